@@ -0,0 +1,16 @@
+// Our first program will print the classic "hello world"
+// message. Here's the full source code.
+
+package main
+
+import "fmt"
+
+// greeting returns the message our program prints.
+func greeting() string {
+	return "hello world"
+}
+
+func main() {
+	// Print the greeting to the console
+	fmt.Println(greeting())
+}
@@ -0,0 +1,9 @@
+package main
+
+import "testing"
+
+func TestGreeting(t *testing.T) {
+	if got := greeting(); got != "hello world" {
+		t.Errorf("Expected %q, got %q", "hello world", got)
+	}
+}
@@ -5,7 +5,13 @@ package main
 
 import "fmt"
 
+// greeting returns the message our program prints.
+func greeting() string {
+	// TODO: Return "hello world"
+	return ""
+}
+
 func main() {
-	// Print "hello world" to the console
-	
-}
\ No newline at end of file
+	// Print the greeting to the console
+	fmt.Println(greeting())
+}
@@ -0,0 +1,44 @@
+// Go has various value types including strings,
+// integers, floats, booleans, etc.
+
+package main
+
+import "fmt"
+
+// Strings, which can be added together with `+`.
+func concat(a, b string) string {
+	return a + b
+}
+
+// Integers and floats.
+func add(a, b int) int {
+	return a + b
+}
+
+func divide(a, b float64) float64 {
+	return a / b
+}
+
+// Booleans, with boolean operators as you'd expect.
+func and(a, b bool) bool {
+	return a && b
+}
+
+func or(a, b bool) bool {
+	return a || b
+}
+
+func not(a bool) bool {
+	return !a
+}
+
+func main() {
+	fmt.Println(concat("go", "lang"))
+
+	fmt.Println("1+1 =", add(1, 1))
+	fmt.Println("7.0/3.0 =", divide(7.0, 3.0))
+
+	fmt.Println(and(true, false))
+	fmt.Println(or(true, false))
+	fmt.Println(not(true))
+}
@@ -0,0 +1,34 @@
+package main
+
+import "testing"
+
+func TestConcat(t *testing.T) {
+	if got := concat("go", "lang"); got != "golang" {
+		t.Errorf("Expected %q, got %q", "golang", got)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	if got := add(1, 1); got != 2 {
+		t.Errorf("Expected 1+1 = 2, got %d", got)
+	}
+	if got := divide(7.0, 3.0); got != 7.0/3.0 {
+		t.Errorf("Expected 7.0/3.0 = %v, got %v", 7.0/3.0, got)
+	}
+}
+
+func TestBooleans(t *testing.T) {
+	for _, a := range []bool{false, true} {
+		for _, b := range []bool{false, true} {
+			if got := and(a, b); got != (a && b) {
+				t.Errorf("and(%v, %v) = %v", a, b, got)
+			}
+			if got := or(a, b); got != (a || b) {
+				t.Errorf("or(%v, %v) = %v", a, b, got)
+			}
+		}
+		if got := not(a); got != !a {
+			t.Errorf("not(%v) = %v", a, got)
+		}
+	}
+}
@@ -1,19 +1,50 @@
 // Go has various value types including strings,
-// integers, floats, booleans, etc. 
+// integers, floats, booleans, etc.
 
 package main
 
 import "fmt"
 
-func main() {
+// Strings, which can be added together with `+`.
+func concat(a, b string) string {
+	// TODO: Return a and b concatenated
+	return ""
+}
+
+// Integers and floats.
+func add(a, b int) int {
+	// TODO: Return the sum of a and b
+	return 0
+}
+
+func divide(a, b float64) float64 {
+	// TODO: Return a divided by b
+	return 0
+}
 
-	// Strings, which can be added together with `+`.
-	// Show the result of concatenating "go" and "lang"
+// Booleans, with boolean operators as you'd expect.
+func and(a, b bool) bool {
+	// TODO: Return a && b
+	return false
+}
 
-	// Integers and floats.
-	// Show the result of 1+1 and 7.0/3.0
+func or(a, b bool) bool {
+	// TODO: Return a || b
+	return false
+}
+
+func not(a bool) bool {
+	// TODO: Return !a
+	return false
+}
+
+func main() {
+	fmt.Println(concat("go", "lang"))
 
-	// Booleans, with boolean operators as you'd expect.
-	// Show the result of true && false, true || false, and !true
+	fmt.Println("1+1 =", add(1, 1))
+	fmt.Println("7.0/3.0 =", divide(7.0, 3.0))
 
-}
\ No newline at end of file
+	fmt.Println(and(true, false))
+	fmt.Println(or(true, false))
+	fmt.Println(not(true))
+}
@@ -0,0 +1,53 @@
+// In Go, _variables_ are explicitly declared and used by
+// the compiler to e.g. check type-correctness of function
+// calls.
+
+package main
+
+import "fmt"
+
+// `var` declares 1 or more variables.
+func initial() string {
+	var a = "initial"
+	return a
+}
+
+// You can declare multiple variables at once.
+func pair() (int, int) {
+	var b, c int = 1, 2
+	return b, c
+}
+
+// Go will infer the type of initialized variables.
+func inferred() bool {
+	var d = true
+	return d
+}
+
+// Variables declared without a corresponding
+// initialization are _zero-valued_. For example, the
+// zero value for an `int` is `0`.
+func zeroed() int {
+	var e int
+	return e
+}
+
+// The `:=` syntax is shorthand for declaring and
+// initializing a variable, e.g. for
+// `var f string = "apple"` in this case.
+// This syntax is only available inside functions.
+func shorthand() string {
+	f := "apple"
+	return f
+}
+
+func main() {
+	fmt.Println(initial())
+
+	b, c := pair()
+	fmt.Println(b, c)
+
+	fmt.Println(inferred())
+	fmt.Println(zeroed())
+	fmt.Println(shorthand())
+}
@@ -0,0 +1,21 @@
+package main
+
+import "testing"
+
+func TestVariables(t *testing.T) {
+	if got := initial(); got != "initial" {
+		t.Errorf("Expected a = %q, got %q", "initial", got)
+	}
+	if b, c := pair(); b != 1 || c != 2 {
+		t.Errorf("Expected b, c = 1, 2, got %d, %d", b, c)
+	}
+	if got := inferred(); !got {
+		t.Errorf("Expected d = true, got %v", got)
+	}
+	if got := zeroed(); got != 0 {
+		t.Errorf("Expected zero value 0 for e, got %d", got)
+	}
+	if got := shorthand(); got != "apple" {
+		t.Errorf("Expected f = %q, got %q", "apple", got)
+	}
+}
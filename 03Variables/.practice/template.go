@@ -6,30 +6,48 @@ package main
 
 import "fmt"
 
-func main() {
-
-	// `var` declares 1 or more variables.
-
-	// TODO: Declare variable a with initial value "initial" and print it
-
-	// You can declare multiple variables at once.
-
-	// TODO: Declare variables b and c as int with values 1 and 2 and print them
+// `var` declares 1 or more variables.
+func initial() string {
+	// TODO: Declare variable a with initial value "initial" and return it
+	return ""
+}
+
+// You can declare multiple variables at once.
+func pair() (int, int) {
+	// TODO: Declare variables b and c as int with values 1 and 2 and return them
+	return 0, 0
+}
+
+// Go will infer the type of initialized variables.
+func inferred() bool {
+	// TODO: Declare variable d with value true and return it
+	return false
+}
+
+// Variables declared without a corresponding
+// initialization are _zero-valued_. For example, the
+// zero value for an `int` is `0`.
+func zeroed() int {
+	// TODO: Declare variable e as int without initialization and return it
+	return -1
+}
+
+// The `:=` syntax is shorthand for declaring and
+// initializing a variable, e.g. for
+// `var f string = "apple"` in this case.
+// This syntax is only available inside functions.
+func shorthand() string {
+	// TODO: Declare and initialize f with value "apple" using := syntax and return it
+	return ""
+}
 
-	// Go will infer the type of initialized variables.
-
-	// TODO: Declare variable d with value true and print it
-
-	// Variables declared without a corresponding
-	// initialization are _zero-valued_. For example, the
-	// zero value for an `int` is `0`.
-
-	// TODO: Declare variable e as int without initialization and print it
+func main() {
+	fmt.Println(initial())
 
-	// The `:=` syntax is shorthand for declaring and
-	// initializing a variable, e.g. for
-	// `var f string = "apple"` in this case.
-	// This syntax is only available inside functions.
+	b, c := pair()
+	fmt.Println(b, c)
 
-	// TODO: Declare and initialize f with value "apple" using := syntax and print it
-}
\ No newline at end of file
+	fmt.Println(inferred())
+	fmt.Println(zeroed())
+	fmt.Println(shorthand())
+}
@@ -0,0 +1,46 @@
+// Go supports _constants_ of character, string, boolean,
+// and numeric values.
+
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// `const` declares a constant value.
+const s string = "constant"
+
+// A `const` statement can appear anywhere a `var`
+// statement can.
+const n = 500000000
+
+// Constant expressions perform arithmetic with
+// arbitrary precision.
+const d = 3e20 / n
+
+// quotient returns the constant d.
+func quotient() float64 {
+	return d
+}
+
+// A numeric constant has no type until it's given
+// one, such as by an explicit conversion.
+func truncated() int64 {
+	return int64(d)
+}
+
+// A number can be given a type by using it in a
+// context that requires one, such as a variable
+// assignment or function call. For example, here
+// `math.Sin` expects a `float64`.
+func sine() float64 {
+	return math.Sin(n)
+}
+
+func main() {
+	fmt.Println(s)
+	fmt.Println(quotient())
+	fmt.Println(truncated())
+	fmt.Println(sine())
+}
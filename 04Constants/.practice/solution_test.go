@@ -0,0 +1,25 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestQuotient(t *testing.T) {
+	if got := quotient(); got != 6e11 {
+		t.Errorf("Expected 6e+11, got %v", got)
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	if got := truncated(); got != 600000000000 {
+		t.Errorf("Expected 600000000000, got %d", got)
+	}
+}
+
+func TestSine(t *testing.T) {
+	want := math.Sin(500000000)
+	if got := sine(); got != want {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
@@ -3,36 +3,46 @@
 
 package main
 
-import (
-	"fmt"
-	"math"
-)
+import "fmt"
 
 // `const` declares a constant value.
 const s string = "constant"
 
-func main() {
-	// TODO: Print constant s from declaration
-
-	// A `const` statement can appear anywhere a `var`
-	// statement can.
+// A `const` statement can appear anywhere a `var`
+// statement can.
 
-	// TODO: Declare constant n with value 500000000
+// TODO: Declare constant n with value 500000000
 
-	// Constant expressions perform arithmetic with
-	// arbitrary precision.
+// Constant expressions perform arithmetic with
+// arbitrary precision.
 
-	// TODO: Declare constant d as 3e20 / n and print it
+// TODO: Declare constant d as 3e20 / n
 
-	// A numeric constant has no type until it's given
-	// one, such as by an explicit conversion.
+// quotient returns the constant d.
+func quotient() float64 {
+	// TODO: Return d
+	return 0
+}
 
-	// TODO: Print d converted to int64
+// A numeric constant has no type until it's given
+// one, such as by an explicit conversion.
+func truncated() int64 {
+	// TODO: Return d converted to int64
+	return 0
+}
 
-	// A number can be given a type by using it in a
-	// context that requires one, such as a variable
-	// assignment or function call. For example, here
-	// `math.Sin` expects a `float64`.
+// A number can be given a type by using it in a
+// context that requires one, such as a variable
+// assignment or function call. For example, here
+// `math.Sin` expects a `float64`.
+func sine() float64 {
+	// TODO: Return the result of math.Sin(n)
+	return 0
+}
 
-	// TODO: Print the result of math.Sin(n)
-}
\ No newline at end of file
+func main() {
+	fmt.Println(s)
+	fmt.Println(quotient())
+	fmt.Println(truncated())
+	fmt.Println(sine())
+}
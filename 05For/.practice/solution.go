@@ -0,0 +1,79 @@
+// `for` is Go's only looping construct. Here are
+// some basic types of `for` loops.
+
+package main
+
+import "fmt"
+
+// The most basic type, with a single condition.
+func countTo(n int) []int {
+	var nums []int
+	i := 1
+	for i <= n {
+		nums = append(nums, i)
+		i = i + 1
+	}
+	return nums
+}
+
+// A classic initial/condition/after `for` loop.
+func countFromZero(n int) []int {
+	var nums []int
+	for j := 0; j < n; j++ {
+		nums = append(nums, j)
+	}
+	return nums
+}
+
+// Another way of accomplishing the basic "do this
+// N times" iteration is `range` over an integer.
+func rangeOver(n int) []int {
+	var nums []int
+	for i := range n {
+		nums = append(nums, i)
+	}
+	return nums
+}
+
+// `for` without a condition will loop repeatedly
+// until you `break` out of the loop or `return` from
+// the enclosing function.
+func loopOnce() []string {
+	var out []string
+	for {
+		out = append(out, "loop")
+		break
+	}
+	return out
+}
+
+// You can also `continue` to the next iteration of
+// the loop.
+func odds(n int) []int {
+	var nums []int
+	for i := range n {
+		if i%2 == 0 {
+			continue
+		}
+		nums = append(nums, i)
+	}
+	return nums
+}
+
+func main() {
+	for _, i := range countTo(3) {
+		fmt.Println(i)
+	}
+	for _, j := range countFromZero(3) {
+		fmt.Println(j)
+	}
+	for _, i := range rangeOver(3) {
+		fmt.Println("range", i)
+	}
+	for _, s := range loopOnce() {
+		fmt.Println(s)
+	}
+	for _, n := range odds(6) {
+		fmt.Println(n)
+	}
+}
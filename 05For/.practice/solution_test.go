@@ -0,0 +1,33 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLoops(t *testing.T) {
+	tests := []struct {
+		name string
+		got  []int
+		want []int
+	}{
+		{"countTo(3)", countTo(3), []int{1, 2, 3}},
+		{"countTo(0)", countTo(0), nil},
+		{"countFromZero(3)", countFromZero(3), []int{0, 1, 2}},
+		{"rangeOver(3)", rangeOver(3), []int{0, 1, 2}},
+		{"odds(6)", odds(6), []int{1, 3, 5}},
+		{"odds(8)", odds(8), []int{1, 3, 5, 7}},
+	}
+
+	for _, tt := range tests {
+		if !slices.Equal(tt.got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestLoopOnce(t *testing.T) {
+	if got := loopOnce(); !slices.Equal(got, []string{"loop"}) {
+		t.Errorf("Expected [loop], got %v", got)
+	}
+}
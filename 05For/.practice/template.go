@@ -5,31 +5,60 @@ package main
 
 import "fmt"
 
-func main() {
-
-	// The most basic type, with a single condition.
-
-	// TODO: Initialize i := 1 and create a for loop that runs for i <= 3 and prints i in each iteration
-
-	// A classic initial/condition/after `for` loop.
+// The most basic type, with a single condition.
+func countTo(n int) []int {
+	var nums []int
+	// TODO: Initialize i := 1 and create a for loop that runs for i <= n and appends i in each iteration
+	return nums
+}
 
-	// TODO: Create a for loop with j := 0; j < 3; j++ and print j in each iteration
+// A classic initial/condition/after `for` loop.
+func countFromZero(n int) []int {
+	var nums []int
+	// TODO: Create a for loop with j := 0; j < n; j++ and append j in each iteration
+	return nums
+}
 
-	// Another way of accomplishing the basic "do this
-	// N times" iteration is `range` over an integer.
+// Another way of accomplishing the basic "do this
+// N times" iteration is `range` over an integer.
+func rangeOver(n int) []int {
+	var nums []int
+	// TODO: Use range n to iterate, appending the index
+	return nums
+}
 
-	// TODO: Use range 3 to iterate, printing "range" and the index
+// `for` without a condition will loop repeatedly
+// until you `break` out of the loop or `return` from
+// the enclosing function.
+func loopOnce() []string {
+	var out []string
+	// TODO: Create an infinite for loop that appends "loop" then breaks
+	return out
+}
 
-	// `for` without a condition will loop repeatedly
-	// until you `break` out of the loop or `return` from
-	// the enclosing function.
+// You can also `continue` to the next iteration of
+// the loop.
+func odds(n int) []int {
+	var nums []int
+	// TODO: Use range n to iterate through numbers 0 to n-1, if the number is even, continue to next iteration,
+	// otherwise append the number
+	return nums
+}
 
-	// TODO: Create an infinite for loop that prints "loop" then breaks
-
-	// You can also `continue` to the next iteration of
-	// the loop.
-
-	// TODO: Use range 6 to iterate through numbers 0-5, if the number is even, continue to next iteration, 
-	// otherwise print the number
-	
-}
\ No newline at end of file
+func main() {
+	for _, i := range countTo(3) {
+		fmt.Println(i)
+	}
+	for _, j := range countFromZero(3) {
+		fmt.Println(j)
+	}
+	for _, i := range rangeOver(3) {
+		fmt.Println("range", i)
+	}
+	for _, s := range loopOnce() {
+		fmt.Println(s)
+	}
+	for _, n := range odds(6) {
+		fmt.Println(n)
+	}
+}
@@ -0,0 +1,61 @@
+// Branching with `if` and `else` in Go is
+// straight-forward.
+
+// Note that you don't need parentheses around conditions
+// in Go, but that the braces are required.
+package main
+
+import "fmt"
+
+// Here's a basic example.
+func parity(n int) string {
+	if n%2 == 0 {
+		return fmt.Sprint(n, " is even")
+	} else {
+		return fmt.Sprint(n, " is odd")
+	}
+}
+
+// You can have an `if` statement without an else.
+func divisible(n, by int) bool {
+	if n%by == 0 {
+		return true
+	}
+	return false
+}
+
+// Logical operators like `&&` and `||` are often
+// useful in conditions.
+func eitherEven(a, b int) bool {
+	if a%2 == 0 || b%2 == 0 {
+		return true
+	}
+	return false
+}
+
+// A statement can precede conditionals; any variables
+// declared in this statement are available in the current
+// and all subsequent branches.
+func digits(n int) string {
+	if num := n; num < 0 {
+		return fmt.Sprint(num, " is negative")
+	} else if num < 10 {
+		return fmt.Sprint(num, " has 1 digit")
+	} else {
+		return fmt.Sprint(num, " has multiple digits")
+	}
+}
+
+func main() {
+	fmt.Println(parity(7))
+
+	if divisible(8, 4) {
+		fmt.Println("8 is divisible by 4")
+	}
+
+	if eitherEven(8, 7) {
+		fmt.Println("either 8 or 7 are even")
+	}
+
+	fmt.Println(digits(9))
+}
@@ -0,0 +1,57 @@
+package main
+
+import "testing"
+
+func TestParity(t *testing.T) {
+	tests := map[int]string{
+		7: "7 is odd",
+		8: "8 is even",
+		0: "0 is even",
+	}
+	for n, want := range tests {
+		if got := parity(n); got != want {
+			t.Errorf("parity(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
+
+func TestDivisible(t *testing.T) {
+	if !divisible(8, 4) {
+		t.Error("Expected 8 to be divisible by 4")
+	}
+	if divisible(7, 4) {
+		t.Error("Expected 7 not to be divisible by 4")
+	}
+}
+
+func TestEitherEven(t *testing.T) {
+	tests := []struct {
+		a, b int
+		want bool
+	}{
+		{8, 7, true},
+		{7, 8, true},
+		{7, 9, false},
+		{2, 4, true},
+	}
+	for _, tt := range tests {
+		if got := eitherEven(tt.a, tt.b); got != tt.want {
+			t.Errorf("eitherEven(%d, %d) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestDigits(t *testing.T) {
+	tests := map[int]string{
+		9:   "9 has 1 digit",
+		0:   "0 has 1 digit",
+		-3:  "-3 is negative",
+		10:  "10 has multiple digits",
+		123: "123 has multiple digits",
+	}
+	for n, want := range tests {
+		if got := digits(n); got != want {
+			t.Errorf("digits(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
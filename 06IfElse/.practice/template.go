@@ -7,31 +7,46 @@ package main
 
 import "fmt"
 
-func main() {
-
-	// Here's a basic example.
-
-	// TODO: Check if 7%2 == 0, print "7 is even" or "7 is odd"
-
-	
-
-	// You can have an `if` statement without an else.
+// Here's a basic example.
+func parity(n int) string {
+	// TODO: Check if n%2 == 0, return "<n> is even" or "<n> is odd"
+	// Hint: use fmt.Sprint(n, " is even")
+	return ""
+}
+
+// You can have an `if` statement without an else.
+func divisible(n, by int) bool {
+	// TODO: If n%by == 0, return true
+	return false
+}
+
+// Logical operators like `&&` and `||` are often
+// useful in conditions.
+func eitherEven(a, b int) bool {
+	// TODO: Return true if a%2 == 0 || b%2 == 0
+	return false
+}
+
+// A statement can precede conditionals; any variables
+// declared in this statement are available in the current
+// and all subsequent branches.
+func digits(n int) string {
+	// TODO: Assign num := n and check if num < 0, return "<num> is negative"
+	// otherwise if num < 10, return "<num> has 1 digit"
+	// otherwise return "<num> has multiple digits"
+	return ""
+}
 
-	// TODO: Check if 8%4 == 0, print "8 is divisible by 4"
-
-
-	// Logical operators like `&&` and `||` are often
-	// useful in conditions.
-
-	// TODO: Check if 8%2 == 0 || 7%2 == 0, print "either 8 or 7 are even"
-
-	// A statement can precede conditionals; any variables
-	// declared in this statement are available in the current
-	// and all subsequent branches.
+func main() {
+	fmt.Println(parity(7))
 
-	// TODO: Assign num := 9 and check if num < 0, print num "is negative"
-	// otherwise if num < 10, print num "has 1 digit"
-	// otherwise print num "has multiple digits"
+	if divisible(8, 4) {
+		fmt.Println("8 is divisible by 4")
+	}
 
+	if eitherEven(8, 7) {
+		fmt.Println("either 8 or 7 are even")
+	}
 
-}
\ No newline at end of file
+	fmt.Println(digits(9))
+}
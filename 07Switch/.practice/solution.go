@@ -0,0 +1,74 @@
+// _Switch statements_ express conditionals across many
+// branches.
+
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// Here's a basic `switch`.
+func spell(i int) string {
+	switch i {
+	case 1:
+		return "one"
+	case 2:
+		return "two"
+	case 3:
+		return "three"
+	}
+	return ""
+}
+
+// You can use commas to separate multiple expressions
+// in the same `case` statement. We use the optional
+// `default` case in this example as well.
+func dayKind(day time.Weekday) string {
+	switch day {
+	case time.Saturday, time.Sunday:
+		return "It's the weekend"
+	default:
+		return "It's a weekday"
+	}
+}
+
+// `switch` without an expression is an alternate way
+// to express if/else logic. Here we also show how the
+// `case` expressions can be non-constants.
+func timeOfDay(t time.Time) string {
+	switch {
+	case t.Hour() < 12:
+		return "It's before noon"
+	default:
+		return "It's after noon"
+	}
+}
+
+// A type `switch` compares types instead of values.  You
+// can use this to discover the type of an interface
+// value.  In this example, the variable `t` will have the
+// type corresponding to its clause.
+func whatAmI(i interface{}) string {
+	switch t := i.(type) {
+	case bool:
+		return "I'm a bool"
+	case int:
+		return "I'm an int"
+	default:
+		return fmt.Sprintf("Don't know type %T", t)
+	}
+}
+
+func main() {
+	i := 2
+	fmt.Print("Write ", i, " as ")
+	fmt.Println(spell(i))
+
+	fmt.Println(dayKind(time.Now().Weekday()))
+	fmt.Println(timeOfDay(time.Now()))
+
+	fmt.Println(whatAmI(true))
+	fmt.Println(whatAmI(1))
+	fmt.Println(whatAmI("hey"))
+}
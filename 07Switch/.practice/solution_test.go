@@ -0,0 +1,62 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSpell(t *testing.T) {
+	tests := map[int]string{1: "one", 2: "two", 3: "three", 4: ""}
+	for i, want := range tests {
+		if got := spell(i); got != want {
+			t.Errorf("spell(%d) = %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestDayKind(t *testing.T) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		want := "It's a weekday"
+		if day == time.Saturday || day == time.Sunday {
+			want = "It's the weekend"
+		}
+		if got := dayKind(day); got != want {
+			t.Errorf("dayKind(%s) = %q, want %q", day, got, want)
+		}
+	}
+}
+
+func TestTimeOfDay(t *testing.T) {
+	tests := []struct {
+		hour int
+		want string
+	}{
+		{0, "It's before noon"},
+		{11, "It's before noon"},
+		{12, "It's after noon"},
+		{23, "It's after noon"},
+	}
+	for _, tt := range tests {
+		at := time.Date(2024, 1, 1, tt.hour, 30, 0, 0, time.UTC)
+		if got := timeOfDay(at); got != tt.want {
+			t.Errorf("timeOfDay(%02d:30) = %q, want %q", tt.hour, got, tt.want)
+		}
+	}
+}
+
+func TestWhatAmI(t *testing.T) {
+	tests := []struct {
+		in   interface{}
+		want string
+	}{
+		{true, "I'm a bool"},
+		{1, "I'm an int"},
+		{"hey", "Don't know type string"},
+		{1.5, "Don't know type float64"},
+	}
+	for _, tt := range tests {
+		if got := whatAmI(tt.in); got != tt.want {
+			t.Errorf("whatAmI(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -8,37 +8,51 @@ import (
 	"time"
 )
 
-func main() {
-
-	// Here's a basic `switch`.
+// Here's a basic `switch`.
+func spell(i int) string {
+	// TODO: Switch on i with cases for 1, 2, 3 returning "one", "two", "three"
+	return ""
+}
+
+// You can use commas to separate multiple expressions
+// in the same `case` statement. We use the optional
+// `default` case in this example as well.
+func dayKind(day time.Weekday) string {
+	// TODO: Switch on day with a case for Saturday, Sunday returning "It's the weekend"
+	// and a default returning "It's a weekday"
+	return ""
+}
+
+// `switch` without an expression is an alternate way
+// to express if/else logic. Here we also show how the
+// `case` expressions can be non-constants.
+func timeOfDay(t time.Time) string {
+	// TODO: Create a switch with no expression
+	// If t.Hour() < 12, return "It's before noon"
+	// Otherwise return "It's after noon"
+	return ""
+}
+
+// A type `switch` compares types instead of values.  You
+// can use this to discover the type of an interface
+// value.  In this example, the variable `t` will have the
+// type corresponding to its clause.
+func whatAmI(i interface{}) string {
+	// TODO: Use a type switch on i
+	// Return "I'm a bool" for bool, "I'm an int" for int,
+	// and "Don't know type <type>" otherwise (Hint: fmt.Sprintf with %T)
+	return ""
+}
 
-	// TODO: Set i := 2, switch on i with cases for 1, 2, 3
+func main() {
 	i := 2
 	fmt.Print("Write ", i, " as ")
+	fmt.Println(spell(i))
 
-	// You can use commas to separate multiple expressions
-	// in the same `case` statement. We use the optional
-	// `default` case in this example as well.
-
-	// TODO: Switch on time.Now().Weekday() with cases for Saturday/Sunday and default
-
-
-	// `switch` without an expression is an alternate way
-	// to express if/else logic. Here we also show how the
-	// `case` expressions can be non-constants.
-
-	// TODO: Create a switch with no expression, check time conditions. Set t := time.Now()
-	// If t.Hour() < 12, print "It's before noon"
-	// Otherwise print "It's after noon"
-
-
-	// A type `switch` compares types instead of values.  You
-	// can use this to discover the type of an interface
-	// value.  In this example, the variable `t` will have the
-	// type corresponding to its clause.
-
-	// TODO: Create a function that uses type switch on interface{}
-	// Function WhatAmI takes an argument i of type interface{} and extracts the type of i.
-	// Uses switch to print the type of i
+	fmt.Println(dayKind(time.Now().Weekday()))
+	fmt.Println(timeOfDay(time.Now()))
 
-}
\ No newline at end of file
+	fmt.Println(whatAmI(true))
+	fmt.Println(whatAmI(1))
+	fmt.Println(whatAmI("hey"))
+}
@@ -0,0 +1,89 @@
+// In Go, an _array_ is a numbered sequence of elements of a
+// specific length. In typical Go code, [slices](slices) are
+// much more common; arrays are useful in some special
+// scenarios.
+
+package main
+
+import "fmt"
+
+// Here we create an array `a` that will hold exactly
+// 5 `int`s. The type of elements and length are both
+// part of the array's type. By default an array is
+// zero-valued, which for `int`s means `0`s.
+func emptyArray() [5]int {
+	var a [5]int
+	return a
+}
+
+// We can set a value at an index using the
+// `array[index] = value` syntax, and get a value with
+// `array[index]`. Arrays are values: `a` is a copy of
+// the caller's array.
+func setLast(a [5]int, v int) [5]int {
+	a[4] = v
+	return a
+}
+
+// Use this syntax to declare and initialize an array
+// in one line.
+func literal() [5]int {
+	b := [5]int{1, 2, 3, 4, 5}
+	return b
+}
+
+// You can also have the compiler count the number of
+// elements for you with `...`
+func counted() [5]int {
+	b := [...]int{1, 2, 3, 4, 5}
+	return b
+}
+
+// If you specify the index with `:`, the elements in
+// between will be zeroed.
+func indexed() [5]int {
+	b := [...]int{100, 3: 400, 500}
+	return b
+}
+
+// Array types are one-dimensional, but you can
+// compose types to build multi-dimensional data
+// structures.
+func grid() [2][3]int {
+	var twoD [2][3]int
+	for i := range 2 {
+		for j := range 3 {
+			twoD[i][j] = i + j
+		}
+	}
+	return twoD
+}
+
+// You can create and initialize multi-dimensional
+// arrays at once too.
+func gridLiteral() [2][3]int {
+	twoD := [2][3]int{
+		{1, 2, 3},
+		{1, 2, 3},
+	}
+	return twoD
+}
+
+func main() {
+	a := emptyArray()
+	fmt.Println("emp:", a)
+
+	a = setLast(a, 100)
+	fmt.Println("set:", a)
+	fmt.Println("get:", a[4])
+
+	// The builtin `len` returns the length of an array.
+	fmt.Println("len:", len(a))
+
+	fmt.Println("dcl:", literal())
+	fmt.Println("dcl:", counted())
+	fmt.Println("idx:", indexed())
+
+	fmt.Println("2d: ", grid())
+	fmt.Println("2d: ", gridLiteral())
+}
@@ -0,0 +1,45 @@
+package main
+
+import "testing"
+
+func TestEmptyArray(t *testing.T) {
+	if got := emptyArray(); got != [5]int{} {
+		t.Errorf("Expected a zero-valued array, got %v", got)
+	}
+}
+
+func TestSetLast(t *testing.T) {
+	a := [5]int{1, 2, 3, 4, 5}
+	got := setLast(a, 100)
+
+	if got != [5]int{1, 2, 3, 4, 100} {
+		t.Errorf("Expected [1 2 3 4 100], got %v", got)
+	}
+	// Arrays are passed by value, so the caller's copy is
+	// left alone.
+	if a[4] != 5 {
+		t.Errorf("Expected the original array to be unchanged, got %v", a)
+	}
+}
+
+func TestLiterals(t *testing.T) {
+	want := [5]int{1, 2, 3, 4, 5}
+	if got := literal(); got != want {
+		t.Errorf("literal() = %v, want %v", got, want)
+	}
+	if got := counted(); got != want {
+		t.Errorf("counted() = %v, want %v", got, want)
+	}
+	if got, want := indexed(), [5]int{100, 0, 0, 400, 500}; got != want {
+		t.Errorf("indexed() = %v, want %v", got, want)
+	}
+}
+
+func TestGrids(t *testing.T) {
+	if got, want := grid(), [2][3]int{{0, 1, 2}, {1, 2, 3}}; got != want {
+		t.Errorf("grid() = %v, want %v", got, want)
+	}
+	if got, want := gridLiteral(), [2][3]int{{1, 2, 3}, {1, 2, 3}}; got != want {
+		t.Errorf("gridLiteral() = %v, want %v", got, want)
+	}
+}
@@ -7,52 +7,76 @@ package main
 
 import "fmt"
 
-func main() {
-
-	// Here we create an array `a` that will hold exactly
-	// 5 `int`s. The type of elements and length are both
-	// part of the array's type. By default an array is
-	// zero-valued, which for `int`s means `0`s.
+// Here we create an array `a` that will hold exactly
+// 5 `int`s. The type of elements and length are both
+// part of the array's type. By default an array is
+// zero-valued, which for `int`s means `0`s.
+func emptyArray() [5]int {
+	// TODO: Create an array `a` that will hold exactly 5 `int`s and return it
+	return [5]int{-1, -1, -1, -1, -1}
+}
+
+// We can set a value at an index using the
+// `array[index] = value` syntax, and get a value with
+// `array[index]`. Arrays are values: `a` is a copy of
+// the caller's array.
+func setLast(a [5]int, v int) [5]int {
+	// TODO: Set a[4] to v and return a
+	return a
+}
+
+// Use this syntax to declare and initialize an array
+// in one line.
+func literal() [5]int {
+	// TODO: Declare and initialize an array `b` with values [1, 2, 3, 4, 5] and return it
+	return [5]int{}
+}
+
+// You can also have the compiler count the number of
+// elements for you with `...`
+func counted() [5]int {
+	// TODO: Initialize an array `b` using [...] syntax with values [1, 2, 3, 4, 5] and return it
+	return [5]int{}
+}
+
+// If you specify the index with `:`, the elements in
+// between will be zeroed.
+func indexed() [5]int {
+	// TODO: Initialize an array `b` using [...] syntax with values [100, 3: 400, 500] and return it
+	return [5]int{}
+}
+
+// Array types are one-dimensional, but you can
+// compose types to build multi-dimensional data
+// structures.
+func grid() [2][3]int {
+	// TODO: Create a two-dimensional array `twoD` of size [2][3]int
+	// Use nested loops (range 2, range 3) to populate twoD[i][j] = i + j
+	return [2][3]int{}
+}
 
-	// TODO: Create an array `a` that will hold exactly 5 `int`s and print it
+// You can create and initialize multi-dimensional
+// arrays at once too.
+func gridLiteral() [2][3]int {
+	// TODO: Create and initialize a two-dimensional array `twoD` with values {{1, 2, 3}, {1, 2, 3}} and return it
+	return [2][3]int{}
+}
 
-	// We can set a value at an index using the
-	// `array[index] = value` syntax, and get a value with
-	// `array[index]`.
+func main() {
+	a := emptyArray()
+	fmt.Println("emp:", a)
 
-	// TODO: Set a[4] to 100 and print the array
+	a = setLast(a, 100)
+	fmt.Println("set:", a)
+	fmt.Println("get:", a[4])
 
 	// The builtin `len` returns the length of an array.
+	fmt.Println("len:", len(a))
 
-	// TODO: Print the length of the array
-
-
-	// Use this syntax to declare and initialize an array
-	// in one line.
-
-	// TODO: Declare and initialize an array `b` with values [1, 2, 3, 4, 5] and print it
-
-	// You can also have the compiler count the number of
-	// elements for you with `...`
-
-	// TODO: Intialize an array `b` with using [...] syntax with values [1, 2, 3, 4, 5] and print it
-
-	// If you specify the index with `:`, the elements in
-	// between will be zeroed.
-
-	// TODO: Intialize an array `b` with using [...] syntax with values [100, 3: 400, 500] and print it
-
-	// Array types are one-dimensional, but you can
-	// compose types to build multi-dimensional data
-	// structures.
-
-	// TODO: Create a two-dimensional array `twoD` of size [2][3]int and print it
-	// Use nested loops (range 2, range 3) to populate twoD[i][j] = i + j
-
-
-	// You can create and initialize multi-dimensional
-	// arrays at once too.
-
-	// TODO: Create and initialize a two-dimensional array `twoD2` with values {{1, 2, 3}, {1, 2, 3}} and print it
+	fmt.Println("dcl:", literal())
+	fmt.Println("dcl:", counted())
+	fmt.Println("idx:", indexed())
 
-}
\ No newline at end of file
+	fmt.Println("2d: ", grid())
+	fmt.Println("2d: ", gridLiteral())
+}
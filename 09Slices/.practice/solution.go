@@ -0,0 +1,121 @@
+// _Slices_ are an important data type in Go, giving
+// a more powerful interface to sequences than arrays.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+)
+
+// To create a slice with non-zero length, use
+// the builtin `make`. Here we make a slice of
+// `string`s of length `n` (initially zero-valued).
+// By default a new slice's capacity is equal to its
+// length; if we know the slice is going to grow ahead
+// of time, it's possible to pass a capacity explicitly
+// as an additional parameter to `make`.
+func makeSlice(n int) []string {
+	return make([]string, n)
+}
+
+// We can set and get just like with arrays.
+func setAndGet(s []string) string {
+	s[0] = "a"
+	s[1] = "b"
+	s[2] = "c"
+	return s[2]
+}
+
+// In addition to these basic operations, slices
+// support several more that make them richer than
+// arrays. One is the builtin `append`, which
+// returns a slice containing one or more new values.
+// Note that we need to accept a return value from
+// `append` as we may get a new slice value.
+func appendLetters(s []string) []string {
+	s = append(s, "d")
+	s = append(s, "e", "f")
+	return s
+}
+
+// Slices can also be `copy`'d. Here we create an
+// empty slice `c` of the same length as `s` and copy
+// into `c` from `s`.
+func copySlice(s []string) []string {
+	c := make([]string, len(s))
+	copy(c, s)
+	return c
+}
+
+// Slices support a "slice" operator with the syntax
+// `slice[low:high]`.
+func sliceUp(s []string) (sl1, sl2, sl3 []string) {
+	sl1 = s[2:5]
+	sl2 = s[:5]
+	sl3 = s[2:]
+	return sl1, sl2, sl3
+}
+
+// The `slices` package contains a number of useful
+// utility functions for slices.
+func equal(a, b []string) bool {
+	return slices.Equal(a, b)
+}
+
+// Slices can be composed into multi-dimensional data
+// structures. The length of the inner slices can
+// vary, unlike with multi-dimensional arrays.
+func triangle(n int) [][]int {
+	twoD := make([][]int, n)
+	for i := range n {
+		innerLen := i + 1
+		twoD[i] = make([]int, innerLen)
+		for j := range innerLen {
+			twoD[i][j] = i + j
+		}
+	}
+	return twoD
+}
+
+func main() {
+
+	// Unlike arrays, slices are typed only by the
+	// elements they contain (not the number of elements).
+	// An uninitialized slice equals to nil and has
+	// length 0.
+	var s []string
+	fmt.Println("uninit:", s, s == nil, len(s) == 0)
+
+	s = makeSlice(3)
+	fmt.Println("emp:", s, "len:", len(s), "cap:", cap(s))
+
+	get := setAndGet(s)
+	fmt.Println("set:", s)
+	fmt.Println("get:", get)
+
+	// `len` returns the length of the slice as expected.
+	fmt.Println("len:", len(s))
+
+	s = appendLetters(s)
+	fmt.Println("apd:", s)
+
+	fmt.Println("cpy:", copySlice(s))
+
+	sl1, sl2, sl3 := sliceUp(s)
+	fmt.Println("sl1:", sl1)
+	fmt.Println("sl2:", sl2)
+	fmt.Println("sl3:", sl3)
+
+	// We can declare and initialize a variable for slice
+	// in a single line as well.
+	t := []string{"g", "h", "i"}
+	fmt.Println("dcl:", t)
+
+	t2 := []string{"g", "h", "i"}
+	if equal(t, t2) {
+		fmt.Println("t == t2")
+	}
+
+	fmt.Println("2d: ", triangle(3))
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMakeSlice(t *testing.T) {
+	s := makeSlice(3)
+	if len(s) != 3 || cap(s) != 3 {
+		t.Fatalf("Expected len 3 and cap 3, got %d and %d", len(s), cap(s))
+	}
+	for i, v := range s {
+		if v != "" {
+			t.Errorf("Expected zero value at %d, got %q", i, v)
+		}
+	}
+}
+
+func TestAppendLetters(t *testing.T) {
+	got := appendLetters([]string{"a", "b", "c"})
+	want := []string{"a", "b", "c", "d", "e", "f"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestCopySlice(t *testing.T) {
+	s := []string{"a", "b", "c"}
+	c := copySlice(s)
+	if !slices.Equal(c, s) {
+		t.Fatalf("Expected %v, got %v", s, c)
+	}
+	// The copy has its own backing array.
+	c[0] = "z"
+	if s[0] != "a" {
+		t.Error("Expected changes to the copy not to affect the original")
+	}
+}
+
+func TestSliceUp(t *testing.T) {
+	s := []string{"a", "b", "c", "d", "e", "f"}
+	sl1, sl2, sl3 := sliceUp(s)
+
+	if want := []string{"c", "d", "e"}; !slices.Equal(sl1, want) {
+		t.Errorf("Expected sl1 %v, got %v", want, sl1)
+	}
+	if want := []string{"a", "b", "c", "d", "e"}; !slices.Equal(sl2, want) {
+		t.Errorf("Expected sl2 %v, got %v", want, sl2)
+	}
+	if want := []string{"c", "d", "e", "f"}; !slices.Equal(sl3, want) {
+		t.Errorf("Expected sl3 %v, got %v", want, sl3)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	if !equal([]string{"g", "h", "i"}, []string{"g", "h", "i"}) {
+		t.Error("Expected equal slices to be equal")
+	}
+	if equal([]string{"g", "h"}, []string{"g", "h", "i"}) {
+		t.Error("Expected slices of different length not to be equal")
+	}
+}
+
+func TestTriangle(t *testing.T) {
+	got := triangle(3)
+	want := [][]int{{0}, {1, 2}, {2, 3, 4}}
+	if !slices.EqualFunc(got, want, slices.Equal[[]int]) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestSetAndGet(t *testing.T) {
+	s := make([]string, 3)
+	if got := setAndGet(s); got != "c" {
+		t.Errorf("Expected get to return %q, got %q", "c", got)
+	}
+	if want := []string{"a", "b", "c"}; !slices.Equal(s, want) {
+		t.Errorf("Expected %v, got %v", want, s)
+	}
+}
@@ -3,10 +3,72 @@
 
 package main
 
-import (
-	"fmt"
-	"slices"
-)
+import "fmt"
+
+// To create a slice with non-zero length, use
+// the builtin `make`. Here we make a slice of
+// `string`s of length `n` (initially zero-valued).
+// By default a new slice's capacity is equal to its
+// length; if we know the slice is going to grow ahead
+// of time, it's possible to pass a capacity explicitly
+// as an additional parameter to `make`.
+func makeSlice(n int) []string {
+	// TODO: Return a new slice holding n empty strings
+	return nil
+}
+
+// We can set and get just like with arrays.
+func setAndGet(s []string) string {
+	// TODO: Store "a", "b" and "c" in the first three elements of s
+	// TODO: Return the third element
+	return ""
+}
+
+// In addition to these basic operations, slices
+// support several more that make them richer than
+// arrays. One is the builtin `append`, which
+// returns a slice containing one or more new values.
+// Note that we need to accept a return value from
+// `append` as we may get a new slice value.
+func appendLetters(s []string) []string {
+	// TODO: Add "d" to the end of s, then "e" and "f" in a single call
+	// TODO: Return the grown slice
+	return s
+}
+
+// Slices can also be `copy`'d. Here we create an
+// empty slice `c` of the same length as `s` and copy
+// into `c` from `s`.
+func copySlice(s []string) []string {
+	// TODO: Return a new slice with the same elements as s, that
+	// doesn't share its backing array
+	return nil
+}
+
+// Slices support a "slice" operator with the syntax
+// `slice[low:high]`.
+func sliceUp(s []string) (sl1, sl2, sl3 []string) {
+	// TODO: Set sl1 to the elements at indexes 2, 3 and 4 of s
+	// TODO: Set sl2 to the first five elements of s
+	// TODO: Set sl3 to every element from index 2 on
+	return nil, nil, nil
+}
+
+// The `slices` package contains a number of useful
+// utility functions for slices.
+func equal(a, b []string) bool {
+	// TODO: Report whether a and b hold the same elements in the same order
+	return false
+}
+
+// Slices can be composed into multi-dimensional data
+// structures. The length of the inner slices can
+// vary, unlike with multi-dimensional arrays.
+func triangle(n int) [][]int {
+	// TODO: Build n rows where row i has i+1 elements and
+	// element j of row i holds i + j
+	return nil
+}
 
 func main() {
 
@@ -14,91 +76,38 @@ func main() {
 	// elements they contain (not the number of elements).
 	// An uninitialized slice equals to nil and has
 	// length 0.
+	var s []string
+	fmt.Println("uninit:", s, s == nil, len(s) == 0)
 
-	// TODO: Declare slice s of strings
-	// Print uninit: s, s == nil, len(s) == 0
-
-	// To create a slice with non-zero length, use
-	// the builtin `make`. Here we make a slice of
-	// `string`s of length `3` (initially zero-valued).
-	// By default a new slice's capacity is equal to its
-	// length; if we know the slice is going to grow ahead
-	// of time, it's possible to pass a capacity explicitly
-	// as an additional parameter to `make`.
-
-	// TODO: Create slice s with make, length 3
-	// Print emp: s, len: len(s), cap: cap(s)
-
-	// We can set and get just like with arrays.
-
-	// TODO: Set s[0] = "a", s[1] = "b", s[2] = "c"
-	// Print set: s, get: s[2]
-	
+	s = makeSlice(3)
+	fmt.Println("emp:", s, "len:", len(s), "cap:", cap(s))
 
+	get := setAndGet(s)
+	fmt.Println("set:", s)
+	fmt.Println("get:", get)
 
 	// `len` returns the length of the slice as expected.
-	// Print len: len(s)
-
-	// In addition to these basic operations, slices
-	// support several more that make them richer than
-	// arrays. One is the builtin `append`, which
-	// returns a slice containing one or more new values.
-	// Note that we need to accept a return value from
-	// `append` as we may get a new slice value.
-
-	// TODO: Append "d" to s.
-	// TODO: Then append "e" and "f" to s and print slice
-
-
-
-	// Slices can also be `copy`'d. Here we create an
-	// empty slice `c` of the same length as `s` and copy
-	// into `c` from `s`.
+	fmt.Println("len:", len(s))
 
-	// TODO: Create slice c with make, same length as s
-	// TODO: Copy s into c
-	// Print cpy: c
+	s = appendLetters(s)
+	fmt.Println("apd:", s)
 
+	fmt.Println("cpy:", copySlice(s))
 
-	// Slices support a "slice" operator with the syntax
-	// `slice[low:high]`. For example, this gets a slice
-	// of the elements `s[2]`, `s[3]`, and `s[4]`.
-
-	// TODO: Create slice l := s[2:5]
-	// Print sl1: l
-
-	// This slices up to (but excluding) `s[5]`.
-
-	// TODO: Create slice l := s[:5]
-	// Print sl2: l
-	
-
-	// And this slices up from (and including) `s[2]`.
-
-	// TODO: Create slice l := s[2:]
-	// Print sl3: l
-	
+	sl1, sl2, sl3 := sliceUp(s)
+	fmt.Println("sl1:", sl1)
+	fmt.Println("sl2:", sl2)
+	fmt.Println("sl3:", sl3)
 
 	// We can declare and initialize a variable for slice
 	// in a single line as well.
+	t := []string{"g", "h", "i"}
+	fmt.Println("dcl:", t)
 
-	// TODO: Create slice t := []string{"g", "h", "i"}
-	// Print dcl: t
-
-	// The `slices` package contains a number of useful
-	// utility functions for slices.
-
-	// TODO: Create slice t2 := []string{"g", "h", "i"}
-	// TODO: Use slices.Equal to compare t and t2
-	// Print t == t2
-
-
-	// Slices can be composed into multi-dimensional data
-	// structures. The length of the inner slices can
-	// vary, unlike with multi-dimensional arrays.
+	t2 := []string{"g", "h", "i"}
+	if equal(t, t2) {
+		fmt.Println("t == t2")
+	}
 
-	// TODO: Create 2D slice twoD := make([][]int, 3)
-	// TODO: Use loop to populate each inner slice with different lengths
-	// Print 2d: twoD
-	
-}
\ No newline at end of file
+	fmt.Println("2d: ", triangle(3))
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"iter"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// source is an iterator over 0, 1, 2, ... that records
+// how far it got, so tests can check how much work an
+// adapter asked of it.
+type source struct {
+	limit    int  // values to yield, or -1 for no limit
+	produced int  // values yielded so far
+	done     bool // whether the iterator has returned
+}
+
+func (s *source) seq() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		defer func() { s.done = true }()
+		for i := 0; s.limit < 0 || i < s.limit; i++ {
+			s.produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func infinite() *source { return &source{limit: -1} }
+
+func upTo(n int) *source { return &source{limit: n} }
+
+func TestAdapters(t *testing.T) {
+	double := func(n int) int { return n * 2 }
+	odd := func(n int) bool { return n%2 == 1 }
+
+	tests := []struct {
+		name string
+		seq  iter.Seq[int]
+		want []int
+	}{
+		{"map", Map(upTo(4).seq(), double), []int{0, 2, 4, 6}},
+		{"filter", Filter(upTo(6).seq(), odd), []int{1, 3, 5}},
+		{"take", Take(upTo(6).seq(), 2), []int{0, 1}},
+		{"take more than there is", Take(upTo(2).seq(), 5), []int{0, 1}},
+		{"take none", Take(upTo(2).seq(), 0), nil},
+		{"skip", Skip(upTo(5).seq(), 3), []int{3, 4}},
+		{"skip everything", Skip(upTo(2).seq(), 5), nil},
+		{"composed", Take(Map(Filter(infinite().seq(), odd), double), 3), []int{2, 6, 10}},
+	}
+	for _, tt := range tests {
+		if got := slices.Collect(tt.seq); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestChunkWindow(t *testing.T) {
+	tests := []struct {
+		name string
+		seq  iter.Seq[[]int]
+		want [][]int
+	}{
+		{"chunk", Chunk(upTo(5).seq(), 2), [][]int{{0, 1}, {2, 3}, {4}}},
+		{"chunk exactly", Chunk(upTo(4).seq(), 2), [][]int{{0, 1}, {2, 3}}},
+		{"chunk empty", Chunk(upTo(0).seq(), 2), nil},
+		{"window", Window(upTo(4).seq(), 2), [][]int{{0, 1}, {1, 2}, {2, 3}}},
+		{"window too large", Window(upTo(2).seq(), 3), nil},
+	}
+	for _, tt := range tests {
+		if got := slices.Collect(tt.seq); !slices.EqualFunc(got, tt.want, slices.Equal) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+
+	for _, f := range []func(iter.Seq[int], int) iter.Seq[[]int]{Chunk[int], Window[int]} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("Expected a size of 0 to panic")
+				}
+			}()
+			f(upTo(1).seq(), 0)
+		}()
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	var got []string
+	for i, s := range Enumerate(slices.Values([]string{"a", "b", "c"})) {
+		got = append(got, strconv.Itoa(i)+s)
+	}
+	if want := []string{"0a", "1b", "2c"}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	concat := func(acc string, n int) string { return acc + strconv.Itoa(n) }
+	if got := Reduce(upTo(4).seq(), ">", concat); got != ">0123" {
+		t.Errorf("Expected >0123, got %q", got)
+	}
+	if got := Reduce(upTo(0).seq(), ">", concat); got != ">" {
+		t.Errorf("Expected an empty iterator to give init, got %q", got)
+	}
+}
+
+func TestZip(t *testing.T) {
+	letters := slices.Values([]string{"a", "b", "c"})
+
+	var got []string
+	for n, s := range Zip(upTo(2).seq(), letters) {
+		got = append(got, strconv.Itoa(n)+s)
+	}
+	if want := []string{"0a", "1b"}; !slices.Equal(got, want) {
+		t.Errorf("Expected to stop at the shorter first iterator, got %v", got)
+	}
+
+	// The pulled iterator is stopped, not left suspended,
+	// when the other one runs out.
+	src := infinite()
+	got = nil
+	for s, n := range Zip(letters, src.seq()) {
+		got = append(got, s+strconv.Itoa(n))
+	}
+	if want := []string{"a0", "b1", "c2"}; !slices.Equal(got, want) {
+		t.Errorf("Expected to stop at the shorter second iterator, got %v", got)
+	}
+	if !src.done {
+		t.Error("Expected the pulled iterator to be stopped")
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	a := slices.Values([]int{1, 4, 4, 9})
+	b := slices.Values([]int{2, 4, 10, 11})
+	if got, want := slices.Collect(MergeSorted(a, b)), []int{1, 2, 4, 4, 4, 9, 10, 11}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if got := slices.Collect(MergeSorted(upTo(0).seq(), upTo(2).seq())); !slices.Equal(got, []int{0, 1}) {
+		t.Errorf("Expected [0 1], got %v", got)
+	}
+
+	// Merging two infinite iterators works, as long as the
+	// loop stops.
+	if got, want := slices.Collect(Take(MergeSorted(genFib(), infinite().seq()), 6)), []int{0, 1, 1, 1, 2, 2}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b iter.Seq[int]
+		want bool
+	}{
+		{upTo(3).seq(), slices.Values([]int{0, 1, 2}), true},
+		{upTo(0).seq(), upTo(0).seq(), true},
+		{upTo(3).seq(), upTo(2).seq(), false},
+		{upTo(2).seq(), upTo(3).seq(), false},
+		{upTo(3).seq(), slices.Values([]int{0, 5, 2}), false},
+		{infinite().seq(), upTo(3).seq(), false},
+	}
+	for i, tt := range tests {
+		if got := Equal(tt.a, tt.b); got != tt.want {
+			t.Errorf("Case %d: expected %v, got %v", i, tt.want, got)
+		}
+	}
+}
+
+func TestLaziness(t *testing.T) {
+	// Building a chain of adapters runs nothing.
+	src := infinite()
+	calls := 0
+	seq := Take(Map(src.seq(), func(n int) int { calls++; return n }), 3)
+	if src.produced != 0 || calls != 0 {
+		t.Fatalf("Expected nothing to run before the loop, got %d values and %d calls", src.produced, calls)
+	}
+
+	// Take asks for exactly 3 values of an infinite source,
+	// and then stops it.
+	if got := slices.Collect(seq); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Errorf("Expected [0 1 2], got %v", got)
+	}
+	if src.produced != 3 || calls != 3 {
+		t.Errorf("Expected 3 values and 3 calls, got %d values and %d calls", src.produced, calls)
+	}
+	if !src.done {
+		t.Error("Expected the source to return once Take was done")
+	}
+}
+
+func TestEarlyStop(t *testing.T) {
+	// Every adapter stops its source as soon as the loop
+	// consuming it breaks: breaking after the first value
+	// leaves the source having produced only as many values
+	// as that first one needed.
+	tests := []struct {
+		name   string
+		first  func(iter.Seq[int])
+		needed int
+	}{
+		{"map", func(s iter.Seq[int]) {
+			for range Map(s, strconv.Itoa) {
+				break
+			}
+		}, 1},
+		{"filter", func(s iter.Seq[int]) {
+			for range Filter(s, func(n int) bool { return n > 2 }) {
+				break
+			}
+		}, 4},
+		{"take", func(s iter.Seq[int]) {
+			for range Take(s, 10) {
+				break
+			}
+		}, 1},
+		{"skip", func(s iter.Seq[int]) {
+			for range Skip(s, 2) {
+				break
+			}
+		}, 3},
+		{"chunk", func(s iter.Seq[int]) {
+			for range Chunk(s, 3) {
+				break
+			}
+		}, 3},
+		{"window", func(s iter.Seq[int]) {
+			for range Window(s, 3) {
+				break
+			}
+		}, 3},
+		{"enumerate", func(s iter.Seq[int]) {
+			for range Enumerate(s) {
+				break
+			}
+		}, 1},
+		{"zip", func(s iter.Seq[int]) {
+			for range Zip(s, genFib()) {
+				break
+			}
+		}, 1},
+		{"merge sorted", func(s iter.Seq[int]) {
+			for range MergeSorted(s, infinite().seq()) {
+				break
+			}
+		}, 1},
+	}
+	for _, tt := range tests {
+		src := infinite()
+		tt.first(src.seq())
+		if src.produced != tt.needed {
+			t.Errorf("%s: expected %d values, got %d", tt.name, tt.needed, src.produced)
+		}
+		if !src.done {
+			t.Errorf("%s: expected the source to return", tt.name)
+		}
+	}
+}
+
+func TestGenFib(t *testing.T) {
+	got := slices.Collect(Take(genFib(), 8))
+	if want := []int{1, 1, 2, 3, 5, 8, 13, 21}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
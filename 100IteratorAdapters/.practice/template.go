@@ -0,0 +1,233 @@
+// [Range over iterators](range-over-iterators) ends with
+// `genFib`, an iterator that never ends, and
+// `slices.Collect`. Here we write the _adapters_ that sit
+// between the two: functions that take an iterator and
+// return another one, like `Map`, `Filter` and `Take`.
+// They are lazy: nothing runs until a loop asks for a
+// value, and nothing more runs once the loop stops, so
+// they work on infinite iterators too.
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// genFib is the Fibonacci iterator of the range over
+// iterators example.
+func genFib() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		a, b := 1, 1
+		for {
+			if !yield(a) {
+				return
+			}
+			a, b = b, a+b
+		}
+	}
+}
+
+// Every adapter follows the same pattern: it ranges over
+// its input and calls `yield` for its own output. When
+// `yield` returns false, because the loop consuming the
+// adapter stopped, the adapter returns. That ends its
+// `range` loop, whose `yield` then returns false to the
+// input in turn, so stopping travels all the way up.
+
+// Map yields `f(v)` for every `v` of seq.
+func Map[In, Out any](seq iter.Seq[In], f func(In) Out) iter.Seq[Out] {
+	return func(yield func(Out) bool) {
+		for v := range seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter yields the values of seq for which keep returns
+// true.
+func Filter[T any](seq iter.Seq[T], keep func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		// TODO: Like Map, but only yield the values for which
+		// keep(v) is true
+	}
+}
+
+// Take yields the first n values of seq. It stops right
+// after the n-th, without asking seq for another one.
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		// TODO: Return at once if n <= 0. Otherwise yield the
+		// values of seq, counting them, and return right
+		// after the n-th one so that seq isn't asked for
+		// another
+	}
+}
+
+// Skip yields the values of seq after the first n.
+func Skip[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		// TODO: Range over seq, skip the first n values and
+		// yield the rest
+	}
+}
+
+// Enumerate yields the values of seq with their index,
+// like ranging over a slice.
+func Enumerate[T any](seq iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for v := range seq {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Chunk yields the values of seq in slices of size. The
+// last slice holds what's left, and may be shorter. Like
+// `slices.Chunk`, it panics if size is less than 1.
+func Chunk[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size < 1 {
+		panic("cannot be less than 1")
+	}
+	return func(yield func([]T) bool) {
+		// Each chunk is a new slice, so the caller may keep
+		// it.
+		chunk := make([]T, 0, size)
+		for v := range seq {
+			chunk = append(chunk, v)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Window yields every run of size consecutive values of
+// seq: the values 0 to size-1, then 1 to size, and so on.
+// It panics if size is less than 1.
+func Window[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size < 1 {
+		panic("cannot be less than 1")
+	}
+	return func(yield func([]T) bool) {
+		// TODO: Append each value to a window slice, drop its
+		// first value once it is longer than size, and yield
+		// a copy (slices.Clone) whenever it holds exactly size
+		// values
+	}
+}
+
+// Reduce combines the values of seq into one, starting
+// from init. Unlike the adapters it isn't lazy: it runs
+// seq to the end, so seq must be finite.
+func Reduce[T, A any](seq iter.Seq[T], init A, f func(A, T) A) A {
+	acc := init
+	for v := range seq {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// The adapters above all consume one iterator with a
+// `range` loop, which is the iterator calling us. To walk
+// two iterators side by side we need to call them
+// instead, asking each for its next value when we're
+// ready. `iter.Pull` turns an iterator into such a `next`
+// function, plus a `stop` function that ends it early.
+// Always call `stop`, or the iterator is left suspended.
+
+// Zip yields the values of a and b in pairs, and stops at
+// the end of the shorter one.
+func Zip[A, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		// TODO: Turn b into next and stop with iter.Pull, and
+		// defer stop(). Then range over a, calling next() for
+		// each value, and return when b is done or yield
+		// returns false
+	}
+}
+
+// MergeSorted merges two sorted iterators into one sorted
+// iterator.
+func MergeSorted[T cmp.Ordered](a, b iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		// TODO: Pull both iterators, as Equal does, and get
+		// the first value of each. While either has a value,
+		// yield the lesser one (or the only one) and pull the
+		// next value from the iterator it came from
+	}
+}
+
+// Equal reports whether a and b yield the same values. It
+// stops at the first difference, so it also works when
+// one of them is infinite.
+func Equal[T comparable](a, b iter.Seq[T]) bool {
+	nextA, stopA := iter.Pull(a)
+	defer stopA()
+	nextB, stopB := iter.Pull(b)
+	defer stopB()
+	for {
+		va, okA := nextA()
+		vb, okB := nextB()
+		if okA != okB || va != vb {
+			return false
+		}
+		if !okA {
+			return true
+		}
+	}
+}
+
+func main() {
+	// Take makes the infinite genFib finite, so it can be
+	// collected.
+	fmt.Println("fib:", slices.Collect(Take(genFib(), 10)))
+
+	// Adapters compose. Filter on an infinite iterator is
+	// infinite too, but it only runs as far as Take needs.
+	even := Filter(genFib(), func(n int) bool { return n%2 == 0 })
+	fmt.Println("even:", slices.Collect(Take(even, 5)))
+
+	big := Map(Skip(Take(genFib(), 12), 9), func(n int) string {
+		return fmt.Sprintf("<%d>", n)
+	})
+	fmt.Println("skip:", strings.Join(slices.Collect(big), " "))
+
+	for i, n := range Enumerate(Take(genFib(), 3)) {
+		fmt.Println(i, n)
+	}
+
+	// Zip stops at the end of the shorter iterator, here
+	// the letters.
+	var pairs []string
+	for c, n := range Zip(slices.Values([]string{"a", "b", "c"}), genFib()) {
+		pairs = append(pairs, fmt.Sprint(c, "=", n))
+	}
+	fmt.Println("zip:", strings.Join(pairs, " "))
+
+	first7 := Take(genFib(), 7)
+	fmt.Println("chunks:", slices.Collect(Chunk(first7, 3)))
+	fmt.Println("windows:", slices.Collect(Window(first7, 3)))
+
+	sum := Reduce(Take(genFib(), 10), 0, func(acc, n int) int { return acc + n })
+	fmt.Println("sum:", sum)
+
+	squares := Map(slices.Values([]int{1, 2, 3}), func(n int) int { return n * n })
+	fmt.Println("merged:", slices.Collect(MergeSorted(squares, Take(genFib(), 6))))
+	fmt.Println("equal:", Equal(Take(genFib(), 5), slices.Values([]int{1, 1, 2, 3, 5})))
+}
@@ -0,0 +1,284 @@
+// The [enums](enums) example moves a `ServerState`
+// between states with a hand-written `transition`
+// switch, and names the states with a hand-written map.
+// Both get harder to keep right as states are added.
+// Here the transitions become a table that a generic
+// state machine runs, with _guards_ that decide between
+// transitions, _hooks_ that run when entering or leaving
+// a state, errors for events a state doesn't accept, and
+// a diagram of the whole machine. The `String` methods
+// are generated from the constants instead of written
+// by hand.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// The states of the enums example, and the events that
+// move a server between them.
+type ServerState int
+
+const (
+	StateIdle ServerState = iota
+	StateConnected
+	StateError
+	StateRetrying
+)
+
+type Event int
+
+const (
+	EventConnect Event = iota
+	EventDisconnect
+	EventFail
+	EventReset
+)
+
+// The `String` methods of both types are in
+// `serverstate_string.go` and `event_string.go`, which
+// `go generate` writes by running the `genstring.go`
+// program in this directory. Like the
+// [stringer tool](https://pkg.go.dev/golang.org/x/tools/cmd/stringer),
+// it finds the constants of a type and names each value
+// after its constant.
+//go:generate go run genstring.go -type=ServerState -trimprefix=State
+//go:generate go run genstring.go -type=Event -trimprefix=Event
+
+// Transition is a row of a state machine's table: `Event`
+// moves the machine from `From` to `To`. The state and
+// event types are type parameters, so the same machine
+// runs any pair of enums.
+type Transition[S, E comparable] struct {
+	From  S
+	Event E
+	To    S
+
+	// Guard, if set, must return true for the transition
+	// to happen; otherwise the next row for the same state
+	// and event is tried. GuardName describes it in
+	// diagrams.
+	Guard     func() bool
+	GuardName string
+}
+
+// Hook is a function run on a transition.
+type Hook[S, E comparable] func(t Transition[S, E])
+
+// The errors of Fire. They are wrapped in a
+// TransitionError, which says which event failed in
+// which state.
+var (
+	ErrInvalidTransition = errors.New("invalid transition")
+	ErrGuardRejected     = errors.New("rejected by guard")
+)
+
+// TransitionError is returned by Fire when an event can't
+// move the machine.
+type TransitionError[S, E comparable] struct {
+	From  S
+	Event E
+	Err   error
+}
+
+func (e *TransitionError[S, E]) Error() string {
+	return fmt.Sprintf("event %v in state %v: %v", e.Event, e.From, e.Err)
+}
+
+func (e *TransitionError[S, E]) Unwrap() error { return e.Err }
+
+// FSM is a finite state machine with states of type S and
+// events of type E. Like most Go types, it is not safe
+// for concurrent use, and hooks must not call Fire.
+type FSM[S, E comparable] struct {
+	state   S
+	initial S
+	table   []Transition[S, E]
+	// rows indexes the table by state and event, keeping
+	// the order of the rows.
+	rows    map[S]map[E][]int
+	onEnter map[S][]Hook[S, E]
+	onExit  map[S][]Hook[S, E]
+}
+
+// New returns a machine in state `initial` that moves
+// between states according to `table`.
+func New[S, E comparable](initial S, table []Transition[S, E]) *FSM[S, E] {
+	m := &FSM[S, E]{
+		state:   initial,
+		initial: initial,
+		table:   table,
+		rows:    make(map[S]map[E][]int),
+		onEnter: make(map[S][]Hook[S, E]),
+		onExit:  make(map[S][]Hook[S, E]),
+	}
+	for i, t := range table {
+		if m.rows[t.From] == nil {
+			m.rows[t.From] = make(map[E][]int)
+		}
+		m.rows[t.From][t.Event] = append(m.rows[t.From][t.Event], i)
+	}
+	return m
+}
+
+// State returns the current state.
+func (m *FSM[S, E]) State() S { return m.state }
+
+// OnEnter registers a hook to run whenever the machine
+// enters state s.
+func (m *FSM[S, E]) OnEnter(s S, h Hook[S, E]) {
+	m.onEnter[s] = append(m.onEnter[s], h)
+}
+
+// OnExit registers a hook to run whenever the machine
+// leaves state s.
+func (m *FSM[S, E]) OnExit(s S, h Hook[S, E]) {
+	m.onExit[s] = append(m.onExit[s], h)
+}
+
+// find returns the transition that e takes from the
+// current state: the first row whose guard allows it.
+func (m *FSM[S, E]) find(e E) (Transition[S, E], error) {
+	rows := m.rows[m.state][e]
+	if len(rows) == 0 {
+		return Transition[S, E]{}, &TransitionError[S, E]{m.state, e, ErrInvalidTransition}
+	}
+	for _, i := range rows {
+		if t := m.table[i]; t.Guard == nil || t.Guard() {
+			return t, nil
+		}
+	}
+	return Transition[S, E]{}, &TransitionError[S, E]{m.state, e, ErrGuardRejected}
+}
+
+// Can reports whether Fire(e) would succeed now.
+func (m *FSM[S, E]) Can(e E) bool {
+	_, err := m.find(e)
+	return err == nil
+}
+
+// Fire moves the machine on event e. It runs the exit
+// hooks of the state it leaves, then the entry hooks of
+// the state it enters, even when both are the same. If e
+// can't move the machine, Fire returns a
+// *TransitionError and the state doesn't change.
+func (m *FSM[S, E]) Fire(e E) error {
+	t, err := m.find(e)
+	if err != nil {
+		return err
+	}
+	for _, h := range m.onExit[t.From] {
+		h(t)
+	}
+	m.state = t.To
+	for _, h := range m.onEnter[t.To] {
+		h(t)
+	}
+	return nil
+}
+
+// WriteDOT writes the machine's diagram in the
+// [DOT language](https://graphviz.org/doc/info/lang.html)
+// of Graphviz. `dot -Tsvg` draws it: one node per state,
+// an arrow into the initial state and one arrow per row
+// of the table, labelled with its event and guard.
+func (m *FSM[S, E]) WriteDOT(w io.Writer) error {
+	quote := func(v any) string { return strconv.Quote(fmt.Sprint(v)) }
+
+	var err error
+	printf := func(format string, args ...any) {
+		if err == nil {
+			_, err = fmt.Fprintf(w, format, args...)
+		}
+	}
+	printf("digraph fsm {\n")
+	printf("\trankdir=LR;\n")
+	printf("\tstart [shape=point];\n")
+	printf("\tstart -> %s;\n", quote(m.initial))
+	for _, t := range m.table {
+		label := fmt.Sprint(t.Event)
+		if t.GuardName != "" {
+			label += " [" + t.GuardName + "]"
+		}
+		printf("\t%s -> %s [label=%s];\n", quote(t.From), quote(t.To), quote(label))
+	}
+	printf("}\n")
+	return err
+}
+
+// connection is the server of the enums example as a
+// state machine. A failure while connected moves it to
+// retrying, where up to `maxRetries` failures in a row
+// keep it retrying before it gives up with an error.
+type connection struct {
+	*FSM[ServerState, Event]
+	retries int
+}
+
+const maxRetries = 2
+
+func newConnection() *connection {
+	c := &connection{}
+	canRetry := func() bool { return c.retries < maxRetries }
+	c.FSM = New(StateIdle, []Transition[ServerState, Event]{
+		{From: StateIdle, Event: EventConnect, To: StateConnected},
+		{From: StateConnected, Event: EventDisconnect, To: StateIdle},
+		{From: StateConnected, Event: EventFail, To: StateRetrying},
+		{From: StateRetrying, Event: EventConnect, To: StateConnected},
+		// The first row whose guard allows it wins, so
+		// failing keeps retrying until canRetry is false.
+		{From: StateRetrying, Event: EventFail, To: StateRetrying, Guard: canRetry, GuardName: "can retry"},
+		{From: StateRetrying, Event: EventFail, To: StateError},
+		{From: StateError, Event: EventReset, To: StateIdle},
+	})
+
+	// The hooks keep the retry count, and say what the
+	// connection is doing.
+	c.OnExit(StateConnected, func(t Transition[ServerState, Event]) {
+		fmt.Println("  closing the connection")
+	})
+	c.OnEnter(StateConnected, func(t Transition[ServerState, Event]) {
+		c.retries = 0
+	})
+	c.OnEnter(StateRetrying, func(t Transition[ServerState, Event]) {
+		c.retries++
+		fmt.Println("  retry", c.retries)
+	})
+	c.OnEnter(StateError, func(t Transition[ServerState, Event]) {
+		fmt.Println("  giving up after", c.retries, "retries")
+	})
+	return c
+}
+
+func main() {
+	c := newConnection()
+	events := []Event{
+		EventConnect, EventFail, EventConnect, EventDisconnect,
+		EventDisconnect, EventConnect, EventFail, EventFail,
+		EventFail, EventConnect, EventReset,
+	}
+	for _, e := range events {
+		fmt.Println(c.State(), "+", e)
+		if err := c.Fire(e); err != nil {
+			fmt.Println("  error:", err)
+			continue
+		}
+		fmt.Println("  now", c.State())
+	}
+
+	// The errors wrap ErrInvalidTransition or
+	// ErrGuardRejected, so errors.Is tells them apart.
+	if err := c.Fire(EventFail); errors.Is(err, ErrInvalidTransition) {
+		fmt.Println("Fail is not accepted in state", c.State())
+	}
+
+	// `dot -Tsvg` turns this into a picture of the
+	// machine.
+	fmt.Println()
+	c.WriteDOT(os.Stdout)
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		v    interface{ String() string }
+		want string
+	}{
+		{StateIdle, "Idle"},
+		{StateConnected, "Connected"},
+		{StateError, "Error"},
+		{StateRetrying, "Retrying"},
+		{ServerState(99), "ServerState(99)"},
+		{EventConnect, "Connect"},
+		{EventReset, "Reset"},
+		{Event(-1), "Event(-1)"},
+	}
+	for _, tt := range tests {
+		if got := tt.v.String(); got != tt.want {
+			t.Errorf("Expected %q, got %q", tt.want, got)
+		}
+	}
+}
+
+// onOff is a light switch, to test the machine with a
+// table of its own.
+func onOff() *FSM[ServerState, Event] {
+	return New(StateIdle, []Transition[ServerState, Event]{
+		{From: StateIdle, Event: EventConnect, To: StateConnected},
+		{From: StateConnected, Event: EventDisconnect, To: StateIdle},
+		{From: StateConnected, Event: EventConnect, To: StateConnected},
+	})
+}
+
+func TestFire(t *testing.T) {
+	m := onOff()
+	if m.State() != StateIdle {
+		t.Fatalf("Expected to start Idle, got %v", m.State())
+	}
+	steps := []struct {
+		event Event
+		want  ServerState
+	}{
+		{EventConnect, StateConnected},
+		{EventConnect, StateConnected},
+		{EventDisconnect, StateIdle},
+		{EventConnect, StateConnected},
+	}
+	for _, s := range steps {
+		if err := m.Fire(s.event); err != nil {
+			t.Fatalf("Fire(%v) failed: %v", s.event, err)
+		}
+		if m.State() != s.want {
+			t.Errorf("Fire(%v): expected %v, got %v", s.event, s.want, m.State())
+		}
+	}
+}
+
+func TestInvalidTransition(t *testing.T) {
+	m := onOff()
+	err := m.Fire(EventDisconnect)
+	if !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("Expected ErrInvalidTransition, got %v", err)
+	}
+	var te *TransitionError[ServerState, Event]
+	if !errors.As(err, &te) || te.From != StateIdle || te.Event != EventDisconnect {
+		t.Errorf("Expected a TransitionError for Disconnect in Idle, got %#v", err)
+	}
+	if want := "event Disconnect in state Idle: invalid transition"; err.Error() != want {
+		t.Errorf("Expected %q, got %q", want, err.Error())
+	}
+	if m.State() != StateIdle {
+		t.Errorf("Expected the state not to change, got %v", m.State())
+	}
+	if m.Can(EventDisconnect) || !m.Can(EventConnect) {
+		t.Error("Expected Can to agree with Fire")
+	}
+}
+
+func TestGuards(t *testing.T) {
+	var first, second bool
+	m := New(StateIdle, []Transition[ServerState, Event]{
+		{From: StateIdle, Event: EventConnect, To: StateConnected, Guard: func() bool { return first }},
+		{From: StateIdle, Event: EventConnect, To: StateRetrying, Guard: func() bool { return second }},
+	})
+
+	// When every guard rejects the event, the state
+	// doesn't change.
+	if err := m.Fire(EventConnect); !errors.Is(err, ErrGuardRejected) {
+		t.Errorf("Expected ErrGuardRejected, got %v", err)
+	}
+	if m.Can(EventConnect) || m.State() != StateIdle {
+		t.Errorf("Expected to stay Idle, got %v", m.State())
+	}
+
+	// Otherwise the first row whose guard allows it wins.
+	first, second = true, true
+	if err := m.Fire(EventConnect); err != nil || m.State() != StateConnected {
+		t.Errorf("Expected the first row to win, got %v, %v", m.State(), err)
+	}
+	m = New(StateIdle, m.table)
+	first = false
+	if err := m.Fire(EventConnect); err != nil || m.State() != StateRetrying {
+		t.Errorf("Expected the second row to win, got %v, %v", m.State(), err)
+	}
+}
+
+func TestHooks(t *testing.T) {
+	m := onOff()
+	var calls []string
+	record := func(what string) Hook[ServerState, Event] {
+		return func(tr Transition[ServerState, Event]) {
+			calls = append(calls, what+" "+tr.From.String()+"->"+tr.To.String())
+		}
+	}
+	m.OnExit(StateIdle, record("exit idle"))
+	m.OnEnter(StateConnected, record("enter connected"))
+	m.OnEnter(StateConnected, record("enter connected again"))
+	m.OnExit(StateConnected, record("exit connected"))
+
+	m.Fire(EventConnect)
+	m.Fire(EventConnect)
+	m.Fire(EventDisconnect)
+	m.Fire(EventDisconnect) // invalid: no hooks
+
+	// Exit hooks run before entry hooks, in the order they
+	// were registered, even for a transition to the same
+	// state.
+	want := []string{
+		"exit idle Idle->Connected",
+		"enter connected Idle->Connected",
+		"enter connected again Idle->Connected",
+		"exit connected Connected->Connected",
+		"enter connected Connected->Connected",
+		"enter connected again Connected->Connected",
+		"exit connected Connected->Idle",
+	}
+	if !slices.Equal(calls, want) {
+		t.Errorf("Expected hooks:\n%s\ngot:\n%s", strings.Join(want, "\n"), strings.Join(calls, "\n"))
+	}
+}
+
+func TestConnection(t *testing.T) {
+	practicetest.Capture(t, func() {
+		c := newConnection()
+		for _, e := range []Event{EventConnect, EventFail, EventFail, EventFail} {
+			if err := c.Fire(e); err != nil {
+				t.Errorf("Fire(%v) failed: %v", e, err)
+				return
+			}
+		}
+		if c.State() != StateError || c.retries != maxRetries {
+			t.Errorf("Expected Error after %d retries, got %v after %d", maxRetries, c.State(), c.retries)
+		}
+
+		// Connecting again resets the retries.
+		for _, e := range []Event{EventReset, EventConnect, EventFail} {
+			c.Fire(e)
+		}
+		if c.State() != StateRetrying || c.retries != 1 {
+			t.Errorf("Expected the first retry, got %v after %d", c.State(), c.retries)
+		}
+	})
+}
+
+// failingWriter fails every write.
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestWriteDOT(t *testing.T) {
+	m := onOff()
+	m.table[2].GuardName = `already "on"`
+
+	var b strings.Builder
+	if err := m.WriteDOT(&b); err != nil {
+		t.Fatalf("WriteDOT failed: %v", err)
+	}
+	want := "digraph fsm {\n" +
+		"\trankdir=LR;\n" +
+		"\tstart [shape=point];\n" +
+		"\tstart -> \"Idle\";\n" +
+		"\t\"Idle\" -> \"Connected\" [label=\"Connect\"];\n" +
+		"\t\"Connected\" -> \"Idle\" [label=\"Disconnect\"];\n" +
+		"\t\"Connected\" -> \"Connected\" [label=\"Connect [already \\\"on\\\"]\"];\n" +
+		"}\n"
+	if b.String() != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, b.String())
+	}
+
+	if err := m.WriteDOT(failingWriter{}); err == nil || err.Error() != "disk full" {
+		t.Errorf("Expected the write error, got %v", err)
+	}
+}
+
+func TestGeneratedStringMethods(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs go generate")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+
+	// Run go generate on a copy of the package, and check
+	// that it writes the String methods we have.
+	dir := t.TempDir()
+	files, _ := filepath.Glob("*.go")
+	files = append(files, "go.mod")
+	var generated []string
+	for _, name := range files {
+		switch {
+		case strings.HasSuffix(name, "_test.go"):
+			continue
+		case strings.HasSuffix(name, "_string.go"):
+			generated = append(generated, name)
+			continue
+		}
+		data, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatalf("ReadFile failed: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			t.Fatalf("WriteFile failed: %v", err)
+		}
+	}
+	if len(generated) == 0 {
+		t.Fatal("Expected generated *_string.go files")
+	}
+
+	cmd := exec.Command(goTool, "generate", ".")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go generate failed: %v\n%s", err, out)
+	}
+	for _, name := range generated {
+		want, _ := os.ReadFile(name)
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Errorf("Expected go generate to write %s: %v", name, err)
+			continue
+		}
+		if string(got) != string(want) {
+			t.Errorf("%s is out of date; run go generate. Expected:\n%s\ngot:\n%s", name, want, got)
+		}
+	}
+}
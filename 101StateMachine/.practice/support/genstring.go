@@ -0,0 +1,156 @@
+//go:build ignore
+
+// genstring writes String methods for integer constant types, like
+// golang.org/x/tools/cmd/stringer but with the standard library only.
+// The state machine runs it with go:generate:
+//
+//	go run genstring.go -type=ServerState -trimprefix=State
+//
+// It reads the package in the current directory, finds the constants of
+// each type and writes <type>_string.go with a String method that
+// returns their names. The names come from the constants, so adding a
+// state is a matter of adding a constant and running go generate.
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/constant"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+var (
+	typeNames  = flag.String("type", "", "comma-separated list of type names; must be set")
+	trimPrefix = flag.String("trimprefix", "", "trim the prefix from the generated constant names")
+	output     = flag.String("output", "", "output file name; default <type>_string.go")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("genstring: ")
+	flag.Parse()
+	if *typeNames == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	types := strings.Split(*typeNames, ",")
+
+	pkg, err := loadPackage(".")
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(pkg, types, *trimPrefix)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	name := *output
+	if name == "" {
+		name = strings.ToLower(types[0]) + "_string.go"
+	}
+	if err := os.WriteFile(name, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// loadPackage parses and type-checks the package in dir. Only the
+// constants matter, so imports are not resolved: the type checker
+// reports the code that uses them as errors, which are ignored, and
+// still computes every constant value.
+func loadPackage(dir string) (*types.Package, error) {
+	bp, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range bp.GoFiles {
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	conf := types.Config{
+		Importer: importerFunc(func(path string) (*types.Package, error) {
+			return nil, fmt.Errorf("not importing %s", path)
+		}),
+		Error: func(error) {},
+	}
+	pkg, _ := conf.Check(bp.Name, fset, files, nil)
+	return pkg, nil
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) { return f(path) }
+
+// value is a constant of one of the types.
+type value struct {
+	name string // the constant's name
+	str  string // the name String returns
+	val  int64
+	pos  token.Pos
+}
+
+// generate returns the source of the String methods of typeNames.
+func generate(pkg *types.Package, typeNames []string, trimPrefix string) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by \"genstring %s\"; DO NOT EDIT.\n\n", strings.Join(os.Args[1:], " "))
+	fmt.Fprintf(&buf, "package %s\n\n", pkg.Name())
+	fmt.Fprintf(&buf, "import \"strconv\"\n")
+
+	for _, typeName := range typeNames {
+		obj, ok := pkg.Scope().Lookup(typeName).(*types.TypeName)
+		if !ok {
+			return nil, fmt.Errorf("no type %s in package %s", typeName, pkg.Name())
+		}
+		basic, ok := obj.Type().Underlying().(*types.Basic)
+		if !ok || basic.Info()&types.IsInteger == 0 {
+			return nil, fmt.Errorf("type %s is not an integer type", typeName)
+		}
+
+		var values []value
+		for _, name := range pkg.Scope().Names() {
+			c, ok := pkg.Scope().Lookup(name).(*types.Const)
+			if !ok || c.Type() != obj.Type() || name == "_" {
+				continue
+			}
+			v, ok := constant.Int64Val(c.Val())
+			if !ok {
+				return nil, fmt.Errorf("constant %s does not fit in an int64", name)
+			}
+			values = append(values, value{name, strings.TrimPrefix(name, trimPrefix), v, c.Pos()})
+		}
+		if len(values) == 0 {
+			return nil, fmt.Errorf("no constants of type %s", typeName)
+		}
+		// Order by value, and keep the first declared name of each
+		// value, as a switch can't have the same case twice.
+		slices.SortFunc(values, func(a, b value) int {
+			return cmp.Or(cmp.Compare(a.val, b.val), cmp.Compare(a.pos, b.pos))
+		})
+		values = slices.CompactFunc(values, func(a, b value) bool { return a.val == b.val })
+
+		fmt.Fprintf(&buf, "\nfunc (i %s) String() string {\n", typeName)
+		fmt.Fprintf(&buf, "switch i {\n")
+		for _, v := range values {
+			fmt.Fprintf(&buf, "case %s:\nreturn %q\n", v.name, v.str)
+		}
+		fmt.Fprintf(&buf, "default:\nreturn \"%s(\" + strconv.FormatInt(int64(i), 10) + \")\"\n", typeName)
+		fmt.Fprintf(&buf, "}\n}\n")
+	}
+	return format.Source(buf.Bytes())
+}
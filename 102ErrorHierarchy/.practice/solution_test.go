@@ -0,0 +1,301 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// keepUsers restores the users when the test ends, so
+// that tests creating users don't affect each other.
+func keepUsers(t *testing.T) {
+	saved := maps.Clone(users)
+	t.Cleanup(func() { users = saved })
+}
+
+func TestCodes(t *testing.T) {
+	tests := []struct {
+		code   Code
+		name   string
+		status int
+	}{
+		{CodeUnknown, "unknown", 500},
+		{CodeInvalid, "invalid", 400},
+		{CodeNotFound, "not_found", 404},
+		{CodeConflict, "conflict", 409},
+		{CodeUnauthorized, "unauthorized", 401},
+		{CodeUnavailable, "unavailable", 503},
+		{Code(42), "unknown", 500},
+	}
+	for _, tt := range tests {
+		if got := tt.code.String(); got != tt.name {
+			t.Errorf("Code(%d).String(): expected %q, got %q", int(tt.code), tt.name, got)
+		}
+		if got := tt.code.HTTPStatus(); got != tt.status {
+			t.Errorf("Code(%d).HTTPStatus(): expected %d, got %d", int(tt.code), tt.status, got)
+		}
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	cause := errors.New("timeout")
+	tests := []struct {
+		err  *Error
+		want string
+	}{
+		{New(CodeConflict, "create", "exists"), "create: exists"},
+		{Wrap(cause, CodeUnavailable, "dial"), "dial: timeout"},
+		{&Error{Code: CodeInvalid, Op: "parse", Msg: "bad", Err: cause}, "parse: bad: timeout"},
+		{&Error{Code: CodeInvalid, Msg: "bad"}, "bad"},
+		{ErrNotFound, "not_found"},
+	}
+	for _, tt := range tests {
+		if got := tt.err.Error(); got != tt.want {
+			t.Errorf("Expected %q, got %q", tt.want, got)
+		}
+	}
+}
+
+// layers wraps err the way an API does on its way up:
+// with context, together with other errors and in a
+// batch.
+func layers(err error) map[string]error {
+	return map[string]error{
+		"bare":    err,
+		"wrapped": fmt.Errorf("handler: %w", fmt.Errorf("service: %w", err)),
+		"joined":  fmt.Errorf("cleanup: %w", errors.Join(errors.New("close failed"), err)),
+		"batch":   &BatchError{Total: 3, Errs: []error{errors.New("other"), fmt.Errorf("item 2: %w", err)}},
+		"coded":   Wrap(fmt.Errorf("retrying: %w", err), CodeUnknown, "outer"),
+	}
+}
+
+func TestClassification(t *testing.T) {
+	for _, code := range []Code{CodeInvalid, CodeNotFound, CodeConflict, CodeUnauthorized, CodeUnavailable} {
+		sentinel := &Error{Code: code}
+		cause := Wrap(sql.ErrNoRows, code, "find")
+		for name, err := range layers(cause) {
+			if !errors.Is(err, sentinel) {
+				t.Errorf("%v %s: expected errors.Is to match the sentinel", code, name)
+			}
+			if !errors.Is(err, sql.ErrNoRows) {
+				t.Errorf("%v %s: expected errors.Is to find the cause", code, name)
+			}
+			for _, other := range []error{ErrInvalid, ErrNotFound, ErrConflict, ErrUnauthorized, ErrUnavailable} {
+				if other.(*Error).Code != code && errors.Is(err, other) {
+					t.Errorf("%v %s: expected errors.Is not to match %v", code, name, other)
+				}
+			}
+			var e *Error
+			if !errors.As(err, &e) || e.Op == "" {
+				t.Errorf("%v %s: expected errors.As to find an *Error", code, name)
+			}
+		}
+	}
+
+	// CodeOf finds the first code, outermost first.
+	tests := []struct {
+		err  error
+		want Code
+	}{
+		{nil, CodeUnknown},
+		{errors.New("plain"), CodeUnknown},
+		{fmt.Errorf("a: %w", New(CodeConflict, "op", "msg")), CodeConflict},
+		{Wrap(New(CodeNotFound, "inner", "msg"), CodeUnavailable, "outer"), CodeUnavailable},
+		{errors.Join(errors.New("plain"), New(CodeInvalid, "op", "msg")), CodeInvalid},
+	}
+	for _, tt := range tests {
+		if got := CodeOf(tt.err); got != tt.want {
+			t.Errorf("CodeOf(%v): expected %v, got %v", tt.err, tt.want, got)
+		}
+	}
+}
+
+func TestIs(t *testing.T) {
+	err := New(CodeNotFound, "find", "no user")
+
+	// Only bare sentinels match by code; errors with more
+	// detail only match themselves.
+	if errors.Is(err, New(CodeNotFound, "find", "no user")) {
+		t.Error("Expected another detailed error not to match")
+	}
+	if !errors.Is(err, err) {
+		t.Error("Expected an error to match itself")
+	}
+	if errors.Is(errors.New("not_found"), ErrNotFound) {
+		t.Error("Expected a plain error not to match a sentinel")
+	}
+}
+
+func TestAsStatusError(t *testing.T) {
+	// An *Error anywhere in the tree converts itself.
+	for name, err := range layers(New(CodeConflict, "create", "exists")) {
+		var se *StatusError
+		if !errors.As(err, &se) {
+			t.Errorf("%s: expected errors.As to convert to *StatusError", name)
+			continue
+		}
+		if name == "coded" {
+			// The outermost *Error has no code, which hides
+			// its message.
+			if se.Status != 500 || se.Msg != "Internal Server Error" {
+				t.Errorf("%s: expected a hidden 500, got %+v", name, se)
+			}
+			continue
+		}
+		if se.Status != 409 || se.Code != "conflict" || se.Msg != "create: exists" {
+			t.Errorf("%s: expected 409 conflict, got %+v", name, se)
+		}
+	}
+
+	// A *StatusError in the tree is found as it is.
+	direct := &StatusError{Status: 405, Code: "method", Msg: "no"}
+	var se *StatusError
+	if !errors.As(fmt.Errorf("x: %w", direct), &se) || se != direct {
+		t.Errorf("Expected to find the StatusError itself, got %+v", se)
+	}
+
+	// Other targets are left to errors.As.
+	var fe *FieldError
+	if errors.As(New(CodeInvalid, "op", "msg"), &fe) {
+		t.Error("Expected no FieldError")
+	}
+}
+
+func TestBatchError(t *testing.T) {
+	first := errors.New("first")
+	second := New(CodeInvalid, "op", "second")
+	err := &BatchError{Total: 5, Errs: []error{first, second}}
+
+	if got, want := err.Error(), "2 of 5 failed: first"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+	if !errors.Is(err, first) || !errors.Is(err, second) || !errors.Is(err, ErrInvalid) {
+		t.Error("Expected errors.Is to search every error of the batch")
+	}
+	var e *Error
+	if !errors.As(err, &e) || e != second {
+		t.Errorf("Expected errors.As to find the second error, got %v", e)
+	}
+}
+
+func TestEmptyBatchError(t *testing.T) {
+	err := &BatchError{Total: 5}
+	if got, want := err.Error(), "0 of 5 failed"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestStack(t *testing.T) {
+	_, err := getUser(99)
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("Expected an *Error, got %v", err)
+	}
+	stack := e.Stack()
+	if len(stack) < 3 {
+		t.Fatalf("Expected a stack, got %v", stack)
+	}
+	// The stack starts where Wrap was called.
+	for i, want := range []string{".findUser", ".getUser", ".TestStack"} {
+		if !strings.HasSuffix(stack[i].Function, want) {
+			t.Errorf("Frame %d: expected %s, got %s", i, want, stack[i].Function)
+		}
+	}
+	if !strings.HasSuffix(stack[0].File, ".go") || stack[0].Line == 0 {
+		t.Errorf("Expected a file and line, got %s:%d", stack[0].File, stack[0].Line)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := validate(User{Name: "ada", Email: "ada@example.com"}); err != nil {
+		t.Errorf("Expected a valid user, got %v", err)
+	}
+
+	err := validate(User{Email: "nope"})
+	if !errors.Is(err, ErrInvalid) {
+		t.Errorf("Expected an invalid error, got %v", err)
+	}
+	// errors.As only returns the first FieldError; the
+	// joined error holds them all.
+	var joined interface{ Unwrap() []error }
+	if !errors.As(err, &joined) {
+		t.Fatalf("Expected a joined error, got %v", err)
+	}
+	var fields []string
+	for _, e := range joined.Unwrap() {
+		var fe *FieldError
+		if errors.As(e, &fe) {
+			fields = append(fields, fe.Field)
+		}
+	}
+	if got := strings.Join(fields, ","); got != "name,email" {
+		t.Errorf("Expected name and email, got %s", got)
+	}
+}
+
+func TestImportUsers(t *testing.T) {
+	keepUsers(t)
+
+	if err := importUsers([]User{{10, "a", "a@x"}, {11, "b", "b@x"}}); err != nil {
+		t.Fatalf("Expected the import to work, got %v", err)
+	}
+	err := importUsers([]User{{10, "a", "a@x"}, {12, "c", "c@x"}, {13, "", "d@x"}})
+	var be *BatchError
+	if !errors.As(err, &be) || be.Total != 3 || len(be.Errs) != 2 {
+		t.Fatalf("Expected 2 of 3 to fail, got %v", err)
+	}
+	if !errors.Is(err, ErrConflict) || !errors.Is(err, ErrInvalid) || errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected a conflict and an invalid user, got %v", err)
+	}
+	if _, ok := users[12]; !ok {
+		t.Error("Expected the valid user to be created")
+	}
+}
+
+func TestWriteError(t *testing.T) {
+	tests := []struct {
+		err    error
+		status int
+		code   string
+		msg    string
+	}{
+		{fmt.Errorf("h: %w", New(CodeUnauthorized, "auth", "bad token")), 401, "unauthorized", "auth: bad token"},
+		{errors.New("connection refused to 10.0.0.5"), 500, "unknown", "Internal Server Error"},
+		{&StatusError{Status: 418, Code: "teapot", Msg: "short and stout"}, 418, "teapot", "short and stout"},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		WriteError(rec, tt.err)
+		if rec.Code != tt.status {
+			t.Errorf("%v: expected status %d, got %d", tt.err, tt.status, rec.Code)
+		}
+		if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Expected JSON, got %q", ct)
+		}
+		var body map[string]string
+		if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+			t.Fatalf("Expected a JSON body, got %q: %v", rec.Body.String(), err)
+		}
+		if body["code"] != tt.code || body["error"] != tt.msg {
+			t.Errorf("%v: expected %s %q, got %v", tt.err, tt.code, tt.msg, body)
+		}
+	}
+}
+
+func TestUserHandler(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /users/{id}", userHandler)
+	for path, status := range map[string]int{"/users/1": 200, "/users/2": 404, "/users/two": 400} {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != status {
+			t.Errorf("%s: expected %d, got %d", path, status, rec.Code)
+		}
+	}
+}
@@ -0,0 +1,370 @@
+// [Errors](errors) shows sentinel errors and wrapping
+// with `%w`, and [custom errors](custom-errors) a custom
+// error type found with `errors.As`. An API puts them
+// together: errors from deep down the stack are wrapped
+// on the way up, and at the top each one must still be
+// classified, e.g. to pick its HTTP status. Here we build
+// a small error hierarchy for that: an error type with a
+// code, errors that hold several errors, and the `Is`,
+// `As` and `Unwrap` methods that let the `errors`
+// package see through all of them.
+
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+)
+
+// Code classifies errors by what the caller can do about
+// them, rather than by what went wrong.
+type Code int
+
+const (
+	CodeUnknown Code = iota
+	CodeInvalid
+	CodeNotFound
+	CodeConflict
+	CodeUnauthorized
+	CodeUnavailable
+)
+
+func (c Code) String() string {
+	switch c {
+	case CodeInvalid:
+		return "invalid"
+	case CodeNotFound:
+		return "not_found"
+	case CodeConflict:
+		return "conflict"
+	case CodeUnauthorized:
+		return "unauthorized"
+	case CodeUnavailable:
+		return "unavailable"
+	default:
+		return "unknown"
+	}
+}
+
+// HTTPStatus maps a code to the HTTP status of a response
+// failing with it.
+func (c Code) HTTPStatus() int {
+	switch c {
+	case CodeInvalid:
+		return http.StatusBadRequest
+	case CodeNotFound:
+		return http.StatusNotFound
+	case CodeConflict:
+		return http.StatusConflict
+	case CodeUnauthorized:
+		return http.StatusUnauthorized
+	case CodeUnavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Error is an error with a code. `Op` names the operation
+// that failed, and `Err` is the error that caused it, if
+// any. It also records the stack where it was created.
+type Error struct {
+	Code Code
+	Op   string
+	Msg  string
+	Err  error
+
+	stack []uintptr
+}
+
+// New returns an error with code and message msg.
+func New(code Code, op, msg string) *Error {
+	return &Error{Code: code, Op: op, Msg: msg, stack: callers()}
+}
+
+// Wrap returns an error with code that wraps err.
+func Wrap(err error, code Code, op string) *Error {
+	return &Error{Code: code, Op: op, Err: err, stack: callers()}
+}
+
+// callers returns the stack of the function calling New
+// or Wrap. `runtime.Callers` skips itself, callers and
+// New or Wrap.
+func callers() []uintptr {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(3, pcs)
+	return pcs[:n]
+}
+
+// Error joins the parts that are set with colons, as
+// errors wrapped with `%w` do.
+func (e *Error) Error() string {
+	var parts []string
+	for _, s := range []string{e.Op, e.Msg} {
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	if e.Err != nil {
+		parts = append(parts, e.Err.Error())
+	}
+	if len(parts) == 0 {
+		return e.Code.String()
+	}
+	return strings.Join(parts, ": ")
+}
+
+// Unwrap lets `errors.Is` and `errors.As` look at the
+// cause too.
+func (e *Error) Unwrap() error { return e.Err }
+
+// Is makes the sentinels below match any *Error with
+// their code: `errors.Is(err, ErrNotFound)` reports
+// whether err, or any error it wraps, is not found.
+// `errors.Is` calls this for every error in the chain,
+// after comparing it with `==`.
+func (e *Error) Is(target error) bool {
+	// TODO: Return true if target is an *Error with the
+	// same Code and no Op, Msg or Err
+	return false
+}
+
+// As lets `errors.As` convert e to the *StatusError of
+// the HTTP layer, so that layer doesn't need to know
+// about codes. `errors.As` calls this for every error in
+// the chain that isn't of the target's type itself.
+func (e *Error) As(target any) bool {
+	// TODO: If target is a **StatusError, set *target to a
+	// StatusError with the status and name of e.Code and
+	// e's message, and return true. Errors without a code
+	// are bugs or outages the client can't act on, and
+	// their messages may reveal internals, so use
+	// http.StatusText(500) as their message instead
+	return false
+}
+
+// Stack returns the frames of the stack where e was
+// created, innermost first.
+func (e *Error) Stack() []runtime.Frame {
+	var stack []runtime.Frame
+	frames := runtime.CallersFrames(e.stack)
+	for {
+		f, more := frames.Next()
+		stack = append(stack, f)
+		if !more {
+			return stack
+		}
+	}
+}
+
+// The sentinels, one per code, to compare errors with
+// `errors.Is`.
+var (
+	ErrInvalid      = &Error{Code: CodeInvalid}
+	ErrNotFound     = &Error{Code: CodeNotFound}
+	ErrConflict     = &Error{Code: CodeConflict}
+	ErrUnauthorized = &Error{Code: CodeUnauthorized}
+	ErrUnavailable  = &Error{Code: CodeUnavailable}
+)
+
+// CodeOf returns the code of the first *Error in err's
+// tree, or CodeUnknown if there is none.
+func CodeOf(err error) Code {
+	// TODO: Find an *Error in err's tree with errors.As,
+	// and return its Code
+	return CodeUnknown
+}
+
+// StatusError is an error as the HTTP layer sees it: a
+// status, and a code and message for the client.
+// Handlers may return one directly, and an *Error turns
+// into one with `errors.As`.
+type StatusError struct {
+	Status int
+	Code   string
+	Msg    string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%d %s", e.Status, e.Msg)
+}
+
+// FieldError is a problem with one field of a request.
+// Validation collects them with `errors.Join`.
+type FieldError struct {
+	Field string
+	Msg   string
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + " " + e.Msg
+}
+
+// BatchError is the error of an operation on several
+// items, some of which failed. It holds the error of each
+// failed item, and its `Unwrap` method returns all of
+// them, so `errors.Is` and `errors.As` search each one.
+type BatchError struct {
+	Total int
+	Errs  []error
+}
+
+func (e *BatchError) Error() string {
+	if len(e.Errs) == 0 {
+		return fmt.Sprintf("0 of %d failed", e.Total)
+	}
+	return fmt.Sprintf("%d of %d failed: %v", len(e.Errs), e.Total, e.Errs[0])
+}
+
+func (e *BatchError) Unwrap() []error {
+	// TODO: Return the errors of the batch
+	return nil
+}
+
+// The rest is a small user service, whose errors go
+// through several layers before reaching a client.
+
+type User struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+var users = map[int]User{1: {1, "ada", "ada@example.com"}}
+
+// findUser is the storage layer. It turns the errors of
+// the database into coded errors.
+func findUser(id int) (User, error) {
+	u, ok := users[id]
+	if !ok {
+		return User{}, Wrap(sql.ErrNoRows, CodeNotFound, "findUser")
+	}
+	return u, nil
+}
+
+// getUser is the service layer, which adds context.
+func getUser(id int) (User, error) {
+	u, err := findUser(id)
+	if err != nil {
+		return User{}, fmt.Errorf("getting user %d: %w", id, err)
+	}
+	return u, nil
+}
+
+// validate reports every problem with u at once.
+func validate(u User) error {
+	var errs []error
+	if u.Name == "" {
+		errs = append(errs, &FieldError{"name", "is required"})
+	}
+	if !strings.Contains(u.Email, "@") {
+		errs = append(errs, &FieldError{"email", "is not an email address"})
+	}
+	// Join returns nil when there are no errors.
+	if err := errors.Join(errs...); err != nil {
+		return Wrap(err, CodeInvalid, "validate")
+	}
+	return nil
+}
+
+func createUser(u User) error {
+	if err := validate(u); err != nil {
+		return err
+	}
+	if _, ok := users[u.ID]; ok {
+		return New(CodeConflict, "createUser", fmt.Sprintf("user %d already exists", u.ID))
+	}
+	users[u.ID] = u
+	return nil
+}
+
+// importUsers creates every user it can, and reports the
+// ones it couldn't together.
+func importUsers(us []User) error {
+	var errs []error
+	for _, u := range us {
+		if err := createUser(u); err != nil {
+			errs = append(errs, fmt.Errorf("user %d: %w", u.ID, err))
+		}
+	}
+	if len(errs) > 0 {
+		return &BatchError{Total: len(us), Errs: errs}
+	}
+	return nil
+}
+
+// WriteError writes err as a JSON response. Its status
+// and message come from the first *StatusError in err's
+// tree, or from any *Error, which converts itself. Other
+// errors are internal server errors.
+func WriteError(w http.ResponseWriter, err error) {
+	se := &StatusError{
+		Status: http.StatusInternalServerError,
+		Code:   CodeUnknown.String(),
+		Msg:    http.StatusText(http.StatusInternalServerError),
+	}
+	errors.As(err, &se)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(se.Status)
+	json.NewEncoder(w).Encode(map[string]string{"code": se.Code, "error": se.Msg})
+}
+
+// userHandler serves `GET /users/{id}`.
+func userHandler(w http.ResponseWriter, req *http.Request) {
+	var id int
+	if _, err := fmt.Sscan(req.PathValue("id"), &id); err != nil {
+		WriteError(w, Wrap(err, CodeInvalid, "parsing id"))
+		return
+	}
+	u, err := getUser(id)
+	if err != nil {
+		WriteError(w, fmt.Errorf("handling %s: %w", req.URL.Path, err))
+		return
+	}
+	json.NewEncoder(w).Encode(u)
+}
+
+func main() {
+	// The error of findUser went through getUser
+	// unchanged, but is still classified as not found.
+	_, err := getUser(7)
+	fmt.Println(err)
+	fmt.Println("not found:", errors.Is(err, ErrNotFound), "no rows:", errors.Is(err, sql.ErrNoRows))
+	fmt.Println("code:", CodeOf(err), "status:", CodeOf(err).HTTPStatus())
+
+	// The *Error knows where it was created. Function
+	// names include their package path.
+	var e *Error
+	if errors.As(err, &e) {
+		fn := e.Stack()[0].Function
+		fmt.Println("created in", fn[strings.LastIndex(fn, ".")+1:])
+	}
+
+	// Validation errors hold every field error.
+	err = createUser(User{ID: 2, Email: "bob"})
+	fmt.Println(err)
+	var fe *FieldError
+	if errors.As(err, &fe) {
+		fmt.Println("first bad field:", fe.Field)
+	}
+
+	// A batch is classified by the errors it holds.
+	err = importUsers([]User{{3, "cy", "cy@example.com"}, {1, "ada", "ada@example.com"}, {4, "", "dee"}})
+	fmt.Println(err)
+	fmt.Println("conflict:", errors.Is(err, ErrConflict), "invalid:", errors.Is(err, ErrInvalid), "not found:", errors.Is(err, ErrNotFound))
+
+	// At the top, the handler turns errors into responses.
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /users/{id}", userHandler)
+	for _, path := range []string{"/users/1", "/users/7", "/users/x"} {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		fmt.Print(path, " ", rec.Code, " ", rec.Body.String())
+	}
+}
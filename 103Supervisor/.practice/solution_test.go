@@ -0,0 +1,363 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+// run runs sup, failing the test instead of hanging if
+// it doesn't return.
+func run(t *testing.T, ctx context.Context, sup *Supervisor, children ...Child) error {
+	t.Helper()
+	errc := make(chan error, 1)
+	go func() { errc <- sup.Run(ctx, children...) }()
+	select {
+	case err := <-errc:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run didn't return")
+		return nil
+	}
+}
+
+// failures records the errors of failed children.
+type failures struct {
+	errs []error
+}
+
+func (f *failures) add(child string, err error) { f.errs = append(f.errs, err) }
+
+// panicky is a child that panics with v on its first n
+// runs, and then returns nil. It counts its runs.
+func panicky(runs *atomic.Int32, n int32, v any) func(context.Context) error {
+	return func(ctx context.Context) error {
+		if runs.Add(1) <= n {
+			panic(v)
+		}
+		return nil
+	}
+}
+
+func TestProtect(t *testing.T) {
+	if err := protect(context.Background(), func(context.Context) error { return nil }); err != nil {
+		t.Errorf("Expected nil, got %v", err)
+	}
+	failure := errors.New("failure")
+	if err := protect(context.Background(), func(context.Context) error { return failure }); err != failure {
+		t.Errorf("Expected the error returned, got %v", err)
+	}
+
+	err := protect(context.Background(), func(context.Context) error { mayPanic(); return nil })
+	var p *PanicError
+	if !errors.As(err, &p) || p.Value != "a problem" {
+		t.Fatalf("Expected a PanicError with the value panicked with, got %#v", err)
+	}
+	if err.Error() != "panic: a problem" {
+		t.Errorf("Expected %q, got %q", "panic: a problem", err.Error())
+	}
+	// The stack shows where the panic happened.
+	if !strings.Contains(string(p.Stack), "mayPanic") {
+		t.Errorf("Expected the stack to contain mayPanic, got:\n%s", p.Stack)
+	}
+
+	// Errors panicked with are unwrapped.
+	err = protect(context.Background(), func(context.Context) error { panic(failure) })
+	if !errors.Is(err, failure) {
+		t.Errorf("Expected the error panicked with to be wrapped, got %v", err)
+	}
+}
+
+func mayPanic() {
+	panic("a problem")
+}
+
+func TestDelay(t *testing.T) {
+	s := &Supervisor{Backoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+	want := []time.Duration{10, 20, 40, 50, 50, 50}
+	for i, w := range want {
+		if got := s.delay(i + 1); got != w*time.Millisecond {
+			t.Errorf("delay(%d): expected %v, got %v", i+1, w*time.Millisecond, got)
+		}
+	}
+	s.MaxBackoff = 0
+	if got := s.delay(8); got != 1280*time.Millisecond {
+		t.Errorf("Expected no limit without MaxBackoff, got %v", got)
+	}
+	s.Backoff = 0
+	if got := s.delay(3); got != 0 {
+		t.Errorf("Expected no backoff, got %v", got)
+	}
+}
+
+func TestOneForOne(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	var f failures
+	sup := &Supervisor{MaxRestarts: 5, Period: time.Minute, OnFailure: f.add}
+
+	var flaky, steady atomic.Int32
+	err := run(t, context.Background(), sup,
+		Child{"flaky", panicky(&flaky, 3, "boom")},
+		Child{"steady", func(ctx context.Context) error {
+			steady.Add(1)
+			return nil
+		}},
+	)
+	if err != nil {
+		t.Fatalf("Expected the children to finish, got %v", err)
+	}
+	if flaky.Load() != 4 {
+		t.Errorf("Expected flaky to run 4 times, got %d", flaky.Load())
+	}
+	// Only the child that failed is restarted.
+	if steady.Load() != 1 {
+		t.Errorf("Expected steady to run once, got %d", steady.Load())
+	}
+	if len(f.errs) != 3 {
+		t.Fatalf("Expected 3 failures, got %v", f.errs)
+	}
+	for _, err := range f.errs {
+		var p *PanicError
+		if !errors.As(err, &p) || p.Value != "boom" {
+			t.Errorf("Expected a PanicError, got %v", err)
+		}
+	}
+}
+
+func TestOneForAll(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	var f failures
+	sup := &Supervisor{Strategy: OneForAll, MaxRestarts: 5, Period: time.Minute, OnFailure: f.add}
+
+	// worker waits for ctx. It returns nil from its second
+	// run on, when it sees a run of flaky that doesn't
+	// panic, so the supervisor ends.
+	var flaky, worker, stopped atomic.Int32
+	ok := make(chan struct{})
+	err := run(t, context.Background(), sup,
+		Child{"flaky", func(ctx context.Context) error {
+			if flaky.Add(1) <= 2 {
+				time.Sleep(10 * time.Millisecond)
+				panic("boom")
+			}
+			close(ok)
+			return nil
+		}},
+		Child{"worker", func(ctx context.Context) error {
+			worker.Add(1)
+			select {
+			case <-ok:
+				return nil
+			case <-ctx.Done():
+				stopped.Add(1)
+				return ctx.Err()
+			}
+		}},
+	)
+	if err != nil {
+		t.Fatalf("Expected the children to finish, got %v", err)
+	}
+	if flaky.Load() != 3 || worker.Load() != 3 {
+		t.Errorf("Expected both children to run 3 times, got %d and %d", flaky.Load(), worker.Load())
+	}
+	// The worker was stopped for each restart, and its
+	// error then isn't a failure.
+	if stopped.Load() != 2 {
+		t.Errorf("Expected the worker to be stopped twice, got %d", stopped.Load())
+	}
+	if len(f.errs) != 2 {
+		t.Errorf("Expected 2 failures, got %v", f.errs)
+	}
+}
+
+func TestOneForAllSkipsDoneChildren(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	sup := &Supervisor{Strategy: OneForAll, MaxRestarts: 5, Period: time.Minute, OnFailure: (&failures{}).add}
+
+	// once is done before flaky fails, so it isn't started
+	// again.
+	var once, flaky atomic.Int32
+	done := make(chan struct{})
+	err := run(t, context.Background(), sup,
+		Child{"once", func(ctx context.Context) error {
+			once.Add(1)
+			close(done)
+			return nil
+		}},
+		Child{"flaky", func(ctx context.Context) error {
+			<-done
+			if flaky.Add(1) == 1 {
+				panic("boom")
+			}
+			return nil
+		}},
+	)
+	if err != nil {
+		t.Fatalf("Expected the children to finish, got %v", err)
+	}
+	if once.Load() != 1 || flaky.Load() != 2 {
+		t.Errorf("Expected 1 and 2 runs, got %d and %d", once.Load(), flaky.Load())
+	}
+}
+
+func TestTooManyRestarts(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	for _, strategy := range []Strategy{OneForOne, OneForAll} {
+		var f failures
+		sup := &Supervisor{Strategy: strategy, MaxRestarts: 3, Period: time.Minute, OnFailure: f.add}
+
+		var crashy, sibling atomic.Int32
+		err := run(t, context.Background(), sup,
+			Child{"crashy", panicky(&crashy, 100, "always")},
+			Child{"sibling", func(ctx context.Context) error {
+				sibling.Add(1)
+				<-ctx.Done()
+				return ctx.Err()
+			}},
+		)
+		if !errors.Is(err, ErrTooManyRestarts) {
+			t.Fatalf("Expected ErrTooManyRestarts, got %v", err)
+		}
+		var p *PanicError
+		if !errors.As(err, &p) || p.Value != "always" {
+			t.Errorf("Expected the last failure to be wrapped, got %v", err)
+		}
+		if want := "too many restarts: crashy: panic: always"; err.Error() != want {
+			t.Errorf("Expected %q, got %q", want, err.Error())
+		}
+		// The first run and MaxRestarts restarts.
+		if crashy.Load() != 4 || len(f.errs) != 4 {
+			t.Errorf("Expected 4 runs and failures, got %d and %d", crashy.Load(), len(f.errs))
+		}
+		wantSibling := int32(1)
+		if strategy == OneForAll {
+			wantSibling = 4
+		}
+		if sibling.Load() != wantSibling {
+			t.Errorf("Expected the sibling to run %d times, got %d", wantSibling, sibling.Load())
+		}
+	}
+}
+
+func TestRestartsOutsidePeriod(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	sup := &Supervisor{MaxRestarts: 1, Period: 20 * time.Millisecond, OnFailure: (&failures{}).add}
+
+	// Failures further apart than the period never add up
+	// to more than one restart in a period.
+	var runs atomic.Int32
+	err := run(t, context.Background(), sup, Child{"slow", func(ctx context.Context) error {
+		if runs.Add(1) > 5 {
+			return nil
+		}
+		time.Sleep(30 * time.Millisecond)
+		panic("boom")
+	}})
+	if err != nil {
+		t.Errorf("Expected the child to finish, got %v", err)
+	}
+	if runs.Load() != 6 {
+		t.Errorf("Expected 6 runs, got %d", runs.Load())
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	backoff := 20 * time.Millisecond
+	sup := &Supervisor{MaxRestarts: 5, Period: time.Minute, Backoff: backoff, OnFailure: (&failures{}).add}
+
+	var starts []time.Time
+	err := run(t, context.Background(), sup, Child{"flaky", func(ctx context.Context) error {
+		starts = append(starts, time.Now())
+		if len(starts) <= 3 {
+			panic("boom")
+		}
+		return nil
+	}})
+	if err != nil {
+		t.Fatalf("Expected the child to finish, got %v", err)
+	}
+	if len(starts) != 4 {
+		t.Fatalf("Expected 4 runs, got %d", len(starts))
+	}
+	// The waits double: 20ms, 40ms and 80ms.
+	for i := 1; i < len(starts); i++ {
+		want := backoff << (i - 1)
+		if got := starts[i].Sub(starts[i-1]); got < want {
+			t.Errorf("Expected restart %d after at least %v, got %v", i, want, got)
+		}
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	sup := &Supervisor{MaxRestarts: 100, Period: time.Minute, Backoff: time.Hour, OnFailure: (&failures{}).add}
+
+	// One child waits for ctx, and the other waits an hour
+	// before its restart. Cancelling ctx stops both.
+	ctx, cancel := context.WithCancelCause(context.Background())
+	var stopped atomic.Bool
+	started := make(chan struct{})
+	go func() {
+		<-started
+		time.Sleep(10 * time.Millisecond)
+		cancel(errors.New("shutting down"))
+	}()
+	err := run(t, ctx, sup,
+		Child{"waiter", func(ctx context.Context) error {
+			<-ctx.Done()
+			stopped.Store(true)
+			return ctx.Err()
+		}},
+		Child{"crashy", func(ctx context.Context) error {
+			close(started)
+			panic("boom")
+		}},
+	)
+	if err == nil || err.Error() != "shutting down" {
+		t.Errorf("Expected the cause of ctx, got %v", err)
+	}
+	if !stopped.Load() {
+		t.Error("Expected the waiter to return before Run")
+	}
+
+	// A supervisor whose ctx is already done returns at
+	// once.
+	if err := run(t, ctx, sup, Child{"waiter", func(ctx context.Context) error {
+		<-ctx.Done()
+		return nil
+	}}); err == nil {
+		t.Error("Expected an error")
+	}
+}
+
+func TestLogsPanicsWithStack(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	sup := &Supervisor{MaxRestarts: 1, Period: time.Minute}
+	var runs atomic.Int32
+	run(t, context.Background(), sup,
+		Child{"crashy", func(ctx context.Context) error {
+			if runs.Add(1) == 1 {
+				mayPanic()
+			}
+			return errors.New("failure")
+		}},
+	)
+	out := buf.String()
+	for _, want := range []string{"supervisor: crashy panicked: a problem\n", "mayPanic", "supervisor: crashy failed: failure\n"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected the log to contain %q, got:\n%s", want, out)
+		}
+	}
+}
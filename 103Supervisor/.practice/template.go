@@ -0,0 +1,305 @@
+// [Panic](panic) stops a program, and [recover](recover)
+// stops a panic in a deferred function. A server can't
+// wrap every goroutine it starts by hand, and a goroutine
+// that panicked, or failed, usually has to be started
+// again for the server to keep working. Here we build a
+// _supervisor_, after the ones of Erlang: it runs
+// goroutines, recovers their panics with the stack they
+// happened at, and restarts them with backoff, either
+// one at a time or all together. If they keep failing, it
+// gives up instead of restarting them forever.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+// Child is a goroutine the supervisor runs. Run should
+// return when ctx is done. A child that returns nil is
+// done and isn't restarted; one that returns an error or
+// panics is.
+type Child struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+// Strategy says which children restart when one fails.
+type Strategy int
+
+const (
+	// OneForOne restarts only the child that failed, for
+	// children that don't depend on each other.
+	OneForOne Strategy = iota
+
+	// OneForAll stops every other child and restarts them
+	// all, for children that can't work without each
+	// other.
+	OneForAll
+)
+
+// PanicError is the error of a child that panicked. Its
+// `Stack` is the stack of the goroutine at the panic.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the value panicked with, if it's an
+// error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// ErrTooManyRestarts is returned by Run when children
+// fail more often than the supervisor allows.
+var ErrTooManyRestarts = errors.New("too many restarts")
+
+// Supervisor runs children and restarts them when they
+// fail.
+type Supervisor struct {
+	Strategy Strategy
+
+	// If more than MaxRestarts restarts happen within
+	// Period, the supervisor stops every child and gives
+	// up: restarting them again is unlikely to help.
+	MaxRestarts int
+	Period      time.Duration
+
+	// Backoff is the wait before a restart. It doubles
+	// with every restart in the current Period, up to
+	// MaxBackoff if that is set.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+
+	// OnFailure is called with the error of every child
+	// that fails. If it's nil, failures are logged with
+	// the standard logger, along with the stack of panics.
+	OnFailure func(child string, err error)
+}
+
+// protect runs fn, and turns a panic into a *PanicError.
+// `debug.Stack` is called in the deferred function, which
+// runs on top of the stack that panicked, so the stack it
+// returns shows where the panic happened.
+func protect(ctx context.Context, fn func(context.Context) error) (err error) {
+	// TODO: Defer a function that calls recover and, if it
+	// returns a value, sets err to a *PanicError with that
+	// value and debug.Stack().
+	return fn(ctx)
+}
+
+// sleep waits for d, or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	}
+}
+
+// delay returns the backoff before the n-th restart
+// within a period, counting from 1.
+func (s *Supervisor) delay(n int) time.Duration {
+	// TODO: Start from s.Backoff and double it n-1 times,
+	// but return at most s.MaxBackoff if that is set.
+	return s.Backoff
+}
+
+func (s *Supervisor) failed(child string, err error) {
+	if s.OnFailure != nil {
+		s.OnFailure(child, err)
+		return
+	}
+	if p, ok := err.(*PanicError); ok {
+		log.Printf("supervisor: %s panicked: %v\n%s", child, p.Value, p.Stack)
+		return
+	}
+	log.Printf("supervisor: %s failed: %v", child, err)
+}
+
+// exit is what a child's goroutine reports when it ends.
+type exit struct {
+	i   int
+	err error
+}
+
+// Run runs children until they are all done, ctx is done,
+// or they fail too often. It returns nil, the cause of
+// ctx, or an error wrapping ErrTooManyRestarts and the
+// last failure. Either way, every child has returned by
+// then.
+func (s *Supervisor) Run(ctx context.Context, children ...Child) error {
+	exits := make(chan exit)
+	cancels := make([]context.CancelFunc, len(children))
+	done := make([]bool, len(children))
+	running := 0
+
+	// start runs child i after waiting for d, unless it's
+	// stopped while waiting. Each run gets a context of
+	// its own, so one child can be stopped without the
+	// others.
+	start := func(i int, d time.Duration) {
+		cctx, cancel := context.WithCancel(ctx)
+		cancels[i] = cancel
+		running++
+		go func() {
+			defer cancel()
+			var err error
+			if d > 0 {
+				err = sleep(cctx, d)
+			}
+			if err == nil {
+				err = protect(cctx, children[i].Run)
+			}
+			exits <- exit{i, err}
+		}()
+	}
+	var (
+		result   error
+		stopping bool
+	)
+	stop := func(err error) {
+		if !stopping {
+			stopping, result = true, err
+			for _, cancel := range cancels {
+				cancel()
+			}
+		}
+	}
+	for i := range children {
+		start(i, 0)
+	}
+
+	var (
+		restarts []time.Time
+		// restartAll is set while a OneForAll supervisor
+		// waits for the other children to stop.
+		restartAll bool
+		ctxDone    = ctx.Done()
+	)
+	for running > 0 {
+		select {
+		case <-ctxDone:
+			// A nil channel blocks, so this case runs once.
+			ctxDone = nil
+			stop(context.Cause(ctx))
+		case ex := <-exits:
+			running--
+			// A child may see ctx done, and return, before
+			// we do. Its error isn't a failure then.
+			if ctx.Err() != nil {
+				stop(context.Cause(ctx))
+			}
+			switch {
+			case stopping:
+			case restartAll:
+				// The children stopped for the restart.
+				// Whatever they returned, they run again.
+			case ex.err == nil:
+				done[ex.i] = true
+			default:
+				// TODO: The child failed. Report it with
+				// s.failed, then drop the times in restarts
+				// older than s.Period and append time.Now().
+				// With more than s.MaxRestarts restarts left,
+				// stop with fmt.Errorf("%w: %s: %w",
+				// ErrTooManyRestarts, the child's name, ex.err).
+				// Otherwise, with OneForOne, start the child
+				// again after s.delay(len(restarts)); with
+				// OneForAll, set restartAll and cancel every
+				// child.
+				stop(errors.New("not implemented"))
+			}
+			if restartAll && running == 0 {
+				restartAll = false
+				for i := range children {
+					if !done[i] {
+						start(i, s.delay(len(restarts)))
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+func main() {
+	report := func(child string, err error) {
+		fmt.Printf("%s failed: %v\n", child, err)
+	}
+
+	// One for one: flaky panics twice before it works,
+	// and steady isn't disturbed.
+	flakyRuns := 0
+	sup := &Supervisor{
+		Strategy:    OneForOne,
+		MaxRestarts: 3,
+		Period:      time.Second,
+		Backoff:     10 * time.Millisecond,
+		MaxBackoff:  100 * time.Millisecond,
+		OnFailure:   report,
+	}
+	err := sup.Run(context.Background(),
+		Child{"flaky", func(ctx context.Context) error {
+			flakyRuns++
+			fmt.Println("flaky: run", flakyRuns)
+			if flakyRuns < 3 {
+				panic("boom")
+			}
+			return nil
+		}},
+		Child{"steady", func(ctx context.Context) error {
+			fmt.Println("steady: run")
+			return nil
+		}},
+	)
+	fmt.Println("one for one:", err)
+
+	// One for all: when db fails, cache is stopped and
+	// both start again. The supervisor runs until its
+	// context times out.
+	dbRuns := 0
+	sup.Strategy = OneForAll
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	err = sup.Run(ctx,
+		Child{"db", func(ctx context.Context) error {
+			dbRuns++
+			fmt.Println("db: run", dbRuns)
+			if dbRuns == 1 {
+				return errors.New("connection lost")
+			}
+			<-ctx.Done()
+			return nil
+		}},
+		Child{"cache", func(ctx context.Context) error {
+			fmt.Println("cache: started")
+			<-ctx.Done()
+			fmt.Println("cache: stopped")
+			return ctx.Err()
+		}},
+	)
+	fmt.Println("one for all:", err)
+
+	// A child that always panics is restarted
+	// MaxRestarts times, then the supervisor gives up.
+	sup.MaxRestarts = 2
+	err = sup.Run(context.Background(), Child{"crashy", func(ctx context.Context) error {
+		panic("always")
+	}})
+	fmt.Println("gave up:", errors.Is(err, ErrTooManyRestarts))
+	fmt.Println(err)
+}
@@ -0,0 +1,339 @@
+// The [HTTP server](http-server) example registers two
+// handlers on the default router and serves them with
+// `ListenAndServe`, which has no timeouts, and stops only
+// when the process is killed, cutting off the requests
+// in flight. Here we serve the same handlers, and a small
+// users API, the way a production server would: routes
+// match methods and path patterns, a chain of
+// _middleware_ gives every request an ID, logs it,
+// recovers from its panics and limits how long it can
+// take, and a signal, as in the [signals](signals)
+// example, shuts the server down gracefully.
+
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"runtime/debug"
+	"slices"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// hello and headers are the handlers of the HTTP server
+// example. headers sorts the header names, so that its
+// output is the same for the same request.
+func hello(w http.ResponseWriter, req *http.Request) {
+	fmt.Fprintf(w, "hello\n")
+}
+
+func headers(w http.ResponseWriter, req *http.Request) {
+	for _, name := range slices.Sorted(maps.Keys(req.Header)) {
+		for _, h := range req.Header[name] {
+			fmt.Fprintf(w, "%v: %v\n", name, h)
+		}
+	}
+}
+
+type User struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// userStore keeps users in memory. Handlers run
+// concurrently, so it's locked.
+type userStore struct {
+	mu    sync.RWMutex
+	users map[int]User
+	next  int
+}
+
+func newUserStore() *userStore {
+	return &userStore{users: make(map[int]User), next: 1}
+}
+
+// writeJSON writes v as a JSON response with status.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
+
+// get serves `GET /users/{id}`. `PathValue` returns the
+// part of the path matched by `{id}` in the pattern.
+func (s *userStore) get(w http.ResponseWriter, req *http.Request) {
+	id, err := strconv.Atoi(req.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+	s.mu.RLock()
+	u, ok := s.users[id]
+	s.mu.RUnlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, "user not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, u)
+}
+
+// create serves `POST /users`. `MaxBytesReader` stops
+// clients from sending bodies of any size.
+func (s *userStore) create(w http.ResponseWriter, req *http.Request) {
+	var u User
+	req.Body = http.MaxBytesReader(w, req.Body, 1<<20)
+	if err := json.NewDecoder(req.Body).Decode(&u); err != nil || u.Name == "" {
+		writeError(w, http.StatusBadRequest, "a user needs a name")
+		return
+	}
+	s.mu.Lock()
+	u.ID = s.next
+	s.next++
+	s.users[u.ID] = u
+	s.mu.Unlock()
+	w.Header().Set("Location", fmt.Sprintf("/users/%d", u.ID))
+	writeJSON(w, http.StatusCreated, u)
+}
+
+// routes returns the server's router. Since Go 1.22,
+// patterns can start with a method, which then is the
+// only one they match, and contain wildcards like `{id}`.
+// A request for a path with a pattern for other methods
+// gets a 405 response that lists them. The `{$}` of the
+// last pattern makes it match `/` only, instead of every
+// path.
+func routes(users *userStore) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /hello", hello)
+	mux.HandleFunc("GET /headers", headers)
+	mux.HandleFunc("GET /users/{id}", users.get)
+	mux.HandleFunc("POST /users", users.create)
+	mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintln(w, "try /hello, /headers or /users/1")
+	})
+	return mux
+}
+
+// Middleware wraps a handler in another one, which does
+// something before or after calling it.
+type Middleware func(http.Handler) http.Handler
+
+// Chain wraps h in mws, the first one outermost: requests
+// go through them in order before reaching h.
+func Chain(h http.Handler, mws ...Middleware) http.Handler {
+	for _, mw := range slices.Backward(mws) {
+		h = mw(h)
+	}
+	return h
+}
+
+// requestIDKey is the context key of request IDs. Keys of
+// unexported types can't collide with other packages'.
+type requestIDKey struct{}
+
+// RequestIDFrom returns the ID of the request of ctx.
+func RequestIDFrom(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// RequestID gives every request an ID, which handlers
+// get with RequestIDFrom and clients in the `X-Request-ID`
+// response header. A request that already has one, e.g.
+// from a proxy in front of the server, keeps it, so that
+// the logs of both can be matched.
+func RequestID(newID func() string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			id := req.Header.Get("X-Request-ID")
+			if id == "" {
+				id = newID()
+			}
+			w.Header().Set("X-Request-ID", id)
+			ctx := context.WithValue(req.Context(), requestIDKey{}, id)
+			next.ServeHTTP(w, req.WithContext(ctx))
+		})
+	}
+}
+
+// statusRecorder remembers the status and size of a
+// response, for logging.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	n, err := r.ResponseWriter.Write(b)
+	r.bytes += n
+	return n, err
+}
+
+// Unwrap lets `http.ResponseController` reach the
+// wrapped writer, e.g. to flush it.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// Logging logs every request once it's served.
+func Logging(logger *slog.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			start := time.Now()
+			rec := &statusRecorder{ResponseWriter: w}
+			next.ServeHTTP(rec, req)
+			if rec.status == 0 {
+				rec.status = http.StatusOK
+			}
+			logger.InfoContext(req.Context(), "request",
+				"id", RequestIDFrom(req.Context()),
+				"method", req.Method,
+				"path", req.URL.Path,
+				"status", rec.status,
+				"bytes", rec.bytes,
+				"duration", time.Since(start),
+			)
+		})
+	}
+}
+
+// Recover turns a panic in a handler into a 500 response,
+// and logs it with its stack. `net/http` would recover it
+// too, but would close the connection without a response.
+// `http.ErrAbortHandler` is the panic that aborts a
+// response on purpose, so it's left to `net/http`.
+func Recover(logger *slog.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			defer func() {
+				v := recover()
+				if v == nil {
+					return
+				}
+				if v == http.ErrAbortHandler {
+					panic(v)
+				}
+				logger.ErrorContext(req.Context(), "panic",
+					"id", RequestIDFrom(req.Context()),
+					"value", v,
+					"stack", string(debug.Stack()),
+				)
+				writeError(w, http.StatusInternalServerError, "internal error")
+			}()
+			next.ServeHTTP(w, req)
+		})
+	}
+}
+
+// Timeout answers requests that take longer than d with
+// 503 Service Unavailable, and cancels their context, so
+// handlers should stop working when it's done.
+// `http.TimeoutHandler` buffers the response until the
+// handler returns, to replace it on timeout; it re-panics
+// the panics of the handler for Recover.
+func Timeout(d time.Duration) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.TimeoutHandler(next, d, "request timed out\n")
+	}
+}
+
+// newServer returns a server for h. Its timeouts stop
+// slow or idle clients from holding connections forever;
+// the write timeout has to be longer than that of the
+// Timeout middleware, for its 503 responses to be sent.
+func newServer(h http.Handler, logger *slog.Logger) *http.Server {
+	return &http.Server{
+		Handler:           h,
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		WriteTimeout:      15 * time.Second,
+		IdleTimeout:       time.Minute,
+		ErrorLog:          slog.NewLogLogger(logger.Handler(), slog.LevelError),
+	}
+}
+
+// serve serves srv on ln until a signal arrives on sigs.
+// Then `Shutdown` closes ln, closes idle connections, and
+// waits up to grace for the requests in flight to finish.
+// `Serve` returns `http.ErrServerClosed` as soon as
+// `Shutdown` is called, so serve waits for `Shutdown`
+// instead.
+func serve(srv *http.Server, ln net.Listener, sigs <-chan os.Signal, grace time.Duration) error {
+	errc := make(chan error, 1)
+	go func() { errc <- srv.Serve(ln) }()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-sigs:
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), grace)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		// The requests still running are cut off.
+		srv.Close()
+		return fmt.Errorf("shutdown: %w", err)
+	}
+	if err := <-errc; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+
+	handler := Chain(routes(newUserStore()),
+		RequestID(rand.Text),
+		Logging(logger),
+		Recover(logger),
+		Timeout(10*time.Second),
+	)
+	srv := newServer(handler, logger)
+
+	ln, err := net.Listen("tcp", ":8090")
+	if err != nil {
+		logger.Error("listen", "err", err)
+		os.Exit(1)
+	}
+
+	// As in the signals example, SIGINT and SIGTERM are
+	// delivered on a channel.
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	logger.Info("listening", "addr", ln.Addr().String())
+	if err := serve(srv, ln, sigs, 10*time.Second); err != nil {
+		logger.Error("serve", "err", err)
+		os.Exit(1)
+	}
+	logger.Info("stopped")
+}
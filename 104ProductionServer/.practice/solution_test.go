@@ -0,0 +1,438 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+// jsonLogger returns a logger writing JSON to buf.
+func jsonLogger(buf *bytes.Buffer) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(buf, nil))
+}
+
+// decode parses the lines written by a JSON handler.
+func decode(t *testing.T, out string) []map[string]any {
+	t.Helper()
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\n"), "\n") {
+		var m map[string]any
+		if err := json.Unmarshal([]byte(line), &m); err != nil {
+			t.Fatalf("Expected a JSON object, got %q: %v", line, err)
+		}
+		records = append(records, m)
+	}
+	return records
+}
+
+// do sends a request to h and returns the response.
+func do(h http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(method, path, strings.NewReader(body)))
+	return rec
+}
+
+// mustNotPanic is do for handlers behind Recover, which
+// fails the test instead of crashing it if a panic gets
+// through.
+func mustNotPanic(t *testing.T, h http.Handler, method, path string) (rec *httptest.ResponseRecorder) {
+	t.Helper()
+	defer func() {
+		if v := recover(); v != nil {
+			t.Errorf("Expected the panic to be recovered, got %v", v)
+			rec = httptest.NewRecorder()
+		}
+	}()
+	return do(h, method, path, "")
+}
+
+func TestHello(t *testing.T) {
+	rec := do(http.HandlerFunc(hello), http.MethodGet, "/hello", "")
+	if rec.Code != http.StatusOK || rec.Body.String() != "hello\n" {
+		t.Errorf("Expected 200 %q, got %d %q", "hello\n", rec.Code, rec.Body.String())
+	}
+}
+
+func TestHeaders(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/headers", nil)
+	req.Header.Set("User-Agent", "curl/8.0")
+	req.Header.Add("Accept", "text/plain")
+	req.Header.Add("Accept", "application/json")
+	rec := httptest.NewRecorder()
+	headers(rec, req)
+
+	want := "Accept: text/plain\nAccept: application/json\nUser-Agent: curl/8.0\n"
+	if rec.Body.String() != want {
+		t.Errorf("Expected %q, got %q", want, rec.Body.String())
+	}
+}
+
+func TestRoutes(t *testing.T) {
+	h := routes(newUserStore())
+	tests := []struct {
+		method, path, body string
+		code               int
+		want               string
+	}{
+		{"GET", "/hello", "", 200, "hello\n"},
+		{"HEAD", "/hello", "", 200, "hello\n"},
+		{"GET", "/", "", 200, "try /hello, /headers or /users/1\n"},
+		{"GET", "/nothing", "", 404, "404 page not found\n"},
+		{"GET", "/users/1", "", 404, `{"error":"user not found"}` + "\n"},
+		{"GET", "/users/x", "", 400, `{"error":"invalid user id"}` + "\n"},
+		{"POST", "/users", `{"name":"ada"}`, 201, `{"id":1,"name":"ada"}` + "\n"},
+		{"POST", "/users", `{"name":"bob"}`, 201, `{"id":2,"name":"bob"}` + "\n"},
+		{"POST", "/users", `{}`, 400, `{"error":"a user needs a name"}` + "\n"},
+		{"POST", "/users", `{"name":`, 400, `{"error":"a user needs a name"}` + "\n"},
+		{"POST", "/users", `{"name":"` + strings.Repeat("x", 1<<20) + `"}`, 400, `{"error":"a user needs a name"}` + "\n"},
+		{"GET", "/users/2", "", 200, `{"id":2,"name":"bob"}` + "\n"},
+		{"GET", "/users/2/posts", "", 404, "404 page not found\n"},
+	}
+	for _, tt := range tests {
+		rec := do(h, tt.method, tt.path, tt.body)
+		if rec.Code != tt.code || rec.Body.String() != tt.want {
+			t.Errorf("%s %s: expected %d %q, got %d %q", tt.method, tt.path, tt.code, tt.want, rec.Code, rec.Body.String())
+		}
+	}
+
+	rec := do(h, "POST", "/users", `{"name":"cy"}`)
+	if loc := rec.Header().Get("Location"); loc != "/users/3" {
+		t.Errorf("Expected Location /users/3, got %q", loc)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Expected a JSON response, got %q", ct)
+	}
+
+	// Patterns with a method answer other methods with 405
+	// and the methods they allow.
+	rec = do(h, "DELETE", "/users/1", "")
+	if rec.Code != http.StatusMethodNotAllowed || rec.Header().Get("Allow") != "GET, HEAD" {
+		t.Errorf("Expected 405 allowing GET, HEAD, got %d %q", rec.Code, rec.Header().Get("Allow"))
+	}
+}
+
+func TestChain(t *testing.T) {
+	var calls []string
+	mw := func(name string) Middleware {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				calls = append(calls, name+" before")
+				next.ServeHTTP(w, req)
+				calls = append(calls, name+" after")
+			})
+		}
+	}
+	h := Chain(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		calls = append(calls, "handler")
+	}), mw("a"), mw("b"))
+	do(h, "GET", "/", "")
+	want := "a before,b before,handler,b after,a after"
+	if got := strings.Join(calls, ","); got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	var seen string
+	h := RequestID(func() string { return "generated" })(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		seen = RequestIDFrom(req.Context())
+	}))
+
+	rec := do(h, "GET", "/", "")
+	if seen != "generated" || rec.Header().Get("X-Request-ID") != "generated" {
+		t.Errorf("Expected a generated ID, got %q and %q", seen, rec.Header().Get("X-Request-ID"))
+	}
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("X-Request-ID", "from-proxy")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if seen != "from-proxy" || rec.Header().Get("X-Request-ID") != "from-proxy" {
+		t.Errorf("Expected the request's ID to be kept, got %q and %q", seen, rec.Header().Get("X-Request-ID"))
+	}
+
+	if id := RequestIDFrom(context.Background()); id != "" {
+		t.Errorf("Expected no ID outside requests, got %q", id)
+	}
+}
+
+func TestLogging(t *testing.T) {
+	var buf bytes.Buffer
+	h := Chain(routes(newUserStore()), RequestID(func() string { return "r1" }), Logging(jsonLogger(&buf)))
+	do(h, "GET", "/hello", "")
+	do(h, "GET", "/users/7", "")
+	// A handler that writes nothing responds 200.
+	Logging(jsonLogger(&buf))(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})).
+		ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("DELETE", "/empty", nil))
+
+	records := decode(t, buf.String())
+	want := []struct {
+		id, method, path string
+		status, bytes    float64
+	}{
+		{"r1", "GET", "/hello", 200, 6},
+		{"r1", "GET", "/users/7", 404, 27},
+		{"", "DELETE", "/empty", 200, 0},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("Expected %d records, got %d", len(want), len(records))
+	}
+	for i, w := range want {
+		r := records[i]
+		if r["msg"] != "request" || r["id"] != w.id || r["method"] != w.method || r["path"] != w.path ||
+			r["status"] != w.status || r["bytes"] != w.bytes {
+			t.Errorf("Expected %+v, got %v", w, r)
+		}
+		if _, ok := r["duration"].(float64); !ok {
+			t.Errorf("Expected a duration, got %v", r["duration"])
+		}
+	}
+}
+
+func TestStatusRecorderUnwrap(t *testing.T) {
+	// ResponseController finds the Flusher of the wrapped
+	// writer through Unwrap.
+	rec := httptest.NewRecorder()
+	sr := &statusRecorder{ResponseWriter: rec}
+	if err := http.NewResponseController(sr).Flush(); err != nil {
+		t.Errorf("Flush failed: %v", err)
+	}
+	if !rec.Flushed {
+		t.Error("Expected the recorder to be flushed")
+	}
+}
+
+func panicky(w http.ResponseWriter, req *http.Request) {
+	panic("boom")
+}
+
+func TestRecover(t *testing.T) {
+	var buf bytes.Buffer
+	h := Chain(http.HandlerFunc(panicky), RequestID(func() string { return "r1" }), Recover(jsonLogger(&buf)))
+
+	rec := mustNotPanic(t, h, "GET", "/")
+	if rec.Code != http.StatusInternalServerError || rec.Body.String() != `{"error":"internal error"}`+"\n" {
+		t.Errorf("Expected a 500 response, got %d %q", rec.Code, rec.Body.String())
+	}
+	r := decode(t, buf.String())[0]
+	if r["level"] != "ERROR" || r["msg"] != "panic" || r["value"] != "boom" || r["id"] != "r1" {
+		t.Errorf("Expected the panic to be logged, got %v", r)
+	}
+	if stack, _ := r["stack"].(string); !strings.Contains(stack, "panicky") {
+		t.Errorf("Expected the stack of the panic, got %q", stack)
+	}
+
+	// ErrAbortHandler is left to net/http.
+	abort := Recover(jsonLogger(&buf))(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+	defer func() {
+		if v := recover(); v != http.ErrAbortHandler {
+			t.Errorf("Expected ErrAbortHandler to be panicked again, got %v", v)
+		}
+	}()
+	do(abort, "GET", "/", "")
+	t.Error("Expected a panic")
+}
+
+func TestTimeout(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	stopped := make(chan error, 1)
+	h := Timeout(20 * time.Millisecond)(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-req.Context().Done():
+		case <-time.After(time.Second):
+		}
+		stopped <- req.Context().Err()
+	}))
+	rec := do(h, "GET", "/", "")
+	if rec.Code != http.StatusServiceUnavailable || rec.Body.String() != "request timed out\n" {
+		t.Errorf("Expected a 503 response, got %d %q", rec.Code, rec.Body.String())
+	}
+	if err := <-stopped; !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the handler's context to time out, got %v", err)
+	}
+
+	// Fast handlers are answered as usual.
+	rec = do(Timeout(time.Second)(http.HandlerFunc(hello)), "GET", "/", "")
+	if rec.Code != http.StatusOK || rec.Body.String() != "hello\n" {
+		t.Errorf("Expected 200 hello, got %d %q", rec.Code, rec.Body.String())
+	}
+}
+
+func TestMiddlewareStack(t *testing.T) {
+	// A panic under the Timeout middleware still reaches
+	// Recover, and Logging sees the 500.
+	var buf bytes.Buffer
+	h := Chain(http.HandlerFunc(panicky),
+		RequestID(func() string { return "r1" }),
+		Logging(jsonLogger(&buf)),
+		Recover(jsonLogger(&buf)),
+		Timeout(time.Second),
+	)
+	rec := mustNotPanic(t, h, "GET", "/boom")
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("Expected 500, got %d", rec.Code)
+	}
+	records := decode(t, buf.String())
+	if len(records) != 2 || records[0]["msg"] != "panic" || records[1]["status"] != 500.0 || records[1]["id"] != "r1" {
+		t.Errorf("Expected the panic and the request to be logged, got %v", records)
+	}
+}
+
+func TestNewServer(t *testing.T) {
+	srv := newServer(http.NotFoundHandler(), slog.New(slog.DiscardHandler))
+	if srv.ReadHeaderTimeout == 0 || srv.ReadTimeout == 0 || srv.WriteTimeout == 0 || srv.IdleTimeout == 0 {
+		t.Errorf("Expected every timeout to be set, got %+v", srv)
+	}
+	if srv.WriteTimeout <= 10*time.Second {
+		t.Errorf("Expected the write timeout to be longer than the request timeout, got %v", srv.WriteTimeout)
+	}
+}
+
+// start serves h on a free port, and returns its URL, the
+// channel to signal it on and the channel serve's error
+// arrives on.
+func start(t *testing.T, h http.Handler, grace time.Duration) (string, chan os.Signal, chan error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	sigs := make(chan os.Signal, 1)
+	errc := make(chan error, 1)
+	srv := newServer(h, slog.New(slog.DiscardHandler))
+	go func() { errc <- serve(srv, ln, sigs, grace) }()
+	return "http://" + ln.Addr().String(), sigs, errc
+}
+
+// wait returns the error of serve, failing the test
+// instead of hanging.
+func wait(t *testing.T, errc <-chan error) error {
+	t.Helper()
+	select {
+	case err := <-errc:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("serve didn't return")
+		return nil
+	}
+}
+
+func TestGracefulShutdown(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /hello", hello)
+	mux.HandleFunc("GET /slow", func(w http.ResponseWriter, req *http.Request) {
+		close(started)
+		<-release
+		fmt.Fprintln(w, "done")
+	})
+	url, sigs, errc := start(t, mux, 5*time.Second)
+	client := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+
+	resp, err := client.Get(url + "/hello")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	resp.Body.Close()
+
+	// A request is in flight when SIGTERM arrives.
+	type result struct {
+		body string
+		err  error
+	}
+	slow := make(chan result, 1)
+	go func() {
+		resp, err := client.Get(url + "/slow")
+		if err != nil {
+			slow <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		slow <- result{string(b), err}
+	}()
+	<-started
+	sigs <- syscall.SIGTERM
+
+	// The server stops accepting connections, but waits
+	// for the request.
+	deadline := time.Now().Add(time.Second)
+	for {
+		resp, err := client.Get(url + "/hello")
+		if err != nil {
+			break
+		}
+		resp.Body.Close()
+		if time.Now().After(deadline) {
+			t.Fatal("Expected new connections to be refused")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	select {
+	case err := <-errc:
+		t.Fatalf("Expected serve to wait for the request, got %v", err)
+	default:
+	}
+
+	close(release)
+	if r := <-slow; r.err != nil || r.body != "done\n" {
+		t.Errorf("Expected the request in flight to finish, got %q, %v", r.body, r.err)
+	}
+	if err := wait(t, errc); err != nil {
+		t.Errorf("Expected a clean shutdown, got %v", err)
+	}
+}
+
+func TestShutdownGracePeriod(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	started := make(chan struct{})
+	stuck := make(chan struct{})
+	defer close(stuck)
+	url, sigs, errc := start(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		close(started)
+		<-stuck
+	}), 20*time.Millisecond)
+
+	client := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+	go func() {
+		if resp, err := client.Get(url); err == nil {
+			resp.Body.Close()
+		}
+	}()
+	<-started
+	sigs <- syscall.SIGINT
+
+	// The request doesn't finish in time, so it's cut off.
+	if err := wait(t, errc); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestServeError(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	ln.Close()
+	err = serve(newServer(http.NotFoundHandler(), slog.New(slog.DiscardHandler)), ln, nil, time.Second)
+	if err == nil || errors.Is(err, http.ErrServerClosed) {
+		t.Errorf("Expected the error of a closed listener, got %v", err)
+	}
+}
@@ -0,0 +1,383 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+	"gorm.io/gorm"
+)
+
+// newDB returns a database with the users and orders of
+// main, closed at the end of the test.
+func newDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := openDB(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("openDB failed: %v", err)
+	}
+	sqlDB, _ := db.DB()
+	t.Cleanup(func() { sqlDB.Close() })
+	db.Create([]User{{1, "ada"}, {2, "bob"}, {3, "cy"}})
+	db.Create([]Order{{UserID: 1, Total: 30}, {UserID: 1, Total: 12}, {UserID: 2, Total: 5}})
+	return db
+}
+
+// newClient returns an HTTP client whose idle connections
+// are closed at the end of the test.
+func newClient(t *testing.T) *http.Client {
+	tr := &http.Transport{}
+	t.Cleanup(tr.CloseIdleConnections)
+	return &http.Client{Transport: tr}
+}
+
+// listen returns a listener on a free port, closed at the
+// end of the test.
+func listen(t *testing.T) net.Listener {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	return ln
+}
+
+// waitDone waits for ctx to be done, or for the test to
+// end, so that a call that ignores ctx fails the test
+// instead of hanging it.
+func waitDone(t *testing.T, ctx context.Context) {
+	select {
+	case <-ctx.Done():
+	case <-t.Context().Done():
+	}
+}
+
+func TestQueries(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+
+	u, err := findUser(ctx, db, 2)
+	if err != nil || u.Name != "bob" {
+		t.Errorf("Expected bob, got %+v, %v", u, err)
+	}
+	if _, err := findUser(ctx, db, 9); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("Expected ErrRecordNotFound, got %v", err)
+	}
+	if n, err := countOrders(ctx, db, 1); err != nil || n != 2 {
+		t.Errorf("Expected 2 orders, got %d, %v", n, err)
+	}
+
+	// A query with a context that is done isn't run.
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := findUser(cancelled, db, 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if _, err := countOrders(cancelled, db, 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+// abortingProfiles is a profile service whose requests
+// wait for their context. It reports each request when it
+// starts and when its context is done.
+func abortingProfiles(t *testing.T) (url string, started, aborted <-chan struct{}) {
+	s, a := make(chan struct{}, 1), make(chan struct{}, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		s <- struct{}{}
+		waitDone(t, req.Context())
+		a <- struct{}{}
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL, s, a
+}
+
+func TestFetchProfile(t *testing.T) {
+	srv := httptest.NewServer(profileService())
+	defer srv.Close()
+	client := newClient(t)
+	ctx := context.Background()
+
+	if p, err := fetchProfile(ctx, client, srv.URL, 1); err != nil || p != "likes gophers" {
+		t.Errorf("Expected the profile, got %q, %v", p, err)
+	}
+	if _, err := fetchProfile(ctx, client, srv.URL, 3); err == nil || err.Error() != "unexpected status 500 Internal Server Error" {
+		t.Errorf("Expected a status error, got %v", err)
+	}
+}
+
+func TestFetchProfileAborts(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	url, started, aborted := abortingProfiles(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error, 1)
+	go func() {
+		_, err := fetchProfile(ctx, newClient(t), url, 1)
+		errc <- err
+	}()
+
+	practicetest.Receive(t, started, "the profile request")
+	cancel()
+	if err := practicetest.Receive(t, errc, "fetchProfile"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	practicetest.Receive(t, aborted, "the profile service to see the cancellation")
+}
+
+// silentScores is a score service that reads the request
+// and never answers. It reports each request when it's
+// read and when the client closes the connection.
+func silentScores(t *testing.T) (addr string, started, aborted <-chan struct{}) {
+	ln := listen(t)
+	s, a := make(chan struct{}, 1), make(chan struct{}, 1)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				r.ReadString('\n')
+				s <- struct{}{}
+				stop := context.AfterFunc(t.Context(), func() { conn.Close() })
+				defer stop()
+				io.Copy(io.Discard, r)
+				a <- struct{}{}
+			}()
+		}
+	}()
+	return ln.Addr().String(), s, a
+}
+
+func TestFetchScore(t *testing.T) {
+	ln := listen(t)
+	go scoreService(ln)
+	if s, err := fetchScore(context.Background(), ln.Addr().String(), 2); err != nil || s != "42" {
+		t.Errorf("Expected 42, got %q, %v", s, err)
+	}
+}
+
+func TestFetchScoreAborts(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	addr, started, aborted := silentScores(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error, 1)
+	go func() {
+		_, err := fetchScore(ctx, addr, 1)
+		errc <- err
+	}()
+
+	// The read has no context: only the AfterFunc stops it.
+	practicetest.Receive(t, started, "the score request")
+	cancel()
+	if err := practicetest.Receive(t, errc, "fetchScore"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	practicetest.Receive(t, aborted, "the score service to see the connection closed")
+}
+
+func TestFanOut(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	ctx := context.Background()
+
+	var ran atomic.Int32
+	ok := func(ctx context.Context) error { ran.Add(1); return nil }
+	var err error
+	practicetest.Wait(t, "fanOut to return", func() { err = fanOut(ctx, ok, ok, ok) })
+	if err != nil || ran.Load() != 3 {
+		t.Errorf("Expected 3 tasks to succeed, got %d, %v", ran.Load(), err)
+	}
+
+	// The first failure cancels the rest, with itself as
+	// the cause.
+	boom := errors.New("boom")
+	causes := make(chan error, 2)
+	wait := func(ctx context.Context) error {
+		waitDone(t, ctx)
+		causes <- context.Cause(ctx)
+		return ctx.Err()
+	}
+	fail := func(ctx context.Context) error { return boom }
+	practicetest.Wait(t, "fanOut to cancel the siblings", func() { err = fanOut(ctx, wait, fail, wait) })
+	if err != boom {
+		t.Errorf("Expected boom, got %v", err)
+	}
+	for range 2 {
+		if cause := practicetest.Receive(t, causes, "the siblings"); cause != boom {
+			t.Errorf("Expected the siblings to be cancelled by boom, got %v", cause)
+		}
+	}
+
+	// When the parent is done first, its cause wins.
+	parent, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	practicetest.Wait(t, "fanOut to stop with its parent", func() { err = fanOut(parent, wait, wait) })
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected DeadlineExceeded, got %v", err)
+	}
+	practicetest.Receive(t, causes, "the tasks")
+	practicetest.Receive(t, causes, "the tasks")
+}
+
+// newServer returns a Server on db that logs into logs,
+// and the URL it serves at.
+func newServer(t *testing.T, db *gorm.DB, profiles, scores string, logs *bytes.Buffer) (*Server, string) {
+	s := &Server{
+		DB:       db,
+		Client:   newClient(t),
+		Profiles: profiles,
+		Scores:   scores,
+		Timeout:  time.Second,
+		Logger:   slog.New(slog.NewTextHandler(logs, nil)),
+	}
+	srv := httptest.NewServer(s.Handler())
+	t.Cleanup(func() {
+		srv.Close()
+		s.Wait()
+	})
+	return s, srv.URL
+}
+
+func get(t *testing.T, url string) (int, string) {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	defer resp.Body.Close()
+	b, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(b)
+}
+
+func TestReport(t *testing.T) {
+	profiles := httptest.NewServer(profileService())
+	defer profiles.Close()
+	ln := listen(t)
+	go scoreService(ln)
+	var logs bytes.Buffer
+	s, url := newServer(t, newDB(t), profiles.URL, ln.Addr().String(), &logs)
+	s.Timeout = 100 * time.Millisecond
+
+	tests := []struct {
+		path string
+		code int
+		want string
+	}{
+		{"/report/1", 200, `{"user":"ada","orders":2,"profile":"likes gophers","score":"41"}` + "\n"},
+		{"/report/2", 504, "report timed out\n"},
+		{"/report/3", 502, "profile: unexpected status 500 Internal Server Error\n"},
+		{"/report/4", 404, "user not found\n"},
+		{"/report/x", 400, "invalid user id\n"},
+	}
+	for _, tt := range tests {
+		if code, body := get(t, url+tt.path); code != tt.code || body != tt.want {
+			t.Errorf("%s: expected %d %q, got %d %q", tt.path, tt.code, tt.want, code, body)
+		}
+	}
+}
+
+func TestAudit(t *testing.T) {
+	db := newDB(t)
+	s := &Server{DB: db, Logger: slog.New(slog.DiscardHandler)}
+
+	// The request is over, and its context cancelled,
+	// before the audit is written.
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), requestIDKey{}, "r1"))
+	cancel()
+	s.audit(ctx, 1, nil)
+	s.audit(ctx, 2, errors.New("boom"))
+	s.Wait()
+
+	var audits []Audit
+	db.Order("user_id").Find(&audits)
+	want := []Audit{{RequestID: "r1", UserID: 1, Outcome: "ok"}, {RequestID: "r1", UserID: 2, Outcome: "boom"}}
+	for i := range audits {
+		audits[i].ID = 0
+	}
+	if !slices.Equal(audits, want) {
+		t.Errorf("Expected %+v, got %+v", want, audits)
+	}
+}
+
+func TestClientDisconnect(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	db := newDB(t)
+
+	// Every downstream call blocks until its context is
+	// done. A GORM callback holds the query of the orders
+	// before it runs, with the context GORM got.
+	ordersStarted, ordersAborted := make(chan struct{}, 1), make(chan error, 1)
+	db.Callback().Query().Before("gorm:query").Register("test:block", func(tx *gorm.DB) {
+		if tx.Statement.Table != "orders" {
+			return
+		}
+		ctx := tx.Statement.Context
+		ordersStarted <- struct{}{}
+		waitDone(t, ctx)
+		ordersAborted <- ctx.Err()
+		tx.AddError(ctx.Err())
+	})
+	profiles, profileStarted, profileAborted := abortingProfiles(t)
+	scores, scoreStarted, scoreAborted := silentScores(t)
+	var logs bytes.Buffer
+	s, url := newServer(t, db, profiles, scores, &logs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url+"/report/1", nil)
+	req.Header.Set("X-Request-ID", "r1")
+	errc := make(chan error, 1)
+	go func() {
+		resp, err := newClient(t).Do(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+		errc <- err
+	}()
+
+	practicetest.Receive(t, ordersStarted, "the orders query")
+	practicetest.Receive(t, profileStarted, "the profile request")
+	practicetest.Receive(t, scoreStarted, "the score request")
+	cancel()
+	if err := practicetest.Receive(t, errc, "the client"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the client to be cancelled, got %v", err)
+	}
+
+	// The server notices the client is gone, and every
+	// call it made stops.
+	if err := practicetest.Receive(t, ordersAborted, "the orders query to be cancelled"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the query to be cancelled, got %v", err)
+	}
+	practicetest.Receive(t, profileAborted, "the profile request to be cancelled")
+	practicetest.Receive(t, scoreAborted, "the score request to be cancelled")
+
+	// The audit is still written, with the request ID.
+	deadline := time.Now().Add(5 * time.Second)
+	var a Audit
+	for db.Where("request_id = ?", "r1").Limit(1).Find(&a); a.ID == 0 && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+		db.Where("request_id = ?", "r1").Limit(1).Find(&a)
+	}
+	if a.UserID != 1 || a.Outcome != "context canceled" {
+		t.Errorf("Expected an audit of the cancelled report, got %+v", a)
+	}
+	s.Wait()
+	if !strings.Contains(logs.String(), `msg="report abandoned" request=r1 cause="context canceled"`) {
+		t.Errorf("Expected the abandoned report to be logged, got %q", logs.String())
+	}
+}
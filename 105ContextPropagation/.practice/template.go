@@ -0,0 +1,413 @@
+// The [context](context) example cancels the work of one
+// handler when its client goes away. Real handlers pass
+// the work on: they query a database, call other
+// services, and start goroutines, and each of those has
+// to stop too, or it keeps running for a client that is
+// gone. Here a handler threads its request's context
+// through a GORM query, an HTTP call, a TCP call and a
+// fan-out of goroutines, with a deadline for all of them,
+// a _cause_ saying why they were cancelled, a function
+// that runs on cancellation, and a context that outlives
+// the request for the work that must finish anyway.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type User struct {
+	ID   uint
+	Name string
+}
+
+type Order struct {
+	ID     uint
+	UserID uint `gorm:"index"`
+	Total  int
+}
+
+// Audit records the outcome of a report.
+type Audit struct {
+	ID        uint
+	RequestID string
+	UserID    uint
+	Outcome   string
+}
+
+// openDB opens the SQLite database at path, with GORM's
+// logger off: it would log every cancelled query.
+func openDB(path string) (*gorm.DB, error) {
+	db, err := gorm.Open(openSQLite(path), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		return nil, err
+	}
+	if err := db.AutoMigrate(&User{}, &Order{}, &Audit{}); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// findUser and countOrders pass ctx to GORM with
+// `WithContext`, as in the [GORM generics](gorm-generics)
+// example. GORM passes it to `database/sql`, which
+// doesn't run a query once ctx is done, and to the
+// driver, which may interrupt a query that is running.
+func findUser(ctx context.Context, db *gorm.DB, id uint) (User, error) {
+	var u User
+	// TODO: Pass ctx to the query with db.WithContext.
+	err := db.First(&u, id).Error
+	return u, err
+}
+
+func countOrders(ctx context.Context, db *gorm.DB, userID uint) (int64, error) {
+	var n int64
+	// TODO: Pass ctx to the query with db.WithContext.
+	err := db.Model(&Order{}).Where("user_id = ?", userID).Count(&n).Error
+	return n, err
+}
+
+// fetchProfile gets a user's profile from an HTTP
+// service. A request made with ctx is cancelled with it:
+// the client closes the connection, and the service sees
+// its own request's context done.
+func fetchProfile(ctx context.Context, client *http.Client, baseURL string, id uint) (string, error) {
+	// TODO: Make the request with
+	// http.NewRequestWithContext, so it's cancelled with
+	// ctx.
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/profiles/%d", baseURL, id), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	b, err := io.ReadAll(resp.Body)
+	return strings.TrimSpace(string(b)), err
+}
+
+// fetchScore gets a user's score from a TCP service that
+// answers a line with the user's ID with a line. Dialing
+// takes a context, but reads and writes on a connection
+// don't. `context.AfterFunc` runs a function once ctx is
+// done, here one that makes the blocked read fail by
+// moving the connection's deadline to now. Its stop
+// function unregisters it, for when the call finishes
+// first.
+func fetchScore(ctx context.Context, addr string, id uint) (string, error) {
+	// TODO: Dial with the DialContext method of a
+	// net.Dialer, and register a function with
+	// context.AfterFunc that sets the deadline of conn to
+	// time.Now(). Defer the stop function it returns.
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	if _, err := fmt.Fprintf(conn, "%d\n", id); err != nil {
+		return "", err
+	}
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		// TODO: If ctx is done, return its error instead,
+		// since the deadline error says nothing about why.
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// fanOut runs tasks in goroutines and waits for them.
+// `context.WithCancelCause` returns a cancel function
+// that takes an error: the first task to fail cancels the
+// others with its error, which `context.Cause` returns,
+// while `ctx.Err()` is just `context.Canceled`. If the
+// parent is done first, the cause is the parent's.
+func fanOut(ctx context.Context, tasks ...func(ctx context.Context) error) error {
+	// TODO: Derive a context with context.WithCancelCause,
+	// run each task in a goroutine with the Go method of a
+	// sync.WaitGroup, and cancel the context with the error
+	// of any task that fails. When they're all done, return
+	// context.Cause of the context.
+	for _, task := range tasks {
+		if err := task(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Report is what the server puts together from the
+// database and the other services.
+type Report struct {
+	User    string `json:"user"`
+	Orders  int64  `json:"orders"`
+	Profile string `json:"profile"`
+	Score   string `json:"score"`
+}
+
+// Server serves reports.
+type Server struct {
+	DB       *gorm.DB
+	Client   *http.Client
+	Profiles string        // base URL of the profile service
+	Scores   string        // address of the score service
+	Timeout  time.Duration // how long a report may take
+	Logger   *slog.Logger
+
+	audits sync.WaitGroup
+}
+
+// Handler returns the server's router.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /report/{id}", s.serveReport)
+	return mux
+}
+
+// requestIDKey is the context key of request IDs.
+type requestIDKey struct{}
+
+func requestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// report builds the report of user id. Every call below
+// gets ctx, or a context derived from it, so all of them
+// stop when the client goes away or the report takes
+// longer than s.Timeout, whichever comes first.
+func (s *Server) report(ctx context.Context, id uint) (Report, error) {
+	// TODO: Derive a context that times out after
+	// s.Timeout with context.WithTimeout, and defer its
+	// cancel function.
+
+	u, err := findUser(ctx, s.DB, id)
+	if err != nil {
+		return Report{}, err
+	}
+	r := Report{User: u.Name}
+	// Each task sets its own field, so they don't race.
+	err = fanOut(ctx,
+		func(ctx context.Context) (err error) {
+			r.Orders, err = countOrders(ctx, s.DB, id)
+			return wrap("orders", err)
+		},
+		func(ctx context.Context) (err error) {
+			r.Profile, err = fetchProfile(ctx, s.Client, s.Profiles, id)
+			return wrap("profile", err)
+		},
+		func(ctx context.Context) (err error) {
+			r.Score, err = fetchScore(ctx, s.Scores, id)
+			return wrap("score", err)
+		},
+	)
+	return r, err
+}
+
+// wrap prefixes err, if any, with the name of the task.
+func wrap(name string, err error) error {
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+// serveReport serves `GET /report/{id}`.
+func (s *Server) serveReport(w http.ResponseWriter, req *http.Request) {
+	id, err := strconv.ParseUint(req.PathValue("id"), 10, 0)
+	if err != nil {
+		http.Error(w, "invalid user id", http.StatusBadRequest)
+		return
+	}
+	ctx := context.WithValue(req.Context(), requestIDKey{}, req.Header.Get("X-Request-ID"))
+
+	r, err := s.report(ctx, uint(id))
+	s.audit(ctx, uint(id), err)
+	switch {
+	case err == nil:
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(r)
+	case req.Context().Err() != nil:
+		// The client is gone, so there's no one to
+		// respond to.
+		s.Logger.Info("report abandoned", "request", requestID(ctx), "cause", context.Cause(req.Context()))
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		http.Error(w, "user not found", http.StatusNotFound)
+	case errors.Is(err, context.DeadlineExceeded):
+		http.Error(w, "report timed out", http.StatusGatewayTimeout)
+	default:
+		http.Error(w, err.Error(), http.StatusBadGateway)
+	}
+}
+
+// audit records the outcome of a report in the
+// background. ctx may be cancelled by the time it runs,
+// or before, if the client went away. `context.WithoutCancel`
+// returns a context with the values of ctx, like the
+// request ID, but which is never cancelled and has no
+// deadline, so the write gets a timeout of its own.
+func (s *Server) audit(ctx context.Context, userID uint, err error) {
+	// TODO: Replace ctx with context.WithoutCancel(ctx).
+	outcome := "ok"
+	if err != nil {
+		outcome = err.Error()
+	}
+	s.audits.Go(func() {
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		a := Audit{RequestID: requestID(ctx), UserID: userID, Outcome: outcome}
+		if err := s.DB.WithContext(ctx).Create(&a).Error; err != nil {
+			s.Logger.Error("audit failed", "request", a.RequestID, "err", err)
+		}
+	})
+}
+
+// Wait waits for the audits in the background.
+func (s *Server) Wait() {
+	s.audits.Wait()
+}
+
+// profileService stands in for the profile service.
+// User 2's profile takes two seconds to come, unless the
+// request is cancelled, and user 3's fails.
+func profileService() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /profiles/{id}", func(w http.ResponseWriter, req *http.Request) {
+		switch req.PathValue("id") {
+		case "1":
+			fmt.Fprintln(w, "likes gophers")
+		case "2":
+			select {
+			case <-time.After(2 * time.Second):
+				fmt.Fprintln(w, "slow")
+			case <-req.Context().Done():
+			}
+		default:
+			http.Error(w, "profile store down", http.StatusInternalServerError)
+		}
+	})
+	return mux
+}
+
+// scoreService stands in for the score service, until ln
+// is closed.
+func scoreService(ln net.Listener) {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer conn.Close()
+			var id int
+			if _, err := fmt.Fscanln(conn, &id); err == nil {
+				fmt.Fprintln(conn, 40+id)
+			}
+		}()
+	}
+}
+
+func main() {
+	dir, err := os.MkdirTemp("", "reports")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	db, err := openDB(filepath.Join(dir, "reports.db"))
+	if err != nil {
+		panic(err)
+	}
+	db.Create([]User{{1, "ada"}, {2, "bob"}, {3, "cy"}})
+	db.Create([]Order{{UserID: 1, Total: 30}, {UserID: 1, Total: 12}, {UserID: 2, Total: 5}})
+
+	profiles := httptest.NewServer(profileService())
+	defer profiles.Close()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	defer ln.Close()
+	go scoreService(ln)
+
+	// The server logs without times, to print the same
+	// lines every time.
+	s := &Server{
+		DB:       db,
+		Client:   &http.Client{},
+		Profiles: profiles.URL,
+		Scores:   ln.Addr().String(),
+		Timeout:  300 * time.Millisecond,
+		Logger: slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+			ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+				if a.Key == slog.TimeKey && len(groups) == 0 {
+					return slog.Attr{}
+				}
+				return a
+			},
+		})),
+	}
+	srv := httptest.NewServer(s.Handler())
+
+	// Bob's report waits for a profile that never comes:
+	// first the client gives up, then the server does.
+	requests := []struct {
+		user     int
+		patience time.Duration
+	}{
+		{1, time.Second},
+		{2, 100 * time.Millisecond},
+		{2, time.Second},
+		{3, time.Second},
+		{4, time.Second},
+	}
+	for i, r := range requests {
+		ctx, cancel := context.WithTimeout(context.Background(), r.patience)
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/report/%d", srv.URL, r.user), nil)
+		req.Header.Set("X-Request-ID", fmt.Sprintf("req-%d", i+1))
+		resp, err := http.DefaultClient.Do(req)
+		if errors.Is(err, context.DeadlineExceeded) {
+			fmt.Printf("report %d: client gave up\n", r.user)
+		} else if err != nil {
+			fmt.Printf("report %d: %v\n", r.user, err)
+		} else {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			fmt.Printf("report %d: %d %s\n", r.user, resp.StatusCode, strings.TrimSpace(string(body)))
+		}
+		cancel()
+	}
+
+	// Close waits for the handlers, and Wait for the
+	// audits they started.
+	srv.Close()
+	s.Wait()
+	var audits []Audit
+	db.Order("id").Find(&audits)
+	for _, a := range audits {
+		fmt.Printf("audit %s: user %d: %s\n", a.RequestID, a.UserID, a.Outcome)
+	}
+}
@@ -0,0 +1,92 @@
+// _Maps_ are Go's built-in [associative data type](https://en.wikipedia.org/wiki/Associative_array)
+// (sometimes called _hashes_ or _dicts_ in other languages).
+
+package main
+
+import (
+	"fmt"
+	"maps"
+)
+
+// To create an empty map, use the builtin `make`:
+// `make(map[key-type]val-type)`.
+func newMap() map[string]int {
+	m := make(map[string]int)
+
+	// Set key/value pairs using typical `name[key] = val`
+	// syntax.
+	m["k1"] = 7
+	m["k2"] = 13
+	return m
+}
+
+// Get a value for a key with `name[key]`. If the key
+// doesn't exist, the
+// [zero value](https://go.dev/ref/spec#The_zero_value) of the
+// value type is returned.
+func lookup(m map[string]int, key string) int {
+	return m[key]
+}
+
+// The builtin `delete` removes key/value pairs from
+// a map.
+func remove(m map[string]int, key string) {
+	delete(m, key)
+}
+
+// To remove *all* key/value pairs from a map, use
+// the `clear` builtin.
+func removeAll(m map[string]int) {
+	clear(m)
+}
+
+// The optional second return value when getting a
+// value from a map indicates if the key was present
+// in the map. This can be used to disambiguate
+// between missing keys and keys with zero values
+// like `0` or `""`. Here we didn't need the value
+// itself, so we ignored it with the _blank identifier_
+// `_`.
+func contains(m map[string]int, key string) bool {
+	_, prs := m[key]
+	return prs
+}
+
+// The `maps` package contains a number of useful
+// utility functions for maps.
+func equal(a, b map[string]int) bool {
+	return maps.Equal(a, b)
+}
+
+func main() {
+	m := newMap()
+
+	// Printing a map with e.g. `fmt.Println` will show all of
+	// its key/value pairs.
+	fmt.Println("map:", m)
+
+	fmt.Println("v1:", lookup(m, "k1"))
+	fmt.Println("v3:", lookup(m, "k3"))
+
+	// The builtin `len` returns the number of key/value
+	// pairs when called on a map.
+	fmt.Println("len:", len(m))
+
+	remove(m, "k2")
+	fmt.Println("map:", m)
+
+	removeAll(m)
+	fmt.Println("map:", m)
+
+	fmt.Println("prs:", contains(m, "k2"))
+
+	// You can also declare and initialize a new map in
+	// the same line with this syntax.
+	n := map[string]int{"foo": 1, "bar": 2}
+	fmt.Println("map:", n)
+
+	n2 := map[string]int{"foo": 1, "bar": 2}
+	if equal(n, n2) {
+		fmt.Println("n == n2")
+	}
+}
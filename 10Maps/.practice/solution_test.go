@@ -0,0 +1,54 @@
+package main
+
+import "testing"
+
+func TestNewMap(t *testing.T) {
+	m := newMap()
+	if len(m) != 2 || m["k1"] != 7 || m["k2"] != 13 {
+		t.Errorf("Expected map[k1:7 k2:13], got %v", m)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	m := map[string]int{"k1": 7}
+	if got := lookup(m, "k1"); got != 7 {
+		t.Errorf("Expected 7, got %d", got)
+	}
+	if got := lookup(m, "k3"); got != 0 {
+		t.Errorf("Expected zero value for a missing key, got %d", got)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	m := map[string]int{"k1": 7, "k2": 13}
+	remove(m, "k2")
+	if len(m) != 1 || m["k1"] != 7 {
+		t.Errorf("Expected map[k1:7], got %v", m)
+	}
+
+	removeAll(m)
+	if len(m) != 0 {
+		t.Errorf("Expected an empty map, got %v", m)
+	}
+}
+
+func TestContains(t *testing.T) {
+	// A zero value is still present.
+	m := map[string]int{"zero": 0}
+	if !contains(m, "zero") {
+		t.Error("Expected key with zero value to be present")
+	}
+	if contains(m, "k2") {
+		t.Error("Expected missing key not to be present")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	n := map[string]int{"foo": 1, "bar": 2}
+	if !equal(n, map[string]int{"bar": 2, "foo": 1}) {
+		t.Error("Expected maps with the same pairs to be equal")
+	}
+	if equal(n, map[string]int{"foo": 1, "bar": 3}) {
+		t.Error("Expected maps with different values not to be equal")
+	}
+}
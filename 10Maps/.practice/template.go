@@ -3,78 +3,89 @@
 
 package main
 
-import (
-	"fmt"
-	"maps"
-)
+import "fmt"
 
-func main() {
-
-	// To create an empty map, use the builtin `make`:
-	// `make(map[key-type]val-type)`.
-
-	// TODO: Create map m  with key-type string and val-type int
+// To create an empty map, use the builtin `make`:
+// `make(map[key-type]val-type)`.
+func newMap() map[string]int {
+	// TODO: Create map m with key-type string and val-type int
 
 	// Set key/value pairs using typical `name[key] = val`
 	// syntax.
 
-	// TODO: set k1 to 7 and k2 to 13
+	// TODO: set k1 to 7 and k2 to 13 and return m
+	return nil
+}
+
+// Get a value for a key with `name[key]`. If the key
+// doesn't exist, the
+// [zero value](https://go.dev/ref/spec#The_zero_value) of the
+// value type is returned.
+func lookup(m map[string]int, key string) int {
+	// TODO: Return the value for key
+	return -1
+}
+
+// The builtin `delete` removes key/value pairs from
+// a map.
+func remove(m map[string]int, key string) {
+	// TODO: Delete key from m
+}
+
+// To remove *all* key/value pairs from a map, use
+// the `clear` builtin.
+func removeAll(m map[string]int) {
+	// TODO: Clear the map
+}
+
+// The optional second return value when getting a
+// value from a map indicates if the key was present
+// in the map. This can be used to disambiguate
+// between missing keys and keys with zero values
+// like `0` or `""`. Here we didn't need the value
+// itself, so we ignored it with the _blank identifier_
+// `_`.
+func contains(m map[string]int, key string) bool {
+	// TODO: Check if key exists in m and return the result
+	return false
+}
+
+// The `maps` package contains a number of useful
+// utility functions for maps.
+func equal(a, b map[string]int) bool {
+	// TODO: Use maps.Equal to compare a and b
+	return false
+}
 
+func main() {
+	m := newMap()
 
 	// Printing a map with e.g. `fmt.Println` will show all of
 	// its key/value pairs.
+	fmt.Println("map:", m)
 
-	// TODO: Print map
-
-	// Get a value for a key with `name[key]`.
-
-	// TODO: Get and print value for key "k1"
-
-	// If the key doesn't exist, the
-	// [zero value](https://go.dev/ref/spec#The_zero_value) of the
-	// value type is returned.
-
-	// TODO: Get and print value for key "k3"
+	fmt.Println("v1:", lookup(m, "k1"))
+	fmt.Println("v3:", lookup(m, "k3"))
 
 	// The builtin `len` returns the number of key/value
 	// pairs when called on a map.
+	fmt.Println("len:", len(m))
 
-	// TODO: Print the length of the map
+	remove(m, "k2")
+	fmt.Println("map:", m)
 
-	// The builtin `delete` removes key/value pairs from
-	// a map.
+	removeAll(m)
+	fmt.Println("map:", m)
 
-	// TODO: Delete key "k2" from map
-	// TODO: Print the map
-
-	// To remove *all* key/value pairs from a map, use
-	// the `clear` builtin.
-
-	// TODO: Clear the map
-	// TODO: Print the map
-
-	// The optional second return value when getting a
-	// value from a map indicates if the key was present
-	// in the map. This can be used to disambiguate
-	// between missing keys and keys with zero values
-	// like `0` or `""`. Here we didn't need the value
-	// itself, so we ignored it with the _blank identifier_
-	// `_`.
-
-	// TODO: Check if key "k2" exists in map
-	// TODO: Print the result
+	fmt.Println("prs:", contains(m, "k2"))
 
 	// You can also declare and initialize a new map in
 	// the same line with this syntax.
-
-	// TODO: Create map n with key-type string and val-type int with initial values {"foo": 1, "bar": 2}
-	// TODO: Print the map
-
-	// The `maps` package contains a number of useful
-	// utility functions for maps.
-
-	// TODO: Create map n2 with key-type string and val-type int with initial values {"foo": 1, "bar": 2}
-	// TODO: Use maps.Equal to compare n and n2
-	// TODO: Print the result
-
-}
\ No newline at end of file
+	n := map[string]int{"foo": 1, "bar": 2}
+	fmt.Println("map:", n)
+
+	n2 := map[string]int{"foo": 1, "bar": 2}
+	if equal(n, n2) {
+		fmt.Println("n == n2")
+	}
+}
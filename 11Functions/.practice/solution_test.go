@@ -0,0 +1,29 @@
+package main
+
+import "testing"
+
+func TestPlus(t *testing.T) {
+	tests := []struct{ a, b, want int }{
+		{1, 2, 3},
+		{0, 0, 0},
+		{-5, 3, -2},
+	}
+	for _, tt := range tests {
+		if got := plus(tt.a, tt.b); got != tt.want {
+			t.Errorf("plus(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestPlusPlus(t *testing.T) {
+	tests := []struct{ a, b, c, want int }{
+		{1, 2, 3, 6},
+		{0, 0, 0, 0},
+		{-1, -2, 10, 7},
+	}
+	for _, tt := range tests {
+		if got := plusPlus(tt.a, tt.b, tt.c); got != tt.want {
+			t.Errorf("plusPlus(%d, %d, %d) = %d, want %d", tt.a, tt.b, tt.c, got, tt.want)
+		}
+	}
+}
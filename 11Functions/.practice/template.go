@@ -7,30 +7,32 @@ import "fmt"
 
 // Here's a function that takes two `int`s and returns
 // their sum as an `int`.
+func plus(a int, b int) int {
 
-// Go requires explicit returns, i.e. it won't
-// automatically return the value of the last
-// expression.
-
-
-// TODO: Create function plus(a, b int) int that returns a + b
+	// Go requires explicit returns, i.e. it won't
+	// automatically return the value of the last
+	// expression.
 
+	// TODO: Return a + b
+	return 0
+}
 
 // When you have multiple consecutive parameters of
 // the same type, you may omit the type name for the
 // like-typed parameters up to the final parameter that
 // declares the type.
-
-// TODO: Create function plusPlus(a, b, c int) int that returns a + b + c
-
+func plusPlus(a, b, c int) int {
+	// TODO: Return a + b + c
+	return 0
+}
 
 func main() {
 
 	// Call a function just as you'd expect, with
 	// `name(args)`.
+	res := plus(1, 2)
+	fmt.Println("1+2 =", res)
 
-	// TODO: Call plus(1, 2) and store in res and print result
-
-	// TODO: Call plusPlus(1, 2, 3) and store in res and print result
-
-}
\ No newline at end of file
+	res = plusPlus(1, 2, 3)
+	fmt.Println("1+2+3 =", res)
+}
@@ -0,0 +1,10 @@
+package main
+
+import "testing"
+
+func TestVals(t *testing.T) {
+	a, b := vals()
+	if a != 3 || b != 7 {
+		t.Errorf("Expected (3, 7), got (%d, %d)", a, b)
+	}
+}
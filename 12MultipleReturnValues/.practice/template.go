@@ -6,19 +6,23 @@ package main
 
 import "fmt"
 
-// TODO: Create function vals() (int, int) that returns 3, 7
-
+// The `(int, int)` in this function signature shows that
+// the function returns 2 `int`s.
+func vals() (int, int) {
+	// TODO: Return 3, 7
+	return 0, 0
+}
 
 func main() {
 
 	// Here we use the 2 different return values from the
 	// call with _multiple assignment_.
-
-	// TODO: Call vals() and assign to a, b
-	// TODO: Print a and b
+	a, b := vals()
+	fmt.Println(a)
+	fmt.Println(b)
 
 	// If you only want a subset of the returned values,
 	// use the blank identifier `_`.
-	// TODO: Call vals() and only use the second return value
-	// TODO: Print c
-}
\ No newline at end of file
+	_, c := vals()
+	fmt.Println(c)
+}
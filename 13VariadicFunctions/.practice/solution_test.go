@@ -0,0 +1,20 @@
+package main
+
+import "testing"
+
+func TestSum(t *testing.T) {
+	if got := sum(); got != 0 {
+		t.Errorf("sum() = %d, want 0", got)
+	}
+	if got := sum(1, 2); got != 3 {
+		t.Errorf("sum(1, 2) = %d, want 3", got)
+	}
+	if got := sum(1, 2, 3); got != 6 {
+		t.Errorf("sum(1, 2, 3) = %d, want 6", got)
+	}
+
+	nums := []int{1, 2, 3, 4}
+	if got := sum(nums...); got != 10 {
+		t.Errorf("sum(nums...) = %d, want 10", got)
+	}
+}
@@ -0,0 +1,27 @@
+package main
+
+import "testing"
+
+func TestIntSeq(t *testing.T) {
+	nextInt := intSeq()
+	for want := 1; want <= 3; want++ {
+		if got := nextInt(); got != want {
+			t.Errorf("Expected call %d to return %d, got %d", want, want, got)
+		}
+	}
+}
+
+func TestIntSeqIndependentState(t *testing.T) {
+	a := intSeq()
+	b := intSeq()
+	a()
+	a()
+
+	// Each closure captures its own i.
+	if got := b(); got != 1 {
+		t.Errorf("Expected a new sequence to start at 1, got %d", got)
+	}
+	if got := a(); got != 3 {
+		t.Errorf("Expected the first sequence to continue at 3, got %d", got)
+	}
+}
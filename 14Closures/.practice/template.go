@@ -11,11 +11,11 @@ import "fmt"
 // we define anonymously in the body of `intSeq`. The
 // returned function _closes over_ the variable `i` to
 // form a closure.
-
-// TODO: Create function intSeq() func() int
-// Inside, create variable i := 0
-// Return anonymous function that increments i and returns it
-
+func intSeq() func() int {
+	// TODO: Create variable i := 0
+	// Return anonymous function that increments i and returns it
+	return func() int { return 0 }
+}
 
 func main() {
 
@@ -23,16 +23,16 @@ func main() {
 	// to `nextInt`. This function value captures its
 	// own `i` value, which will be updated each time
 	// we call `nextInt`.
-
-	// TODO: Call intSeq() and assign to nextInt
+	nextInt := intSeq()
 
 	// See the effect of the closure by calling `nextInt`
 	// a few times.
-
-	// TODO: Call nextInt() multiple times and print results for each call
+	fmt.Println(nextInt())
+	fmt.Println(nextInt())
+	fmt.Println(nextInt())
 
 	// To confirm that the state is unique to that
 	// particular function, create and test a new one.
-
-	// TODO: Create newInts := intSeq() and call it to show separate state
-}
\ No newline at end of file
+	newInts := intSeq()
+	fmt.Println(newInts())
+}
@@ -0,0 +1,22 @@
+package main
+
+import "testing"
+
+func TestFact(t *testing.T) {
+	tests := map[int]int{0: 1, 1: 1, 5: 120, 7: 5040, 10: 3628800}
+	for n, want := range tests {
+		if got := fact(n); got != want {
+			t.Errorf("fact(%d) = %d, want %d", n, got, want)
+		}
+	}
+}
+
+func TestFibonacci(t *testing.T) {
+	fib := fibonacci()
+	want := []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34}
+	for n, w := range want {
+		if got := fib(n); got != w {
+			t.Errorf("fib(%d) = %d, want %d", n, got, w)
+		}
+	}
+}
@@ -8,23 +8,30 @@ import "fmt"
 
 // This `fact` function calls itself until it reaches the
 // base case of `fact(0)`.
-// TODO: Create recursive function fact(n int) int
-// Base case: if n == 0 return 1
-// Recursive case: return n * fact(n-1)
-
-func main() {
-	
-	// TODO: Call fact(7) and print result
-
-	// Anonymous functions can also be recursive, but this requires
-	// explicitly declaring a variable with `var` to store
-	// the function before it's defined.
-
+func fact(n int) int {
+	// TODO: Base case: if n == 0 return 1
+	// Recursive case: return n * fact(n-1)
+	return 0
+}
+
+// Anonymous functions can also be recursive, but this requires
+// explicitly declaring a variable with `var` to store
+// the function before it's defined.
+func fibonacci() func(int) int {
 	// TODO: Create variable fib of type func(int) int
-	// Assign anonymous function that calculates fibonacci recursively
+	// Assign anonymous function that calculates fibonacci recursively:
+	// fib(n) is n for n < 2, otherwise fib(n-1) + fib(n-2)
+
+	// Since `fib` was previously declared, Go knows
+	// which function to call with `fib` here.
 
-	// Since `fib` was previously declared, you can call that with the anonymous function
+	// TODO: Return fib
+	return func(int) int { return 0 }
+}
 
+func main() {
+	fmt.Println(fact(7))
 
-	// TODO: Call fib(7) and print result
-}
\ No newline at end of file
+	fib := fibonacci()
+	fmt.Println(fib(7))
+}
@@ -0,0 +1,82 @@
+// _range_ iterates over elements in a variety of
+// built-in data structures. Let's see how to
+// use `range` with some of the data structures
+// we've already learned.
+
+package main
+
+import "fmt"
+
+// Here we use `range` to sum the numbers in a slice.
+// Arrays work like this too.
+func sum(nums []int) int {
+	sum := 0
+	for _, num := range nums {
+		sum += num
+	}
+	return sum
+}
+
+// `range` on arrays and slices provides both the
+// index and value for each entry. Above we didn't
+// need the index, so we ignored it with the
+// blank identifier `_`. Sometimes we actually want
+// the indexes though.
+func indexOf(nums []int, target int) int {
+	for i, num := range nums {
+		if num == target {
+			return i
+		}
+	}
+	return -1
+}
+
+// `range` on map iterates over key/value pairs.
+func pairs(kvs map[string]string) []string {
+	var out []string
+	for k, v := range kvs {
+		out = append(out, fmt.Sprintf("%s -> %s", k, v))
+	}
+	return out
+}
+
+// `range` can also iterate over just the keys of a map.
+func keys(kvs map[string]string) []string {
+	var out []string
+	for k := range kvs {
+		out = append(out, k)
+	}
+	return out
+}
+
+// `range` on strings iterates over Unicode code
+// points. The first value is the starting byte index
+// of the `rune` and the second the `rune` itself.
+// See [Strings and Runes](strings-and-runes) for more
+// details.
+func runesOf(s string) (offsets []int, runes []rune) {
+	for i, c := range s {
+		offsets = append(offsets, i)
+		runes = append(runes, c)
+	}
+	return offsets, runes
+}
+
+func main() {
+	nums := []int{2, 3, 4}
+	fmt.Println("sum:", sum(nums))
+	fmt.Println("index:", indexOf(nums, 3))
+
+	kvs := map[string]string{"a": "apple", "b": "banana"}
+	for _, p := range pairs(kvs) {
+		fmt.Println(p)
+	}
+	for _, k := range keys(kvs) {
+		fmt.Println("key:", k)
+	}
+
+	offsets, runes := runesOf("go")
+	for i := range offsets {
+		fmt.Println(offsets[i], runes[i])
+	}
+}
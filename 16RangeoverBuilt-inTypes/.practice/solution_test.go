@@ -0,0 +1,60 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	if got := sum([]int{2, 3, 4}); got != 9 {
+		t.Errorf("Expected 9, got %d", got)
+	}
+	if got := sum(nil); got != 0 {
+		t.Errorf("Expected 0 for no numbers, got %d", got)
+	}
+}
+
+func TestIndexOf(t *testing.T) {
+	nums := []int{2, 3, 4}
+	if got := indexOf(nums, 3); got != 1 {
+		t.Errorf("Expected index 1, got %d", got)
+	}
+	if got := indexOf(nums, 7); got != -1 {
+		t.Errorf("Expected -1 for a missing number, got %d", got)
+	}
+}
+
+func TestMapRange(t *testing.T) {
+	kvs := map[string]string{"a": "apple", "b": "banana"}
+
+	// Map iteration order is not specified, so compare
+	// sorted results.
+	got := pairs(kvs)
+	slices.Sort(got)
+	if want := []string{"a -> apple", "b -> banana"}; !slices.Equal(got, want) {
+		t.Errorf("Expected pairs %v, got %v", want, got)
+	}
+
+	got = keys(kvs)
+	slices.Sort(got)
+	if want := []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("Expected keys %v, got %v", want, got)
+	}
+}
+
+func TestRunesOf(t *testing.T) {
+	offsets, runes := runesOf("go")
+	if !slices.Equal(offsets, []int{0, 1}) || !slices.Equal(runes, []rune{'g', 'o'}) {
+		t.Errorf("Expected [0 1] [103 111], got %v %v", offsets, runes)
+	}
+
+	// Offsets are byte positions, so they skip over the
+	// bytes of multi-byte runes.
+	offsets, runes = runesOf("héllo")
+	if !slices.Equal(offsets, []int{0, 1, 3, 4, 5}) {
+		t.Errorf("Expected offsets [0 1 3 4 5], got %v", offsets)
+	}
+	if string(runes) != "héllo" {
+		t.Errorf("Expected runes of %q, got %q", "héllo", string(runes))
+	}
+}
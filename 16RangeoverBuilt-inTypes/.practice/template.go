@@ -7,41 +7,61 @@ package main
 
 import "fmt"
 
-func main() {
-
-	// Here we use `range` to sum the numbers in a slice.
-	// Arrays work like this too.
-
-	// TODO: Create slice nums := []int{2, 3, 4}
-
-	// TODO: Use range to sum all numbers in nums
-	// TODO: Print sum
-
-	// `range` on arrays and slices provides both the
-	// index and value for each entry. Above we didn't
-	// need the index, so we ignored it with the
-	// blank identifier `_`. Sometimes we actually want
-	// the indexes though.
-
-	// TODO: Use range over nums to print index and value
-
-	// `range` on map iterates over key/value pairs.
-
-	// TODO: Create map kvs := map[string]string{"a": "apple", "b": "banana"}
+// Here we use `range` to sum the numbers in a slice.
+// Arrays work like this too.
+func sum(nums []int) int {
+	// TODO: Use range to sum all numbers in nums and return the sum
+	return 0
+}
+
+// `range` on arrays and slices provides both the
+// index and value for each entry. Above we didn't
+// need the index, so we ignored it with the
+// blank identifier `_`. Sometimes we actually want
+// the indexes though.
+func indexOf(nums []int, target int) int {
+	// TODO: Use range over nums and return the index of target, or -1 if it is missing
+	return -1
+}
+
+// `range` on map iterates over key/value pairs.
+func pairs(kvs map[string]string) []string {
+	// TODO: Use range to iterate over kvs and collect "<key> -> <value>" for each pair
+	// Hint: fmt.Sprintf("%s -> %s", k, v)
+	return nil
+}
+
+// `range` can also iterate over just the keys of a map.
+func keys(kvs map[string]string) []string {
+	// TODO: Use range to iterate over just the keys of kvs and collect them
+	return nil
+}
+
+// `range` on strings iterates over Unicode code
+// points. The first value is the starting byte index
+// of the `rune` and the second the `rune` itself.
+// See [Strings and Runes](strings-and-runes) for more
+// details.
+func runesOf(s string) (offsets []int, runes []rune) {
+	// TODO: Use range over s to collect the index and rune value of each code point
+	return nil, nil
+}
 
-	// TODO: Use range to iterate over kvs to print key and value
-
-
-	// `range` can also iterate over just the keys of a map.
-	// TODO: Use range to iterate over just keys of kvs
-
-
-	// `range` on strings iterates over Unicode code
-	// points. The first value is the starting byte index
-	// of the `rune` and the second the `rune` itself.
-	// See [Strings and Runes](strings-and-runes) for more
-	// details.
-
-	// TODO: Use range over string "go" to print index and rune value
-
-}
\ No newline at end of file
+func main() {
+	nums := []int{2, 3, 4}
+	fmt.Println("sum:", sum(nums))
+	fmt.Println("index:", indexOf(nums, 3))
+
+	kvs := map[string]string{"a": "apple", "b": "banana"}
+	for _, p := range pairs(kvs) {
+		fmt.Println(p)
+	}
+	for _, k := range keys(kvs) {
+		fmt.Println("key:", k)
+	}
+
+	offsets, runes := runesOf("go")
+	for i := range offsets {
+		fmt.Println(offsets[i], runes[i])
+	}
+}
@@ -0,0 +1,19 @@
+package main
+
+import "testing"
+
+func TestZeroval(t *testing.T) {
+	i := 1
+	zeroval(i)
+	if i != 1 {
+		t.Errorf("Expected zeroval to leave the caller's value at 1, got %d", i)
+	}
+}
+
+func TestZeroptr(t *testing.T) {
+	i := 1
+	zeroptr(&i)
+	if i != 0 {
+		t.Errorf("Expected zeroptr to set the value to 0, got %d", i)
+	}
+}
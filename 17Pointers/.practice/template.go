@@ -11,8 +11,9 @@ import "fmt"
 // `int` parameter, so arguments will be passed to it by
 // value. `zeroval` will get a copy of `ival` distinct
 // from the one in the calling function.
-
-// TODO: Create function zeroval(ival int) that sets ival = 0
+func zeroval(ival int) {
+	// TODO: Set ival = 0
+}
 
 // `zeroptr` in contrast has an `*int` parameter, meaning
 // that it takes an `int` pointer. The `*iptr` code in the
@@ -20,21 +21,22 @@ import "fmt"
 // memory address to the current value at that address.
 // Assigning a value to a dereferenced pointer changes the
 // value at the referenced address.
-
-// TODO: Create function zeroptr(iptr *int) that sets *iptr = 0
-
+func zeroptr(iptr *int) {
+	// TODO: Set *iptr = 0
+}
 
 func main() {
+	i := 1
+	fmt.Println("initial:", i)
 
-	// TODO: Create variable i := 1
-
-	// TODO: Call zeroval(i) and print result
+	zeroval(i)
+	fmt.Println("zeroval:", i)
 
 	// The `&i` syntax gives the memory address of `i`,
 	// i.e. a pointer to `i`.
+	zeroptr(&i)
+	fmt.Println("zeroptr:", i)
 
-	// TODO: Call zeroptr(&i) and print result
-
-	// Pointers can be printed too
-	// TODO: Print pointer of i using &i
-}
\ No newline at end of file
+	// Pointers can be printed too.
+	fmt.Println("pointer:", &i)
+}
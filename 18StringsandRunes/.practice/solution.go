@@ -0,0 +1,104 @@
+// A Go string is a read-only slice of bytes. The language
+// and the standard library treat strings specially - as
+// containers of text encoded in [UTF-8](https://en.wikipedia.org/wiki/UTF-8).
+// In other languages, strings are made of "characters".
+// In Go, the concept of a character is called a `rune` - it's
+// an integer that represents a Unicode code point.
+// [This Go blog post](https://go.dev/blog/strings) is a good
+// introduction to the topic.
+
+package main
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Indexing into a string produces the raw byte values at
+// each index. `hexBytes` returns the hex values of all
+// the bytes that constitute the code points in `s`.
+func hexBytes(s string) []string {
+	var out []string
+	for i := 0; i < len(s); i++ {
+		out = append(out, fmt.Sprintf("%x", s[i]))
+	}
+	return out
+}
+
+// To count how many _runes_ are in a string, we can use
+// the `utf8` package. Note that the run-time of
+// `RuneCountInString` depends on the size of the string,
+// because it has to decode each UTF-8 rune sequentially.
+// Some Thai characters are represented by UTF-8 code points
+// that can span multiple bytes, so the result of this count
+// may be surprising.
+func runeCount(s string) int {
+	return utf8.RuneCountInString(s)
+}
+
+// A `range` loop handles strings specially and decodes
+// each `rune` along with its offset in the string.
+func runeInfo(s string) []string {
+	var out []string
+	for idx, runeValue := range s {
+		out = append(out, fmt.Sprintf("%#U starts at %d", runeValue, idx))
+	}
+	return out
+}
+
+// We can achieve the same iteration by using the
+// `utf8.DecodeRuneInString` function explicitly.
+func decode(s string) (runes []rune, offsets []int) {
+	for i, w := 0, 0; i < len(s); i += w {
+		runeValue, width := utf8.DecodeRuneInString(s[i:])
+		runes = append(runes, runeValue)
+		offsets = append(offsets, i)
+		w = width
+	}
+	return runes, offsets
+}
+
+// This demonstrates passing a `rune` value to a function.
+// Values enclosed in single quotes are _rune literals_. We
+// can compare a `rune` value to a rune literal directly.
+func examineRune(r rune) string {
+	if r == 't' {
+		return "found tee"
+	} else if r == 'ส' {
+		return "found so sua"
+	}
+	return ""
+}
+
+func main() {
+
+	// `s` is a `string` assigned a literal value
+	// representing the word "hello" in the Thai
+	// language. Go string literals are UTF-8
+	// encoded text.
+	const s = "สวัสดี"
+
+	// Since strings are equivalent to `[]byte`, this
+	// will produce the length of the raw bytes stored within.
+	fmt.Println("Len:", len(s))
+
+	for _, h := range hexBytes(s) {
+		fmt.Printf("%s ", h)
+	}
+	fmt.Println()
+
+	fmt.Println("Rune count:", runeCount(s))
+
+	for _, info := range runeInfo(s) {
+		fmt.Println(info)
+	}
+
+	fmt.Println("\nUsing DecodeRuneInString")
+	runes, offsets := decode(s)
+	for i, runeValue := range runes {
+		fmt.Printf("%#U starts at %d\n", runeValue, offsets[i])
+		if found := examineRune(runeValue); found != "" {
+			fmt.Println(found)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+const thai = "สวัสดี"
+
+func TestHexBytes(t *testing.T) {
+	got := hexBytes(thai)
+	if len(got) != 18 {
+		t.Fatalf("Expected 18 bytes, got %d", len(got))
+	}
+	if want := []string{"e0", "b8", "aa"}; !slices.Equal(got[:3], want) {
+		t.Errorf("Expected first bytes %v, got %v", want, got[:3])
+	}
+	if want := []string{"68", "69"}; !slices.Equal(hexBytes("hi"), want) {
+		t.Errorf("Expected %v, got %v", want, hexBytes("hi"))
+	}
+}
+
+func TestRuneCount(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{thai, 6},
+		{"hello", 5},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		if got := runeCount(tt.s); got != tt.want {
+			t.Errorf("runeCount(%q): expected %d, got %d", tt.s, tt.want, got)
+		}
+	}
+}
+
+func TestRuneInfo(t *testing.T) {
+	got := runeInfo(thai)
+	if len(got) != 6 {
+		t.Fatalf("Expected 6 runes, got %d", len(got))
+	}
+	if want := "U+0E2A 'ส' starts at 0"; got[0] != want {
+		t.Errorf("Expected %q, got %q", want, got[0])
+	}
+	if want := "U+0E35 'ี' starts at 15"; got[5] != want {
+		t.Errorf("Expected %q, got %q", want, got[5])
+	}
+}
+
+func TestDecode(t *testing.T) {
+	runes, offsets := decode(thai)
+	if string(runes) != thai {
+		t.Errorf("Expected runes of %q, got %q", thai, string(runes))
+	}
+	if want := []int{0, 3, 6, 9, 12, 15}; !slices.Equal(offsets, want) {
+		t.Errorf("Expected offsets %v, got %v", want, offsets)
+	}
+}
+
+func TestExamineRune(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want string
+	}{
+		{'t', "found tee"},
+		{'ส', "found so sua"},
+		{'x', ""},
+	}
+	for _, tt := range tests {
+		if got := examineRune(tt.r); got != tt.want {
+			t.Errorf("examineRune(%q): expected %q, got %q", tt.r, tt.want, got)
+		}
+	}
+}
@@ -9,10 +9,52 @@
 
 package main
 
-import (
-	"fmt"
-	"unicode/utf8"
-)
+import "fmt"
+
+// Indexing into a string produces the raw byte values at
+// each index. `hexBytes` returns the hex values of all
+// the bytes that constitute the code points in `s`.
+func hexBytes(s string) []string {
+	// TODO: Loop through the bytes of s and collect each one as hex
+	// Hint: fmt.Sprintf("%x", s[i])
+	return nil
+}
+
+// To count how many _runes_ are in a string, we can use
+// the `utf8` package. Note that the run-time of
+// `RuneCountInString` depends on the size of the string,
+// because it has to decode each UTF-8 rune sequentially.
+// Some Thai characters are represented by UTF-8 code points
+// that can span multiple bytes, so the result of this count
+// may be surprising.
+func runeCount(s string) int {
+	// TODO: Return the rune count of s. Use utf8.RuneCountInString
+	return 0
+}
+
+// A `range` loop handles strings specially and decodes
+// each `rune` along with its offset in the string.
+func runeInfo(s string) []string {
+	// TODO: Range over s and collect "<rune> starts at <index>" for each rune
+	// Hint: fmt.Sprintf("%#U starts at %d", runeValue, idx)
+	return nil
+}
+
+// We can achieve the same iteration by using the
+// `utf8.DecodeRuneInString` function explicitly.
+func decode(s string) (runes []rune, offsets []int) {
+	// TODO: Loop through s with utf8.DecodeRuneInString and collect each rune and its offset
+	// Use for loop and i, w := 0, 0; i < len(s); i += w, where w is the width of the rune
+	return nil, nil
+}
+
+// This demonstrates passing a `rune` value to a function.
+// Values enclosed in single quotes are _rune literals_. We
+// can compare a `rune` value to a rune literal directly.
+func examineRune(r rune) string {
+	// TODO: Return "found tee" if r is 't' and "found so sua" if r is 'ส', otherwise ""
+	return ""
+}
 
 func main() {
 
@@ -24,41 +66,25 @@ func main() {
 
 	// Since strings are equivalent to `[]byte`, this
 	// will produce the length of the raw bytes stored within.
+	fmt.Println("Len:", len(s))
 
-	// TODO: Print length of s
-
-	// Indexing into a string produces the raw byte values at
-	// each index. This loop generates the hex values of all
-	// the bytes that constitute the code points in `s`.
+	for _, h := range hexBytes(s) {
+		fmt.Printf("%s ", h)
+	}
+	fmt.Println()
 
-	// TODO: Loop through s and print hex values
+	fmt.Println("Rune count:", runeCount(s))
 
-	// To count how many _runes_ are in a string, we can use
-	// the `utf8` package. Note that the run-time of
-	// `RuneCountInString` depends on the size of the string,
-	// because it has to decode each UTF-8 rune sequentially.
-	// Some Thai characters are represented by UTF-8 code points
-	// that can span multiple bytes, so the result of this count
-	// may be surprising.
-
-	// TODO: Print rune count of s. Use utf8.RuneCountInString
-
-	// A `range` loop handles strings specially and decodes
-	// each `rune` along with its offset in the string.
-
-	// TODO: Loop through s and print index and rune value
-
-	// We can achieve the same iteration by using the
-	// `utf8.DecodeRuneInString` function explicitly.
-
-
-	// TODO: Loop through s and print index and rune value using utf8.DecodeRuneInString
-	// Use for loop and i, w := 0, 0; i < len(s); i += w, where w is the width of the rune
-
-	// Also demonstrate passing a `rune` value to a function, examineRune
+	for _, info := range runeInfo(s) {
+		fmt.Println(info)
+	}
 
 	fmt.Println("\nUsing DecodeRuneInString")
-
+	runes, offsets := decode(s)
+	for i, runeValue := range runes {
+		fmt.Printf("%#U starts at %d\n", runeValue, offsets[i])
+		if found := examineRune(runeValue); found != "" {
+			fmt.Println(found)
+		}
+	}
 }
-
-// TODO: Create function examineRune(r rune) that prints "found tee" if r is 't' and "found so sua" if r is 'ส'
\ No newline at end of file
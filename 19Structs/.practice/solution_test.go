@@ -0,0 +1,26 @@
+package main
+
+import "testing"
+
+func TestNewPerson(t *testing.T) {
+	p := newPerson("Jon")
+	if p == nil {
+		t.Fatal("Expected a pointer to a person, got nil")
+	}
+	if p.name != "Jon" || p.age != 42 {
+		t.Errorf("Expected {Jon 42}, got %+v", *p)
+	}
+
+	// Each call must return a distinct person.
+	if q := newPerson("Jon"); q == p {
+		t.Error("Expected newPerson to return a new pointer on each call")
+	}
+}
+
+func TestSetAge(t *testing.T) {
+	s := person{name: "Sean", age: 50}
+	setAge(&s, 51)
+	if s.age != 51 {
+		t.Errorf("Expected age 51, got %d", s.age)
+	}
+}
@@ -7,62 +7,65 @@ package main
 import "fmt"
 
 // This `person` struct type has `name` and `age` fields.
-
-// TODO: Define struct person with name (string) and age (int) fields
+type person struct {
+	name string
+	age  int
+}
 
 // `newPerson` constructs a new person struct with the given name.
-
-// TODO: Create function newPerson(name string) *person
-// Go is a garbage collected language; you can safely
-// return a pointer to a local variable - it will only
-// be cleaned up by the garbage collector when there
-// are no active references to it.
-// Create person p with the given name, set p.age = 42, return &p
+func newPerson(name string) *person {
+	// Go is a garbage collected language; you can safely
+	// return a pointer to a local variable - it will only
+	// be cleaned up by the garbage collector when there
+	// are no active references to it.
+	// TODO: Create person p with the given name, set p.age = 42, return &p
+	return &person{}
+}
+
+// Structs are mutable. `setAge` changes the age of the
+// person `sp` points to; you can use dots with struct
+// pointers - the pointers are automatically dereferenced.
+func setAge(sp *person, age int) {
+	// TODO: Set sp.age = age
+}
 
 func main() {
 
 	// This syntax creates a new struct.
-
-	// TODO: Print person{"Bob", 20}
+	fmt.Println(person{"Bob", 20})
 
 	// You can name the fields when initializing a struct.
-
-	// TODO: Print person{name: "Alice", age: 30}
+	fmt.Println(person{name: "Alice", age: 30})
 
 	// Omitted fields will be zero-valued.
-
-	// TODO: Print person{name: "Fred"}
+	fmt.Println(person{name: "Fred"})
 
 	// An `&` prefix yields a pointer to the struct.
-
-	// TODO: Print &person{name: "Ann", age: 40}
+	fmt.Println(&person{name: "Ann", age: 40})
 
 	// It's idiomatic to encapsulate new struct creation in constructor functions
-
-	// TODO: Print newPerson("Jon")
+	fmt.Println(newPerson("Jon"))
 
 	// Access struct fields with a dot.
+	s := person{name: "Sean", age: 50}
+	fmt.Println(s.name)
 
-	// TODO: Create s := person{name: "Sean", age: 50}
-	// TODO: Print s.name
-
-	// You can also use dots with struct pointers - the
-	// pointers are automatically dereferenced.
-
-	// TODO: Create sp := &s
-	// TODO: Print sp.age
+	sp := &s
+	fmt.Println(sp.age)
 
-	// Structs are mutable.
-
-	// TODO: Set sp.age = 51
-	// TODO: Print sp.age
+	setAge(sp, 51)
+	fmt.Println(sp.age)
 
 	// If a struct type is only used for a single value, we don't
 	// have to give it a name. The value can have an anonymous
 	// struct type. This technique is commonly used for
 	// [table-driven tests](testing-and-benchmarking).
-
-	// TODO: Create anonymous struct dog with name (string) and isGood (bool) fields
-	// Initialize with "Rex" and true, then print it
-
-}
\ No newline at end of file
+	dog := struct {
+		name   string
+		isGood bool
+	}{
+		"Rex",
+		true,
+	}
+	fmt.Println(dog)
+}
@@ -0,0 +1,31 @@
+package main
+
+import "testing"
+
+func TestRectMethods(t *testing.T) {
+	tests := []struct {
+		r     rect
+		area  float64
+		perim float64
+	}{
+		{rect{width: 10, height: 5}, 50, 30},
+		{rect{width: 3, height: 4}, 12, 14},
+		{rect{}, 0, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.r.area(); got != tt.area {
+			t.Errorf("%+v area: expected %v, got %v", tt.r, tt.area, got)
+		}
+		if got := tt.r.perim(); got != tt.perim {
+			t.Errorf("%+v perim: expected %v, got %v", tt.r, tt.perim, got)
+		}
+	}
+}
+
+func TestGrow(t *testing.T) {
+	r := rect{width: 10, height: 5}
+	r.grow(1)
+	if r.width != 11 || r.height != 6 {
+		t.Errorf("Expected grow to mutate the receiver to {11 6}, got %+v", r)
+	}
+}
@@ -4,31 +4,42 @@ package main
 
 import "fmt"
 
-// TODO: Define struct rect with width, height float64
+type rect struct {
+	width, height float64
+}
 
 // Here we define an `area` method which has a _receiver type_ of `*rect`.
-
-// TODO: Create method area() float64 on rect that returns width * height
+func (r *rect) area() float64 {
+	// TODO: Return width * height
+	return 0
+}
 
 // Methods can be defined for either pointer or value receiver types.
 // Here's an example of a value receiver.
+func (r rect) perim() float64 {
+	// TODO: Return 2*width + 2*height
+	return 0
+}
 
-// TODO: Create method perim() float64 on rect that returns 2*width + 2*height
+// `grow` uses a pointer receiver so that it can mutate
+// the receiving struct.
+func (r *rect) grow(by float64) {
+	// TODO: Add by to both width and height
+}
 
 func main() {
 	r := rect{width: 10, height: 5}
 
 	// Here we call the 2 methods defined for our struct.
-
-	// TODO: Call r.area() and print the result
-	// TODO: Call r.perim() and print the result
+	fmt.Println("area: ", r.area())
+	fmt.Println("perim:", r.perim())
 
 	// Go automatically handles conversion between values
 	// and pointers for method calls. You may want to use
 	// a pointer receiver type to avoid copying on method
 	// calls or to allow the method to mutate the
 	// receiving struct.
-
-	// TODO: Create rp := &r
-	// TODO: Call rp.area() and rp.perim() and print results
-}
\ No newline at end of file
+	rp := &r
+	fmt.Println("area: ", rp.area())
+	fmt.Println("perim:", rp.perim())
+}
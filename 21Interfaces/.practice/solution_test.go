@@ -0,0 +1,43 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGeometry(t *testing.T) {
+	tests := []struct {
+		name  string
+		g     geometry
+		area  float64
+		perim float64
+	}{
+		{"rect", rect{width: 3, height: 4}, 12, 14},
+		{"circle", circle{radius: 5}, 25 * math.Pi, 10 * math.Pi},
+		{"unit circle", circle{radius: 1}, math.Pi, 2 * math.Pi},
+	}
+	for _, tt := range tests {
+		if got := tt.g.area(); math.Abs(got-tt.area) > 1e-9 {
+			t.Errorf("%s area: expected %v, got %v", tt.name, tt.area, got)
+		}
+		if got := tt.g.perim(); math.Abs(got-tt.perim) > 1e-9 {
+			t.Errorf("%s perim: expected %v, got %v", tt.name, tt.perim, got)
+		}
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		in   interface{}
+		want string
+	}{
+		{rect{width: 3, height: 4}, "Rectangle 3 x 4"},
+		{circle{radius: 5}, "Circle with radius 5"},
+		{42, "Unknown type"},
+	}
+	for _, tt := range tests {
+		if got := describe(tt.in); got != tt.want {
+			t.Errorf("describe(%v): expected %q, got %q", tt.in, tt.want, got)
+		}
+	}
+}
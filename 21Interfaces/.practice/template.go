@@ -3,39 +3,65 @@
 
 package main
 
-import (
-	"fmt"
-	"math"
-)
+import "fmt"
 
 // Here's a basic interface for geometric shapes.
-
-// TODO: Define interface geometry with area() float64 and perim() float64 methods
+type geometry interface {
+	area() float64
+	perim() float64
+}
 
 // For our example we'll implement this interface on
 // `rect` and `circle` types.
-
-// TODO: Define struct rect with width, height float64
-// TODO: Define struct circle with radius float64
+type rect struct {
+	width, height float64
+}
+type circle struct {
+	radius float64
+}
 
 // To implement an interface in Go, we just need to
 // implement all the methods in the interface. Here we
 // implement `geometry` on `rect`s.
-
-// TODO: Implement area() method on rect that returns width * height
-// TODO: Implement perim() method on rect that returns 2*width + 2*height
+func (r rect) area() float64 {
+	// TODO: Return width * height
+	return 0
+}
+func (r rect) perim() float64 {
+	// TODO: Return 2*width + 2*height
+	return 0
+}
 
 // The implementation for `circle`s.
-
-// TODO: Implement area() method on circle that returns math.Pi * radius * radius
-// TODO: Implement perim() method on circle that returns 2 * math.Pi * radius
+func (c circle) area() float64 {
+	// TODO: Return math.Pi * radius * radius
+	return 0
+}
+func (c circle) perim() float64 {
+	// TODO: Return 2 * math.Pi * radius
+	return 0
+}
 
 // If a variable has an interface type, then we can call
 // methods that are in the named interface. Here's a
 // generic `measure` function taking advantage of this
 // to work on any `geometry`.
-
-// TODO: Create function measure(g geometry) that prints g, g.area(), and g.perim()
+func measure(g geometry) {
+	fmt.Println(g)
+	fmt.Println(g.area())
+	fmt.Println(g.perim())
+}
+
+// Sometimes it's useful to know the runtime type of an
+// interface value. One option is using a *type assertion*
+// as shown here; another is a [type `switch`](switch).
+func describe(i interface{}) string {
+	// TODO: Use a type assertion to check if i is a circle, if so return "Circle with radius <radius>"
+	// Check if i is a rect, if so return "Rectangle <width> x <height>"
+	// Otherwise return "Unknown type"
+	// Hint: fmt.Sprint("Circle with radius ", c.radius)
+	return ""
+}
 
 func main() {
 	r := rect{width: 3, height: 4}
@@ -44,17 +70,9 @@ func main() {
 	// The `circle` and `rect` struct types both
 	// implement the `geometry` interface so we can use
 	// instances of these structs as arguments to `measure`.
+	measure(r)
+	measure(c)
 
-	// TODO: Call measure(r) and measure(c)
-
-	// Sometimes it's useful to know the runtime type of an
-	// interface value. One option is using a *type assertion*
-	// as shown here; another is a [type `switch`](switch).
-
-	// TODO: Create function describe(i interface{}) that uses type assertion
-	// Check if i is a circle, if so print "Circle with radius" and the radius
-	// Check if i is a rect, if so print "Rectangle" and dimensions
-	// Otherwise print "Unknown type"
-
-	// TODO: Call describe(r) and describe(c)
-}
\ No newline at end of file
+	fmt.Println(describe(r))
+	fmt.Println(describe(c))
+}
@@ -0,0 +1,45 @@
+package main
+
+import "testing"
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		s    ServerState
+		want string
+	}{
+		{StateIdle, "idle"},
+		{StateConnected, "connected"},
+		{StateError, "error"},
+		{StateRetrying, "retrying"},
+	}
+	for _, tt := range tests {
+		if got := tt.s.String(); got != tt.want {
+			t.Errorf("ServerState(%d).String(): expected %q, got %q", int(tt.s), tt.want, got)
+		}
+	}
+}
+
+func TestTransition(t *testing.T) {
+	tests := []struct {
+		from, to ServerState
+	}{
+		{StateIdle, StateConnected},
+		{StateConnected, StateIdle},
+		{StateRetrying, StateIdle},
+		{StateError, StateError},
+	}
+	for _, tt := range tests {
+		if got := transition(tt.from); got != tt.to {
+			t.Errorf("transition(%d): expected %d, got %d", int(tt.from), int(tt.to), int(got))
+		}
+	}
+}
+
+func TestTransitionUnknownState(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected transition to panic on an unknown state")
+		}
+	}()
+	transition(ServerState(99))
+}
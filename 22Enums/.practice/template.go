@@ -10,15 +10,18 @@ package main
 import "fmt"
 
 // Our enum type `ServerState` has an underlying `int` type.
-
-// TODO: Define struct ServerState with underlying int type
+type ServerState int
 
 // The possible values for `ServerState` are defined as
 // constants. The special keyword [iota](https://go.dev/ref/spec#Iota)
 // generates successive constant values automatically; in this
 // case 0, 1, 2 and so on.
-
-// TODO: Define constants for StateIdle, StateConnected, StateError, StateRetrying
+const (
+	StateIdle ServerState = iota
+	StateConnected
+	StateError
+	StateRetrying
+)
 
 // By implementing the [fmt.Stringer](https://pkg.go.dev/fmt#Stringer)
 // interface, values of `ServerState` can be printed out or converted
@@ -29,32 +32,38 @@ import "fmt"
 // can be used in conjunction with `go:generate` to automate the
 // process. See [this post](https://eli.thegreenplace.net/2021/a-comprehensive-guide-to-go-generate)
 // for a longer explanation.
+var stateName = map[ServerState]string{
+	// TODO: Map each state to its name
+	// StateIdle: "idle",
+	// StateConnected: "connected",
+	// StateError: "error",
+	// StateRetrying: "retrying",
+}
 
-// TODO: Define map stateName with ServerState keys and string values
-// StateIdle: "idle",
-// StateConnected: "connected",
-// StateError: "error",
-// StateRetrying: "retrying",
-
-// TODO: Define method String() string on ServerState that returns stateName[ss]
+func (ss ServerState) String() string {
+	// TODO: Return stateName[ss]
+	return ""
+}
 
 func main() {
-	
-	// TODO: Create ns := transition(StateIdle) and print it
+	ns := transition(StateIdle)
+	fmt.Println(ns)
 
 	// If we have a value of type `int`, we cannot pass it to `transition` - the
 	// compiler will complain about type mismatch. This provides some degree of
 	// compile-time type safety for enums.
-
-	// TODO: Create ns2 := transition(ns) and print it
-
+	ns2 := transition(ns)
+	fmt.Println(ns2)
 }
 
 // transition emulates a state transition for a
 // server; it takes the existing state and returns
 // a new state.
-
-// TODO: Create function transition(s ServerState) ServerState that 
-// returns StateConnected if s is StateIdle, 
-// StateIdle if s is StateConnected or StateRetrying, 
-// StateError if s is StateError
\ No newline at end of file
+func transition(s ServerState) ServerState {
+	// TODO: Use a switch on s that
+	// returns StateConnected if s is StateIdle,
+	// StateIdle if s is StateConnected or StateRetrying,
+	// StateError if s is StateError,
+	// and panics with fmt.Errorf("unknown state: %s", s) otherwise
+	return s
+}
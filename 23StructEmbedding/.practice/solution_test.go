@@ -0,0 +1,27 @@
+package main
+
+import "testing"
+
+func TestDescribe(t *testing.T) {
+	b := base{num: 7}
+	if got, want := b.describe(), "base with num=7"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestNewContainer(t *testing.T) {
+	co := newContainer(1, "some name")
+	if co.num != 1 || co.base.num != 1 {
+		t.Errorf("Expected embedded num 1, got %d", co.num)
+	}
+	if co.str != "some name" {
+		t.Errorf("Expected str %q, got %q", "some name", co.str)
+	}
+
+	// The embedded method is promoted, so container
+	// satisfies describer.
+	var d describer = co
+	if got, want := d.describe(), "base with num=1"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
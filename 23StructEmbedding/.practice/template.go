@@ -8,46 +8,58 @@ package main
 
 import "fmt"
 
-// TODO: Define struct base with num int field
+type base struct {
+	num int
+}
 
-// TODO: Create method describe() string on base that returns fmt.Sprintf("base with num=%v", b.num)
+func (b base) describe() string {
+	// TODO: Return fmt.Sprintf("base with num=%v", b.num)
+	return ""
+}
 
 // A `container` _embeds_ a `base`. An embedding looks
 // like a field without a name.
-
-// TODO: Define struct container that embeds base and has str string field
+type container struct {
+	base
+	str string
+}
+
+// `describer` is satisfied by any type with a
+// `describe` method.
+type describer interface {
+	describe() string
+}
+
+// `newContainer` builds a `container`. When creating
+// structs with literals, we have to initialize the
+// embedding explicitly; here the embedded type serves
+// as the field name.
+func newContainer(num int, str string) container {
+	// TODO: Return a container with base: base{num: num} and str: str
+	return container{}
+}
 
 func main() {
-
-	// When creating structs with literals, we have to
-	// initialize the embedding explicitly; here the
-	// embedded type serves as the field name.
-
-	// TODO: Create co := container with base: base{num: 1} and str: "some name"
+	co := newContainer(1, "some name")
 
 	// We can access the base's fields directly on `co`,
 	// e.g. `co.num`.
-
-	// TODO: Print "co={num: %v, str: %v}" with co.num and co.str
+	fmt.Printf("co={num: %v, str: %v}\n", co.num, co.str)
 
 	// Alternatively, we can spell out the full path using
 	// the embedded type name.
-
-	// TODO: Print "also num:" followed by co.base.num
+	fmt.Println("also num:", co.base.num)
 
 	// Since `container` embeds `base`, the methods of
 	// `base` also become methods of a `container`. Here
 	// we invoke a method that was embedded from `base`
 	// directly on `co`.
-
-	// TODO: Print "describe:" followed by co.describe()
-
-	// TODO: Define interface describer with describe() string method
+	fmt.Println("describe:", co.describe())
 
 	// Embedding structs with methods may be used to bestow
 	// interface implementations onto other structs. Here
 	// we see that a `container` now implements the
 	// `describer` interface because it embeds `base`.
-
-	// TODO: Create var d describer = co and print "describer:" followed by d.describe()
-}
\ No newline at end of file
+	var d describer = co
+	fmt.Println("describer:", d.describe())
+}
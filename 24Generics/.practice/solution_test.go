@@ -0,0 +1,47 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSlicesIndex(t *testing.T) {
+	s := []string{"foo", "bar", "zoo"}
+	if got := SlicesIndex(s, "zoo"); got != 2 {
+		t.Errorf("Expected index 2, got %d", got)
+	}
+	if got := SlicesIndex(s, "baz"); got != -1 {
+		t.Errorf("Expected -1 for a missing value, got %d", got)
+	}
+
+	// The first occurrence wins.
+	if got := SlicesIndex([]int{1, 2, 1}, 1); got != 0 {
+		t.Errorf("Expected index 0, got %d", got)
+	}
+
+	// Named slice types satisfy the ~[]E constraint.
+	type names []string
+	if got := SlicesIndex(names{"a", "b"}, "b"); got != 1 {
+		t.Errorf("Expected index 1, got %d", got)
+	}
+}
+
+func TestList(t *testing.T) {
+	lst := List[int]{}
+	if got := lst.AllElements(); len(got) != 0 {
+		t.Errorf("Expected an empty list, got %v", got)
+	}
+
+	lst.Push(10)
+	lst.Push(13)
+	lst.Push(23)
+	if got, want := lst.AllElements(), []int{10, 13, 23}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	words := List[string]{}
+	words.Push("go")
+	if got := words.AllElements(); !slices.Equal(got, []string{"go"}) {
+		t.Errorf("Expected [go], got %v", got)
+	}
+}
@@ -14,56 +14,55 @@ import "fmt"
 // of this type signature, see [this blog post](https://go.dev/blog/deconstructing-type-parameters).
 // Note that this function exists in the standard library
 // as [slices.Index](https://pkg.go.dev/slices#Index).
-
-
-// TODO: Create function SlicesIndex[S ~[]E, E comparable](s S, v E) int that returns the index of the first occurrence of v in s, or -1 if not present
-
+func SlicesIndex[S ~[]E, E comparable](s S, v E) int {
+	// TODO: Return the index of the first occurrence of v in s, or -1 if not present
+	return -1
+}
 
 // As an example of a generic type, `List` is a
 // singly-linked list with values of any type.
+type List[T any] struct {
+	head, tail *element[T]
+}
 
-
-// TODO: Define struct List[T any] with head and tail pointers to element[T]
-
-
-// TODO: Define struct element[T any] with next pointer to element[T] and val field of type T
-
+type element[T any] struct {
+	next *element[T]
+	val  T
+}
 
 // We can define methods on generic types just like we
 // do on regular types, but we have to keep the type
 // parameters in place. The type is `List[T]`, not `List`.
-
-
-// TODO: Define method Push(v T) on List[T] that pushes a value v to the list
-
+func (lst *List[T]) Push(v T) {
+	// TODO: Push v to the end of the list
+	// If tail is nil, set head and tail to the new element,
+	// otherwise set tail.next to the new element and tail to the new element
+}
 
 // AllElements returns all the List elements as a slice.
 // In the next example we'll see a more idiomatic way
 // of iterating over all elements of custom types.
-
-
-// TODO: Define method AllElements() []T on List[T] that returns all the List elements as a slice
-
+func (lst *List[T]) AllElements() []T {
+	// TODO: Walk the list from head following next and collect every val
+	return nil
+}
 
 func main() {
-
-	// TODO: Create var s = []string{"foo", "bar", "zoo"}
-
+	var s = []string{"foo", "bar", "zoo"}
 
 	// When invoking generic functions, we can often rely
 	// on _type inference_. Note that we don't have to
 	// specify the types for `S` and `E` when
 	// calling `SlicesIndex` - the compiler infers them
 	// automatically.
-
-	// TODO: Print index of zoo, zoo should be 2
+	fmt.Println("index of zoo:", SlicesIndex(s, "zoo"))
 
 	// ... though we could also specify them explicitly.
-
-	// TODO: Get index of zoo using explicit types
-
-	// TODO: Create lst := List[int]{}
-	// TODO: Push 10, 13, 23 to lst
-	// TODO: Print list: lst.AllElements()
-
-}
\ No newline at end of file
+	_ = SlicesIndex[[]string, string](s, "zoo")
+
+	lst := List[int]{}
+	lst.Push(10)
+	lst.Push(13)
+	lst.Push(23)
+	fmt.Println("list:", lst.AllElements())
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestListAll(t *testing.T) {
+	lst := List[int]{}
+	lst.Push(10)
+	lst.Push(13)
+	lst.Push(23)
+
+	if got, want := slices.Collect(lst.All()), []int{10, 13, 23}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	// Breaking out of the loop must stop the iterator.
+	var seen []int
+	for e := range lst.All() {
+		seen = append(seen, e)
+		if e == 13 {
+			break
+		}
+	}
+	if want := []int{10, 13}; !slices.Equal(seen, want) {
+		t.Errorf("Expected iteration to stop after %v, got %v", want, seen)
+	}
+}
+
+func TestGenFib(t *testing.T) {
+	var got []int
+	for n := range genFib() {
+		if len(got) == 8 {
+			break
+		}
+		got = append(got, n)
+	}
+	if want := []int{1, 1, 2, 3, 5, 8, 13, 21}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestF(t *testing.T) {
+	r, err := f(7)
+	if err != nil {
+		t.Fatalf("f(7) failed: %v", err)
+	}
+	if r != 10 {
+		t.Errorf("Expected 10, got %d", r)
+	}
+
+	r, err = f(42)
+	if err == nil {
+		t.Fatal("Expected an error for 42, got nil")
+	}
+	if r != -1 {
+		t.Errorf("Expected -1 alongside the error, got %d", r)
+	}
+	if got, want := err.Error(), "can't work with 42"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestMakeTea(t *testing.T) {
+	for _, i := range []int{0, 1, 3} {
+		if err := makeTea(i); err != nil {
+			t.Errorf("makeTea(%d): expected no error, got %v", i, err)
+		}
+	}
+
+	if err := makeTea(2); err != ErrOutOfTea {
+		t.Errorf("makeTea(2): expected ErrOutOfTea, got %v", err)
+	}
+
+	// The power error is wrapped, so it must be found
+	// with errors.Is rather than ==.
+	err := makeTea(4)
+	if !errors.Is(err, ErrPower) {
+		t.Errorf("makeTea(4): expected an error wrapping ErrPower, got %v", err)
+	}
+	if err == ErrPower {
+		t.Error("makeTea(4): expected ErrPower to be wrapped with context")
+	}
+}
@@ -20,39 +20,60 @@ import (
 
 // By convention, errors are the last return value and
 // have type `error`, a built-in interface.
-
-// TODO: Create function f(arg int) (int, error) that returns -1, errors.New("can't work with 42") if arg == 42,
-// otherwise returns arg + 3, nil
+func f(arg int) (int, error) {
+	// TODO: Return -1, errors.New("can't work with 42") if arg == 42,
+	// otherwise return arg + 3, nil
+	return 0, nil
+}
 
 // A sentinel error is a predeclared variable that is used to
 // signify a specific error condition.
+var ErrOutOfTea = fmt.Errorf("no more tea available")
+var ErrPower = fmt.Errorf("can't boil water")
 
-// TODO: Define var ErrOutOfTea = fmt.Errorf("no more tea available")
-// TODO: Define var ErrPower = fmt.Errorf("can't boil water")
+func makeTea(arg int) error {
+	// TODO: Return ErrOutOfTea if arg == 2, nil otherwise
 
-// TODO: Define function makeTea(arg int) error that returns ErrOutOfTea if arg == 2, ErrPower if arg == 4, nil otherwise
+	// We can wrap errors with higher-level errors to add
+	// context. The simplest way to do this is with the
+	// `%w` verb in `fmt.Errorf`. Wrapped errors
+	// create a logical chain (A wraps B, which wraps C, etc.)
+	// that can be queried with functions like `errors.Is`
+	// and `errors.As`.
 
-// We can wrap errors with higher-level errors to add
-// context. The simplest way to do this is with the
-// `%w` verb in `fmt.Errorf`. Wrapped errors
-// create a logical chain (A wraps B, which wraps C, etc.)
-// that can be queried with functions like `errors.Is`
-// and `errors.As`.
+	// TODO: If arg == 4, return fmt.Errorf("making tea: %w", ErrPower)
+	return nil
+}
 
 func main() {
+	for _, i := range []int{7, 42} {
 
-	// TODO: Use range to iterate over []int{7, 42} and check if f(i) is nil
-	// TODO: Print result
-	// It's idiomatic to use an inline error check in the `if`
-	// line.
-
+		// It's idiomatic to use an inline error check in the `if`
+		// line.
+		if r, e := f(i); e != nil {
+			fmt.Println("f failed:", e)
+		} else {
+			fmt.Println("f worked:", r)
+		}
+	}
 
-	// TODO: Use range to iterate over 5 and check if makeTea(i) is nil
-	// Check if err is ErrOutOfTea or ErrPower, print the error message
+	for i := range 5 {
+		if err := makeTea(i); err != nil {
 
-	// `errors.Is` checks that a given error (or any error in its chain)
-	// matches a specific error value. This is especially useful with wrapped or
-	// nested errors, allowing you to identify specific error types or sentinel
-	// errors in a chain of errors.
+			// `errors.Is` checks that a given error (or any error in its chain)
+			// matches a specific error value. This is especially useful with wrapped or
+			// nested errors, allowing you to identify specific error types or sentinel
+			// errors in a chain of errors.
+			if errors.Is(err, ErrOutOfTea) {
+				fmt.Println("We should buy new tea!")
+			} else if errors.Is(err, ErrPower) {
+				fmt.Println("Now it is dark.")
+			} else {
+				fmt.Printf("unknown error: %s\n", err)
+			}
+			continue
+		}
 
-}
\ No newline at end of file
+		fmt.Println("Tea is ready!")
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestArgError(t *testing.T) {
+	var err error = &argError{7, "bad"}
+	if got, want := err.Error(), "7 - bad"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestF(t *testing.T) {
+	r, err := f(7)
+	if err != nil || r != 10 {
+		t.Errorf("Expected 10, <nil>, got %d, %v", r, err)
+	}
+
+	_, err = f(42)
+	var ae *argError
+	if !errors.As(err, &ae) {
+		t.Fatalf("Expected an *argError, got %v", err)
+	}
+	if ae.arg != 42 || ae.message != "can't work with it" {
+		t.Errorf("Expected {42 can't work with it}, got %+v", *ae)
+	}
+
+	// errors.As also finds the custom error through a wrap.
+	wrapped := fmt.Errorf("calling f: %w", err)
+	if !errors.As(wrapped, &ae) {
+		t.Error("Expected errors.As to find *argError in a wrapped error")
+	}
+}
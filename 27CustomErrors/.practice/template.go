@@ -11,19 +11,23 @@ import (
 )
 
 // A custom error type usually has the suffix "Error".
-
-// TODO: Define struct argError with arg int and message string fields
-
+type argError struct {
+	arg     int
+	message string
+}
 
 // Adding this `Error` method makes `argError` implement
 // the `error` interface.
+func (e *argError) Error() string {
+	// TODO: Return fmt.Sprintf("%d - %s", e.arg, e.message)
+	return ""
+}
 
-// TODO: Define method Error() string on argError that returns fmt.Sprintf("%d - %s", e.arg, e.message)
-
-
-// TODO: Define function f(arg int) (int, error) that returns -1, &argError{arg, "can't work with it"} if arg == 42,
-// otherwise returns arg + 3, nil
-
+func f(arg int) (int, error) {
+	// TODO: Return -1, &argError{arg, "can't work with it"} if arg == 42,
+	// otherwise return arg + 3, nil
+	return 0, nil
+}
 
 func main() {
 
@@ -34,8 +38,10 @@ func main() {
 	// returns `false`.
 	_, err := f(42)
 	var ae *argError
-
-	// TODO: Use errors.As to check if err is an argError
-	// TODO: Print arg and message
-
-}
\ No newline at end of file
+	if errors.As(err, &ae) {
+		fmt.Println(ae.arg)
+		fmt.Println(ae.message)
+	} else {
+		fmt.Println("err doesn't match argError")
+	}
+}
@@ -0,0 +1,9 @@
+module github.com/orsenthil/practicego/28Goroutines/.practice
+
+go 1.25
+
+require github.com/orsenthil/practicego v0.0.0
+
+// The generated module imports practicekit from the root module,
+// which is the parent of the directory go.mod is generated in.
+replace github.com/orsenthil/practicego => ../
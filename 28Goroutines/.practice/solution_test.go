@@ -0,0 +1,49 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+func TestF(t *testing.T) {
+	got := practicetest.Capture(t, func() { f("direct") })
+
+	want := "direct : 0\ndirect : 1\ndirect : 2\n"
+	if got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestFConcurrent(t *testing.T) {
+	names := []string{"a", "b", "c", "d"}
+	out := practicetest.Capture(t, func() {
+		var wg sync.WaitGroup
+		for _, name := range names {
+			wg.Go(func() { f(name) })
+		}
+		wg.Wait()
+	})
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 3*len(names) {
+		t.Fatalf("Expected %d lines, got %d:\n%s", 3*len(names), len(lines), out)
+	}
+	// Goroutines interleave, but each one prints its own
+	// lines in order.
+	for _, name := range names {
+		var mine []string
+		for _, line := range lines {
+			if strings.HasPrefix(line, name+" :") {
+				mine = append(mine, line)
+			}
+		}
+		want := []string{name + " : 0", name + " : 1", name + " : 2"}
+		if !slices.Equal(mine, want) {
+			t.Errorf("Expected %v for %s, got %v", want, name, mine)
+		}
+	}
+}
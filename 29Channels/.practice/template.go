@@ -5,8 +5,6 @@
 
 package main
 
-import "fmt"
-
 func main() {
 
 	// Create a new channel with `make(chan val-type)`.
@@ -18,12 +16,11 @@ func main() {
 	// syntax. Here we send `"ping"`  to the `messages`
 	// channel we made above, from a new goroutine.
 
-	// TODO: Send "ping" to messages channel
+	// TODO: Send "ping" to messages channel from a new goroutine
 
 	// The `<-channel` syntax _receives_ a value from the
 	// channel. Here we'll receive the `"ping"` message
 	// we sent above and print it out.
 
-	// TODO: Receive msg from messages channel and print it
-
-}
\ No newline at end of file
+	// TODO: Receive msg from messages channel and print it with fmt.Println
+}
@@ -7,8 +7,6 @@
 
 package main
 
-import "fmt"
-
 func main() {
 
 	// Here we `make` a channel of strings buffering up to
@@ -24,6 +22,5 @@ func main() {
 
 	// Later we can receive these two values as usual.
 
-	// TODO: Receive "buffered" and "channel" from messages channel and print them
-
-}
\ No newline at end of file
+	// TODO: Receive both values from messages channel and print them with fmt.Println
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+func TestWorker(t *testing.T) {
+	workDuration = 0
+	t.Cleanup(func() { workDuration = time.Second })
+
+	done := make(chan bool, 1)
+	got := practicetest.Capture(t, func() {
+		go worker(done)
+		if !practicetest.Receive(t, done, "the worker to send on done") {
+			t.Error("Expected worker to send true on done")
+		}
+	})
+
+	// Receiving from done happens after the worker has
+	// printed everything, so the output is complete.
+	if got != "working...done\n" {
+		t.Errorf("Expected %q, got %q", "working...done\n", got)
+	}
+}
+
+func TestWorkerDoesNotBlock(t *testing.T) {
+	workDuration = 0
+	t.Cleanup(func() { workDuration = time.Second })
+
+	// With a buffered channel the worker can finish even if
+	// nobody is receiving yet.
+	done := make(chan bool, 1)
+	practicetest.Capture(t, func() { worker(done) })
+	if len(done) != 1 {
+		t.Errorf("Expected one value buffered on done, got %d", len(done))
+	}
+}
@@ -6,30 +6,31 @@
 
 package main
 
-import (
-	"fmt"
-	"time"
-)
+import "time"
+
+// workDuration is how long the worker pretends to work.
+// Tests shorten it.
+var workDuration = time.Second
 
 // This is the function we'll run in a goroutine. The
 // `done` channel will be used to notify another
 // goroutine that this function's work is done.
+func worker(done chan bool) {
+	// TODO: Print "working..." (without a newline), sleep for workDuration,
+	// then print "done"
+	// Hint: fmt.Print and fmt.Println
 
-// TODO: Define function worker(done chan bool) that prints "working..." and sleeps for 1 second, 
-// then prints "done" and sends true to the done channel.
-
+	// TODO: Send true to the done channel to notify that we're done
+}
 
 func main() {
 
 	// Start a worker goroutine, giving it the channel to
 	// notify on.
-
-	// TODO: Create done channel of bool with buffer size 1
-
-	// TODO: Call go worker(done)
+	done := make(chan bool, 1)
+	go worker(done)
 
 	// Block until we receive a notification from the
 	// worker on the channel.
-
-	// TODO: Receive from done channel
-}
\ No newline at end of file
+	<-done
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+func TestPingPong(t *testing.T) {
+	pings := make(chan string, 1)
+	pongs := make(chan string, 1)
+
+	practicetest.Wait(t, "ping and pong to return", func() {
+		ping(pings, "hello")
+		pong(pings, pongs)
+	})
+
+	if got := practicetest.Receive(t, pongs, "a message on pongs"); got != "hello" {
+		t.Errorf("Expected %q, got %q", "hello", got)
+	}
+}
+
+func TestPongUnbuffered(t *testing.T) {
+	// pong must hand over every message it receives, in
+	// order, even when neither side is buffered.
+	pings := make(chan string)
+	pongs := make(chan string)
+	msgs := []string{"one", "two", "three"}
+
+	go func() {
+		for _, msg := range msgs {
+			ping(pings, msg)
+		}
+	}()
+	go func() {
+		for range msgs {
+			pong(pings, pongs)
+		}
+	}()
+
+	for _, want := range msgs {
+		if got := practicetest.Receive(t, pongs, "a message on pongs"); got != want {
+			t.Errorf("Expected %q, got %q", want, got)
+		}
+	}
+}
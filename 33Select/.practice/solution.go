@@ -0,0 +1,46 @@
+// Go's _select_ lets you wait on multiple channel
+// operations. Combining goroutines and channels with
+// select is a powerful feature of Go.
+
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// receive waits on both channels and returns the first
+// message that arrives.
+func receive(c1, c2 <-chan string) string {
+	select {
+	case msg1 := <-c1:
+		return msg1
+	case msg2 := <-c2:
+		return msg2
+	}
+}
+
+func main() {
+
+	// For our example we'll select across two channels.
+	c1 := make(chan string)
+	c2 := make(chan string)
+
+	// Each channel will receive a value after some amount
+	// of time, to simulate e.g. blocking RPC operations
+	// executing in concurrent goroutines.
+	go func() {
+		time.Sleep(1 * time.Second)
+		c1 <- "one"
+	}()
+	go func() {
+		time.Sleep(2 * time.Second)
+		c2 <- "two"
+	}()
+
+	// We'll use `select` to await both of these values
+	// simultaneously, printing each one as it arrives.
+	for range 2 {
+		fmt.Println("received", receive(c1, c2))
+	}
+}
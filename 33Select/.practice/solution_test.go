@@ -0,0 +1,50 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestReceiveFromEitherChannel(t *testing.T) {
+	c1 := make(chan string, 1)
+	c2 := make(chan string, 1)
+
+	// Only the second channel is ready, so select must not
+	// wait for the first one.
+	c2 <- "two"
+	if got := receive(c1, c2); got != "two" {
+		t.Errorf("Expected %q, got %q", "two", got)
+	}
+
+	c1 <- "one"
+	if got := receive(c1, c2); got != "one" {
+		t.Errorf("Expected %q, got %q", "one", got)
+	}
+}
+
+func TestReceiveInArrivalOrder(t *testing.T) {
+	c1 := make(chan string)
+	c2 := make(chan string)
+	sent := make(chan struct{})
+
+	// The second send only starts once the first one has
+	// been received, so the arrival order is fixed.
+	go func() {
+		c2 <- "two"
+		<-sent
+		c1 <- "one"
+	}()
+
+	var got []string
+	for range 2 {
+		got = append(got, receive(c1, c2))
+		if len(got) == 1 {
+			close(sent)
+		}
+	}
+
+	want := []string{"two", "one"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
@@ -9,27 +9,34 @@ import (
 	"time"
 )
 
+// receive waits on both channels and returns the first
+// message that arrives.
+func receive(c1, c2 <-chan string) string {
+	// TODO: Use select to wait on c1 and c2 and return whichever message arrives first
+	return ""
+}
+
 func main() {
 
 	// For our example we'll select across two channels.
-
-	// TODO: Create c1 channel of strings
-
-	// TODO: Create c2 channel of strings
-
+	c1 := make(chan string)
+	c2 := make(chan string)
 
 	// Each channel will receive a value after some amount
 	// of time, to simulate e.g. blocking RPC operations
 	// executing in concurrent goroutines.
-
-	// TODO Creat a goroutine that sends "one" to c1 after 1 second
-
-	// TODO: Creat a goroutine that sends "two" to c2 after 2 seconds
-
+	go func() {
+		time.Sleep(1 * time.Second)
+		c1 <- "one"
+	}()
+	go func() {
+		time.Sleep(2 * time.Second)
+		c2 <- "two"
+	}()
 
 	// We'll use `select` to await both of these values
 	// simultaneously, printing each one as it arrives.
-
-	// TODO: Use for range 2 to receive from c1 and c2
-
-}
\ No newline at end of file
+	for range 2 {
+		fmt.Println("received", receive(c1, c2))
+	}
+}
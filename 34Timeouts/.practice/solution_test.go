@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAwaitResultReady(t *testing.T) {
+	c := make(chan string, 1)
+	c <- "result"
+
+	// The timeout is far away, so only the result can
+	// complete the select.
+	res, ok := awaitResult(c, time.Hour)
+	if !ok || res != "result" {
+		t.Errorf("Expected (%q, true), got (%q, %v)", "result", res, ok)
+	}
+}
+
+func TestAwaitResultTimeout(t *testing.T) {
+	// Nothing is ever sent, so only the timeout can
+	// complete the select.
+	c := make(chan string)
+
+	res, ok := awaitResult(c, 0)
+	if ok || res != "" {
+		t.Errorf("Expected timeout, got (%q, %v)", res, ok)
+	}
+}
+
+func TestAwaitResultLateSenderDoesNotLeak(t *testing.T) {
+	c := make(chan string, 1)
+	if _, ok := awaitResult(c, 0); ok {
+		t.Fatal("Expected timeout")
+	}
+
+	// Thanks to the buffer, a sender that shows up after the
+	// timeout still completes instead of blocking forever.
+	done := make(chan struct{})
+	go func() {
+		c <- "late"
+		close(done)
+	}()
+	<-done
+}
@@ -10,6 +10,14 @@ import (
 	"time"
 )
 
+// awaitResult returns the value received from c, or false
+// if nothing arrives within timeout.
+func awaitResult(c <-chan string, timeout time.Duration) (string, bool) {
+	// TODO: Use select to return the result from c with true,
+	// or "", false once <-time.After(timeout) fires
+	return "", false
+}
+
 func main() {
 
 	// For our example, suppose we're executing an external
@@ -18,11 +26,11 @@ func main() {
 	// send in the goroutine is nonblocking. This is a
 	// common pattern to prevent goroutine leaks in case the
 	// channel is never read.
-
-	// TODO: Create c1 channel of strings with buffer size 1
-
-	// TODO: Creat a goroutine that sends "result 1" to c1 after 2 seconds
-
+	c1 := make(chan string, 1)
+	go func() {
+		time.Sleep(2 * time.Second)
+		c1 <- "result 1"
+	}()
 
 	// Here's the `select` implementing a timeout.
 	// `res := <-c1` awaits the result and `<-time.After`
@@ -30,19 +38,22 @@ func main() {
 	// 1s. Since `select` proceeds with the first
 	// receive that's ready, we'll take the timeout case
 	// if the operation takes more than the allowed 1s.
-
-	// TODO: Use select to receive from c1 and print the result
-	// or print "timeout 1" if the operation takes more than 1 second
-
+	if res, ok := awaitResult(c1, 1*time.Second); ok {
+		fmt.Println(res)
+	} else {
+		fmt.Println("timeout 1")
+	}
 
 	// If we allow a longer timeout of 3s, then the receive
 	// from `c2` will succeed and we'll print the result.
-
-	// TODO: Create c2 channel of strings with buffer size 1
-
-	// TODO: Creat a goroutine that sends "result 2" to c2 after 2 seconds
-
-	// TODO: Use select to receive from c2 and print the result
-	// or print "timeout 2" if the operation takes more than 3 seconds
-
-}
\ No newline at end of file
+	c2 := make(chan string, 1)
+	go func() {
+		time.Sleep(2 * time.Second)
+		c2 <- "result 2"
+	}()
+	if res, ok := awaitResult(c2, 3*time.Second); ok {
+		fmt.Println(res)
+	} else {
+		fmt.Println("timeout 2")
+	}
+}
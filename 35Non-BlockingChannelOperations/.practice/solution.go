@@ -0,0 +1,69 @@
+// Basic sends and receives on channels are blocking.
+// However, we can use `select` with a `default` clause to
+// implement _non-blocking_ sends, receives, and even
+// non-blocking multi-way `select`s.
+
+package main
+
+import "fmt"
+
+// tryReceive receives from messages if a value is ready
+// and reports whether it got one.
+func tryReceive(messages <-chan string) (string, bool) {
+	select {
+	case msg := <-messages:
+		return msg, true
+	default:
+		return "", false
+	}
+}
+
+// trySend sends msg if messages can take it right away
+// and reports whether it did.
+func trySend(messages chan<- string, msg string) bool {
+	select {
+	case messages <- msg:
+		return true
+	default:
+		return false
+	}
+}
+
+func main() {
+	messages := make(chan string)
+	signals := make(chan bool)
+
+	// Here's a non-blocking receive. If a value is
+	// available on `messages` then `select` will take
+	// the `<-messages` `case` with that value. If not
+	// it will immediately take the `default` case.
+	if msg, ok := tryReceive(messages); ok {
+		fmt.Println("received message", msg)
+	} else {
+		fmt.Println("no message received")
+	}
+
+	// A non-blocking send works similarly. Here `msg`
+	// cannot be sent to the `messages` channel, because
+	// the channel has no buffer and there is no receiver.
+	// Therefore the `default` case is selected.
+	msg := "hi"
+	if trySend(messages, msg) {
+		fmt.Println("sent message", msg)
+	} else {
+		fmt.Println("no message sent")
+	}
+
+	// We can use multiple `case`s above the `default`
+	// clause to implement a multi-way non-blocking
+	// select. Here we attempt non-blocking receives
+	// on both `messages` and `signals`.
+	select {
+	case msg := <-messages:
+		fmt.Println("received message", msg)
+	case sig := <-signals:
+		fmt.Println("received signal", sig)
+	default:
+		fmt.Println("no activity")
+	}
+}
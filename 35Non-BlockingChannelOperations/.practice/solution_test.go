@@ -0,0 +1,33 @@
+package main
+
+import "testing"
+
+func TestTryReceive(t *testing.T) {
+	messages := make(chan string, 1)
+	if msg, ok := tryReceive(messages); ok {
+		t.Errorf("Expected no message from an empty channel, got %q", msg)
+	}
+
+	messages <- "hi"
+	if msg, ok := tryReceive(messages); !ok || msg != "hi" {
+		t.Errorf("Expected (%q, true), got (%q, %v)", "hi", msg, ok)
+	}
+}
+
+func TestTrySend(t *testing.T) {
+	// No buffer and no receiver: the send must not block.
+	if trySend(make(chan string), "hi") {
+		t.Error("Expected send on an unbuffered channel without receiver to fail")
+	}
+
+	messages := make(chan string, 1)
+	if !trySend(messages, "hi") {
+		t.Fatal("Expected send into an empty buffer to succeed")
+	}
+	if trySend(messages, "again") {
+		t.Error("Expected send into a full buffer to fail")
+	}
+	if got := <-messages; got != "hi" {
+		t.Errorf("Expected %q, got %q", "hi", got)
+	}
+}
@@ -7,35 +7,55 @@ package main
 
 import "fmt"
 
-func main() {
-	
-	// TODO: Create messages channel of strings
+// tryReceive receives from messages if a value is ready
+// and reports whether it got one.
+func tryReceive(messages <-chan string) (string, bool) {
+	// TODO: Use select with a default case to receive from messages without blocking
+	return "", false
+}
+
+// trySend sends msg if messages can take it right away
+// and reports whether it did.
+func trySend(messages chan<- string, msg string) bool {
+	// TODO: Use select with a default case to send msg on messages without blocking
+	return false
+}
 
-	// TODO: Create signals channel of bools
+func main() {
+	messages := make(chan string)
+	signals := make(chan bool)
 
 	// Here's a non-blocking receive. If a value is
 	// available on `messages` then `select` will take
 	// the `<-messages` `case` with that value. If not
 	// it will immediately take the `default` case.
-
-	// TODO: Use select to receive from messages and print the result
-	// or print "no message received" if the operation takes more than 1 second
-	
+	if msg, ok := tryReceive(messages); ok {
+		fmt.Println("received message", msg)
+	} else {
+		fmt.Println("no message received")
+	}
 
 	// A non-blocking send works similarly. Here `msg`
 	// cannot be sent to the `messages` channel, because
 	// the channel has no buffer and there is no receiver.
 	// Therefore the `default` case is selected.
-
-	// TODO: Use select to send to messages and print the result
-	// or print "no message sent" as default
+	msg := "hi"
+	if trySend(messages, msg) {
+		fmt.Println("sent message", msg)
+	} else {
+		fmt.Println("no message sent")
+	}
 
 	// We can use multiple `case`s above the `default`
 	// clause to implement a multi-way non-blocking
 	// select. Here we attempt non-blocking receives
 	// on both `messages` and `signals`.
-
-	// TODO: Use select to receive from messages and signals and print the result
-	// or print "no activity" as default
-
-}
\ No newline at end of file
+	select {
+	case msg := <-messages:
+		fmt.Println("received message", msg)
+	case sig := <-signals:
+		fmt.Println("received signal", sig)
+	default:
+		fmt.Println("no activity")
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+func TestWorkerDrainsClosedChannel(t *testing.T) {
+	jobs := make(chan int, 3)
+	done := make(chan bool)
+	for j := 1; j <= 3; j++ {
+		jobs <- j
+	}
+	// Closing before the worker starts must not lose the
+	// buffered jobs.
+	close(jobs)
+
+	got := practicetest.Capture(t, func() {
+		go worker(jobs, done)
+		practicetest.Receive(t, done, "the worker to send on done")
+	})
+
+	want := "received job 1\nreceived job 2\nreceived job 3\nreceived all jobs\n"
+	if got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestWorkerWaitsForClose(t *testing.T) {
+	jobs := make(chan int)
+	done := make(chan bool)
+
+	practicetest.Capture(t, func() {
+		go worker(jobs, done)
+		// Unbuffered sends only complete once the worker
+		// has received them, so done cannot be ready yet.
+		practicetest.Wait(t, "the worker to receive two jobs", func() {
+			jobs <- 1
+			jobs <- 2
+		})
+		select {
+		case <-done:
+			t.Error("Expected worker to keep running until jobs is closed")
+		default:
+		}
+		close(jobs)
+		practicetest.Receive(t, done, "the worker to send on done")
+	})
+}
@@ -0,0 +1,34 @@
+// In a [previous](range-over-built-in-types) example we saw how `for` and
+// `range` provide iteration over basic data structures.
+// We can also use this syntax to iterate over
+// values received from a channel.
+
+package main
+
+import "fmt"
+
+// This `range` iterates over each element as it's
+// received from `queue`. The iteration terminates once
+// the channel is `close`d and drained.
+func drain(queue <-chan string) []string {
+	var elems []string
+	for elem := range queue {
+		elems = append(elems, elem)
+	}
+	return elems
+}
+
+func main() {
+
+	// We'll iterate over 2 values in the `queue` channel.
+	queue := make(chan string, 2)
+	queue <- "one"
+	queue <- "two"
+	close(queue)
+
+	// Because we `close`d the channel above, the
+	// iteration terminates after receiving the 2 elements.
+	for _, elem := range drain(queue) {
+		fmt.Println(elem)
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDrainBuffered(t *testing.T) {
+	queue := make(chan string, 2)
+	queue <- "one"
+	queue <- "two"
+	close(queue)
+
+	want := []string{"one", "two"}
+	if got := drain(queue); !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestDrainStopsAtClose(t *testing.T) {
+	queue := make(chan string)
+	want := []string{"a", "b", "c"}
+
+	go func() {
+		for _, s := range want {
+			queue <- s
+		}
+		close(queue)
+	}()
+
+	if got := drain(queue); !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestDrainClosedEmpty(t *testing.T) {
+	queue := make(chan string)
+	close(queue)
+
+	if got := drain(queue); len(got) != 0 {
+		t.Errorf("Expected no elements, got %v", got)
+	}
+}
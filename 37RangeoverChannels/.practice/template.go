@@ -7,22 +7,25 @@ package main
 
 import "fmt"
 
+// This `range` iterates over each element as it's
+// received from `queue`. The iteration terminates once
+// the channel is `close`d and drained.
+func drain(queue <-chan string) []string {
+	// TODO: Use range over queue to collect every element until the channel is closed
+	return nil
+}
+
 func main() {
 
 	// We'll iterate over 2 values in the `queue` channel.
-
-	// TODO: Create queue channel of strings with buffer size 2
-
-	// TODO: Send "one" and "two" to queue channel
-
-	// TODO: Close the queue channel
-
-
-	// This `range` iterates over each element as it's
-	// received from `queue`. Because we `close`d the
-	// channel above, the iteration terminates after
-	// receiving the 2 elements.
-
-	// TODO: Use range to iterate over queue and print each element
-
-}
\ No newline at end of file
+	queue := make(chan string, 2)
+	queue <- "one"
+	queue <- "two"
+	close(queue)
+
+	// Because we `close`d the channel above, the
+	// iteration terminates after receiving the 2 elements.
+	for _, elem := range drain(queue) {
+		fmt.Println(elem)
+	}
+}
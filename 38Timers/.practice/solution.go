@@ -0,0 +1,53 @@
+// We often want to execute Go code at some point in the
+// future, or repeatedly at some interval. Go's built-in
+// _timer_ and _ticker_ features make both of these tasks
+// easy. We'll look first at timers and then
+// at [tickers](tickers).
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit"
+)
+
+// clock works like the time package: clock.NewTimer is
+// time.NewTimer and clock.Sleep is time.Sleep. `practice
+// check` replaces it with a simulated clock, so the
+// program runs the same way every time.
+var clock = practicekit.ClockFromEnv()
+
+// The `<-timer.C` blocks on the timer's channel `C`
+// until it sends a value indicating that the timer
+// fired.
+func waitFor(timer *practicekit.Timer, name string) {
+	<-timer.C
+	fmt.Println(name, "fired")
+}
+
+func main() {
+
+	// Timers represent a single event in the future. You
+	// tell the timer how long you want to wait, and it
+	// provides a channel that will be notified at that
+	// time. This timer will wait 2 seconds.
+	timer1 := clock.NewTimer(2 * time.Second)
+	waitFor(timer1, "Timer 1")
+
+	// If you just wanted to wait, you could have used
+	// `time.Sleep`. One reason a timer may be useful is
+	// that you can cancel the timer before it fires.
+	// Here's an example of that.
+	timer2 := clock.NewTimer(time.Second)
+	go waitFor(timer2, "Timer 2")
+	stop2 := timer2.Stop()
+	if stop2 {
+		fmt.Println("Timer 2 stopped")
+	}
+
+	// Give the `timer2` enough time to fire, if it ever
+	// was going to, to show it is in fact stopped.
+	clock.Sleep(2 * time.Second)
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit"
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+func TestWaitForFired(t *testing.T) {
+	got := practicetest.Capture(t, func() {
+		waitFor(practicekit.System().NewTimer(0), "Timer 1")
+	})
+
+	if got != "Timer 1 fired\n" {
+		t.Errorf("Expected %q, got %q", "Timer 1 fired\n", got)
+	}
+}
+
+func TestStoppedTimerNeverFires(t *testing.T) {
+	timer := time.NewTimer(time.Hour)
+	if !timer.Stop() {
+		t.Fatal("Expected Stop to report that it stopped a pending timer")
+	}
+	if timer.Stop() {
+		t.Error("Expected a second Stop to report the timer was already stopped")
+	}
+
+	// Since Go 1.23 a stopped timer's channel never
+	// delivers a value, so this check needs no waiting.
+	select {
+	case <-timer.C:
+		t.Error("Expected no value from a stopped timer")
+	default:
+	}
+}
+
+func TestWaitForSimulatedTimer(t *testing.T) {
+	practicetest.Simulate(t, &clock, 5)
+	start := clock.Now()
+
+	// waitFor returns once the timer fires, which moves the
+	// simulated clock exactly to the timer's expiry.
+	got := practicetest.Capture(t, func() { waitFor(clock.NewTimer(2*time.Second), "Timer 2") })
+	if got != "Timer 2 fired\n" {
+		t.Errorf("Expected %q, got %q", "Timer 2 fired\n", got)
+	}
+	if elapsed := clock.Since(start); elapsed != 2*time.Second {
+		t.Errorf("Expected the clock to be 2s later, got %v", elapsed)
+	}
+}
+
+func TestMainOutput(t *testing.T) {
+	practicetest.Simulate(t, &clock, 5)
+
+	got := practicetest.Capture(t, main)
+	want := "Timer 1 fired\nTimer 2 stopped\n"
+	if got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
@@ -9,37 +9,44 @@ package main
 import (
 	"fmt"
 	"time"
+
+	"github.com/orsenthil/practicego/practicekit"
 )
 
+// clock works like the time package: clock.NewTimer is
+// time.NewTimer and clock.Sleep is time.Sleep. `practice
+// check` replaces it with a simulated clock, so the
+// program runs the same way every time.
+var clock = practicekit.ClockFromEnv()
+
+// The `<-timer.C` blocks on the timer's channel `C`
+// until it sends a value indicating that the timer
+// fired.
+func waitFor(timer *practicekit.Timer, name string) {
+	// TODO: Block on <-timer.C, then print name followed by "fired"
+}
+
 func main() {
 
 	// Timers represent a single event in the future. You
 	// tell the timer how long you want to wait, and it
 	// provides a channel that will be notified at that
 	// time. This timer will wait 2 seconds.
-
-	// TODO: Create timer1 with 2 seconds
-
-	// The `<-timer1.C` blocks on the timer's channel `C`
-	// until it sends a value indicating that the timer
-	// fired.
-
-	// TODO: Receive from timer1.C and print the result
+	timer1 := clock.NewTimer(2 * time.Second)
+	waitFor(timer1, "Timer 1")
 
 	// If you just wanted to wait, you could have used
 	// `time.Sleep`. One reason a timer may be useful is
 	// that you can cancel the timer before it fires.
 	// Here's an example of that.
-
-	// TODO: Create timer2 with 1 second using NewTimer
-
-	// TODO: Creat a goroutine that receives from timer2.C and prints the result
-
-	// TODO: Create stop2 with timer2.Stop()
-
-	// TODO: If stop2 is true, print "Timer 2 stopped"
-
-	// TODO: Give the timer2 enough time to fire, if it ever
+	timer2 := clock.NewTimer(time.Second)
+	go waitFor(timer2, "Timer 2")
+	stop2 := timer2.Stop()
+	if stop2 {
+		fmt.Println("Timer 2 stopped")
+	}
+
+	// Give the `timer2` enough time to fire, if it ever
 	// was going to, to show it is in fact stopped.
-
-}
\ No newline at end of file
+	clock.Sleep(2 * time.Second)
+}
@@ -0,0 +1,51 @@
+// [Timers](timers) are for when you want to do
+// something once in the future - _tickers_ are for when
+// you want to do something repeatedly at regular
+// intervals. Here's an example of a ticker that ticks
+// periodically until we stop it.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit"
+)
+
+// clock works like the time package: clock.NewTicker is
+// time.NewTicker and clock.Sleep is time.Sleep. `practice
+// check` replaces it with a simulated clock, so the ticks
+// are printed with the same times on every run.
+var clock = practicekit.ClockFromEnv()
+
+// printTicks uses the `select` builtin to print every
+// tick as it arrives, until it is told to stop on done.
+func printTicks(ticks <-chan time.Time, done <-chan bool) {
+	for {
+		select {
+		case <-done:
+			return
+		case t := <-ticks:
+			fmt.Println("Tick at", t)
+		}
+	}
+}
+
+func main() {
+
+	// Tickers use a similar mechanism to timers: a
+	// channel that is sent values. Here we'll await the
+	// values as they arrive every 500ms.
+	ticker := clock.NewTicker(500 * time.Millisecond)
+	done := make(chan bool)
+	go printTicks(ticker.C, done)
+
+	// Tickers can be stopped like timers. Once a ticker
+	// is stopped it won't receive any more values on its
+	// channel. We'll stop ours after 1600ms.
+	clock.Sleep(1600 * time.Millisecond)
+	ticker.Stop()
+	done <- true
+	fmt.Println("Ticker stopped")
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+func TestPrintTicks(t *testing.T) {
+	// A hand driven channel stands in for ticker.C, so the
+	// test decides exactly when and how often it ticks.
+	ticks := make(chan time.Time)
+	done := make(chan bool)
+	base := time.Date(2012, 9, 23, 11, 29, 56, 0, time.UTC)
+
+	got := practicetest.Capture(t, func() {
+		finished := make(chan struct{})
+		go func() {
+			printTicks(ticks, done)
+			close(finished)
+		}()
+		practicetest.Wait(t, "printTicks to receive three ticks and done", func() {
+			for i := range 3 {
+				ticks <- base.Add(time.Duration(i) * 500 * time.Millisecond)
+			}
+			done <- true
+		})
+		practicetest.Receive(t, finished, "printTicks to return")
+	})
+
+	want := "Tick at 2012-09-23 11:29:56 +0000 UTC\n" +
+		"Tick at 2012-09-23 11:29:56.5 +0000 UTC\n" +
+		"Tick at 2012-09-23 11:29:57 +0000 UTC\n"
+	if got != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestPrintTicksStopsWithoutTicks(t *testing.T) {
+	done := make(chan bool)
+	finished := make(chan struct{})
+
+	got := practicetest.Capture(t, func() {
+		go func() {
+			printTicks(make(chan time.Time), done)
+			close(finished)
+		}()
+		practicetest.Wait(t, "printTicks to receive done", func() { done <- true })
+		practicetest.Receive(t, finished, "printTicks to return")
+	})
+
+	if got != "" {
+		t.Errorf("Expected no output, got %q", got)
+	}
+}
+
+func TestMainOutput(t *testing.T) {
+	// On a simulated clock the ticks carry their exact due
+	// times, so the output is the same on every run.
+	practicetest.Simulate(t, &clock, 5)
+
+	got := practicetest.Capture(t, func() {
+		practicetest.Wait(t, "main to return", main)
+	})
+	want := "Tick at 2026-01-02 15:04:05.5 +0000 UTC\n" +
+		"Tick at 2026-01-02 15:04:06 +0000 UTC\n" +
+		"Tick at 2026-01-02 15:04:06.5 +0000 UTC\n" +
+		"Ticker stopped\n"
+	if got != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+}
@@ -9,28 +9,37 @@ package main
 import (
 	"fmt"
 	"time"
+
+	"github.com/orsenthil/practicego/practicekit"
 )
 
+// clock works like the time package: clock.NewTicker is
+// time.NewTicker and clock.Sleep is time.Sleep. `practice
+// check` replaces it with a simulated clock, so the ticks
+// are printed with the same times on every run.
+var clock = practicekit.ClockFromEnv()
+
+// printTicks uses the `select` builtin to print every
+// tick as it arrives, until it is told to stop on done.
+func printTicks(ticks <-chan time.Time, done <-chan bool) {
+	// TODO: In a for loop, use select to return when done receives a value,
+	// and print "Tick at" and t for every t received from ticks
+}
+
 func main() {
 
 	// Tickers use a similar mechanism to timers: a
-	// channel that is sent values. Here we'll use the
-	// `select` builtin on the channel to await the
+	// channel that is sent values. Here we'll await the
 	// values as they arrive every 500ms.
-
-	// TODO: Create ticker with 500 milliseconds using NewTicker
-
-	// TODO: Create done channel of bool
-
-	// TODO: Creat a goroutine that receives from ticker.C and prints the result
+	ticker := clock.NewTicker(500 * time.Millisecond)
+	done := make(chan bool)
+	go printTicks(ticker.C, done)
 
 	// Tickers can be stopped like timers. Once a ticker
 	// is stopped it won't receive any more values on its
 	// channel. We'll stop ours after 1600ms.
-
-	// TODO: Give the ticker enough time to fire, if it ever
-	// was going to, to show it is in fact stopped.
-
-	// TODO: If done is true, print "Ticker stopped"
-
-}
\ No newline at end of file
+	clock.Sleep(1600 * time.Millisecond)
+	ticker.Stop()
+	done <- true
+	fmt.Println("Ticker stopped")
+}
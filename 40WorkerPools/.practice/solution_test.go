@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+// runPool feeds numJobs jobs to numWorkers workers and
+// returns the collected results.
+func runPool(t *testing.T, numWorkers, numJobs int) []int {
+	jobs := make(chan int, numJobs)
+	results := make(chan int, numJobs)
+	for w := 1; w <= numWorkers; w++ {
+		go worker(w, jobs, results)
+	}
+	for j := 1; j <= numJobs; j++ {
+		jobs <- j
+	}
+	close(jobs)
+
+	var got []int
+	for range numJobs {
+		got = append(got, practicetest.Receive(t, results, "a result"))
+	}
+	return got
+}
+
+func TestWorkerPoolProcessesAllJobs(t *testing.T) {
+	workDuration = 0
+	t.Cleanup(func() { workDuration = time.Second })
+
+	const numJobs = 5
+	var results []int
+	out := practicetest.Capture(t, func() { results = runPool(t, 3, numJobs) })
+
+	slices.Sort(results)
+	want := []int{2, 4, 6, 8, 10}
+	if !slices.Equal(results, want) {
+		t.Errorf("Expected results %v, got %v", want, results)
+	}
+
+	// Every job is started and finished exactly once,
+	// by one of the three workers.
+	for j := 1; j <= numJobs; j++ {
+		for _, verb := range []string{"started  job", "finished job"} {
+			suffix := fmt.Sprintf(" %s %d", verb, j)
+			n := 0
+			for _, line := range strings.Split(out, "\n") {
+				if strings.HasSuffix(line, suffix) {
+					n++
+					if !strings.HasPrefix(line, "worker 1 ") &&
+						!strings.HasPrefix(line, "worker 2 ") &&
+						!strings.HasPrefix(line, "worker 3 ") {
+						t.Errorf("Unexpected worker in %q", line)
+					}
+				}
+			}
+			if n != 1 {
+				t.Errorf("Expected %q once, got %d times", suffix, n)
+			}
+		}
+	}
+}
+
+func TestSingleWorkerKeepsOrder(t *testing.T) {
+	workDuration = 0
+	t.Cleanup(func() { workDuration = time.Second })
+
+	var results []int
+	practicetest.Capture(t, func() { results = runPool(t, 1, 4) })
+
+	want := []int{2, 4, 6, 8}
+	if !slices.Equal(results, want) {
+		t.Errorf("Expected results %v, got %v", want, results)
+	}
+}
+
+func TestWorkerExitsWhenJobsClosed(t *testing.T) {
+	jobs := make(chan int)
+	close(jobs)
+
+	// With no jobs the worker must return instead of
+	// blocking forever.
+	done := make(chan struct{})
+	go func() {
+		worker(1, jobs, make(chan int))
+		close(done)
+	}()
+	practicetest.Receive(t, done, "the worker to return")
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+func TestWorker(t *testing.T) {
+	workDuration = 0
+	t.Cleanup(func() { workDuration = time.Second })
+
+	got := practicetest.Capture(t, func() { worker(7) })
+
+	want := "Worker 7 starting\nWorker 7 done\n"
+	if got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestMainWaitsForAllWorkers(t *testing.T) {
+	workDuration = 0
+	t.Cleanup(func() { workDuration = time.Second })
+
+	// main only returns after wg.Wait, so by then every
+	// worker must have printed both of its lines.
+	out := practicetest.Capture(t, main)
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 10 {
+		t.Fatalf("Expected 10 lines, got %d:\n%s", len(lines), out)
+	}
+	for id := 1; id <= 5; id++ {
+		start := slices.Index(lines, fmt.Sprintf("Worker %d starting", id))
+		done := slices.Index(lines, fmt.Sprintf("Worker %d done", id))
+		if start < 0 || done < 0 {
+			t.Errorf("Expected worker %d to start and finish:\n%s", id, out)
+		} else if start > done {
+			t.Errorf("Expected worker %d to start before it finishes:\n%s", id, out)
+		}
+	}
+}
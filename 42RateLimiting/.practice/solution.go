@@ -0,0 +1,93 @@
+// [_Rate limiting_](https://en.wikipedia.org/wiki/Rate_limiting)
+// is an important mechanism for controlling resource
+// utilization and maintaining quality of service. Go
+// elegantly supports rate limiting with goroutines,
+// channels, and [tickers](tickers).
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit"
+)
+
+// clock works like the time package: clock.Now is
+// time.Now and clock.Tick is time.Tick. `practice check`
+// replaces it with a simulated clock, so the requests are
+// printed with the same times on every run.
+var clock = practicekit.ClockFromEnv()
+
+// serve handles every request, blocking on a receive
+// from `limiter` before each one.
+func serve(requests <-chan int, limiter <-chan time.Time) {
+	for req := range requests {
+		<-limiter
+		fmt.Println("request", req, clock.Now())
+	}
+}
+
+// newBurstyLimiter returns a limiter channel that allows
+// bursts of up to `burst` events. It starts full and is
+// refilled with one event every `every`.
+func newBurstyLimiter(burst int, every time.Duration) <-chan time.Time {
+	limiter := make(chan time.Time, burst)
+
+	// Fill up the channel to represent allowed bursting.
+	for range burst {
+		limiter <- clock.Now()
+	}
+
+	// Every `every` we'll try to add a new value to
+	// `limiter`, up to its limit of `burst`.
+	ticks := clock.Tick(every)
+	go func() {
+		for t := range ticks {
+			limiter <- t
+		}
+	}()
+	return limiter
+}
+
+// newRequests returns a closed channel holding n
+// requests numbered from 1.
+func newRequests(n int) <-chan int {
+	requests := make(chan int, n)
+	for i := 1; i <= n; i++ {
+		requests <- i
+	}
+	close(requests)
+	return requests
+}
+
+func main() {
+
+	// First we'll look at basic rate limiting. Suppose
+	// we want to limit our handling of incoming requests.
+	// We'll serve these requests off a channel of the
+	// same name.
+	requests := newRequests(5)
+
+	// This `limiter` channel will receive a value
+	// every 200 milliseconds. This is the regulator in
+	// our rate limiting scheme.
+	limiter := clock.Tick(200 * time.Millisecond)
+
+	// By blocking on a receive from the `limiter` channel
+	// before serving each request, we limit ourselves to
+	// 1 request every 200 milliseconds.
+	serve(requests, limiter)
+
+	// We may want to allow short bursts of requests in
+	// our rate limiting scheme while preserving the
+	// overall rate limit. We can accomplish this by
+	// buffering our limiter channel. This `burstyLimiter`
+	// channel will allow bursts of up to 3 events.
+	burstyLimiter := newBurstyLimiter(3, 200*time.Millisecond)
+
+	// Now simulate 5 more incoming requests. The first
+	// 3 of these will benefit from the burst capability
+	// of `burstyLimiter`.
+	serve(newRequests(5), burstyLimiter)
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+func TestBurstyLimiterAllowsExactlyBurst(t *testing.T) {
+	// With an hour between refills only the initial burst
+	// is available during the test.
+	limiter := newBurstyLimiter(3, time.Hour)
+
+	allowed := 0
+	for range 5 {
+		select {
+		case <-limiter:
+			allowed++
+		default:
+		}
+	}
+	if allowed != 3 {
+		t.Errorf("Expected exactly 3 immediate requests, got %d", allowed)
+	}
+}
+
+func TestServeWaitsForLimiter(t *testing.T) {
+	limiter := make(chan time.Time)
+	finished := make(chan struct{})
+
+	out := practicetest.Capture(t, func() {
+		go func() {
+			serve(newRequests(3), limiter)
+			close(finished)
+		}()
+
+		// Each unbuffered send is one permit; serve can't
+		// handle a request without one.
+		practicetest.Wait(t, "serve to take two permits", func() {
+			limiter <- time.Now()
+			limiter <- time.Now()
+		})
+		select {
+		case <-finished:
+			t.Error("Expected serve to wait for a third permit")
+		default:
+		}
+		practicetest.Wait(t, "serve to take a third permit", func() {
+			limiter <- time.Now()
+		})
+		practicetest.Receive(t, finished, "serve to return")
+	})
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 requests served, got %d:\n%s", len(lines), out)
+	}
+	for i, prefix := range []string{"request 1 ", "request 2 ", "request 3 "} {
+		if !strings.HasPrefix(lines[i], prefix) {
+			t.Errorf("Expected line %d to start with %q, got %q", i+1, prefix, lines[i])
+		}
+	}
+}
+
+func TestNewRequests(t *testing.T) {
+	var got []int
+	practicetest.Wait(t, "the requests channel to be closed", func() {
+		for req := range newRequests(5) {
+			got = append(got, req)
+		}
+	})
+	if len(got) != 5 || got[0] != 1 || got[4] != 5 {
+		t.Errorf("Expected requests 1 to 5, got %v", got)
+	}
+}
+
+func TestMainOutput(t *testing.T) {
+	// On a simulated clock, clock.Now returns the time of the
+	// tick that let the request through, so every run prints
+	// the same times.
+	practicetest.Simulate(t, &clock, 5)
+
+	got := practicetest.Capture(t, func() {
+		practicetest.Wait(t, "main to return", main)
+	})
+	want := "request 1 2026-01-02 15:04:05.2 +0000 UTC\n" +
+		"request 2 2026-01-02 15:04:05.4 +0000 UTC\n" +
+		"request 3 2026-01-02 15:04:05.6 +0000 UTC\n" +
+		"request 4 2026-01-02 15:04:05.8 +0000 UTC\n" +
+		"request 5 2026-01-02 15:04:06 +0000 UTC\n" +
+		// The burst of 3 is served at once, then the
+		// limiter is back to one request every 200ms.
+		"request 1 2026-01-02 15:04:06 +0000 UTC\n" +
+		"request 2 2026-01-02 15:04:06 +0000 UTC\n" +
+		"request 3 2026-01-02 15:04:06 +0000 UTC\n" +
+		"request 4 2026-01-02 15:04:06.2 +0000 UTC\n" +
+		"request 5 2026-01-02 15:04:06.4 +0000 UTC\n"
+	if got != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+}
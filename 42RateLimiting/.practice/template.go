@@ -7,65 +7,78 @@
 package main
 
 import (
-	"fmt"
 	"time"
+
+	"github.com/orsenthil/practicego/practicekit"
 )
 
+// clock works like the time package: clock.Now is
+// time.Now and clock.Tick is time.Tick. `practice check`
+// replaces it with a simulated clock, so the requests are
+// printed with the same times on every run.
+var clock = practicekit.ClockFromEnv()
+
+// serve handles every request, blocking on a receive
+// from `limiter` before each one.
+func serve(requests <-chan int, limiter <-chan time.Time) {
+	// TODO: Use range over requests; for each req receive from limiter,
+	// then print "request", req and clock.Now()
+	// Hint: fmt.Println
+}
+
+// newBurstyLimiter returns a limiter channel that allows
+// bursts of up to `burst` events. It starts full and is
+// refilled with one event every `every`.
+func newBurstyLimiter(burst int, every time.Duration) <-chan time.Time {
+	// TODO: Create limiter channel of time.Time buffering up to burst values
+
+	// TODO: Fill up the channel with clock.Now() to represent allowed bursting
+
+	// Every `every` we'll try to add a new value to
+	// `limiter`, up to its limit of `burst`.
+
+	// TODO: Call clock.Tick(every), then start a goroutine that sends
+	// every t it delivers to limiter
+
+	// TODO: Return limiter
+	return nil
+}
+
+// newRequests returns a closed channel holding n
+// requests numbered from 1.
+func newRequests(n int) <-chan int {
+	// TODO: Create requests channel of int buffering up to n values,
+	// send 1 to n to it, close it and return it
+	return nil
+}
+
 func main() {
 
 	// First we'll look at basic rate limiting. Suppose
 	// we want to limit our handling of incoming requests.
 	// We'll serve these requests off a channel of the
 	// same name.
-
-	// TODO: Create requests channel of int with buffer size 5
-
-	// TODO: Send 5 requests to the requests channel
-
-	// TODO: Close the requests channel
+	requests := newRequests(5)
 
 	// This `limiter` channel will receive a value
 	// every 200 milliseconds. This is the regulator in
 	// our rate limiting scheme.
-
-	// TODO: Create limiter channel of time.Tick 200 milliseconds
+	limiter := clock.Tick(200 * time.Millisecond)
 
 	// By blocking on a receive from the `limiter` channel
 	// before serving each request, we limit ourselves to
 	// 1 request every 200 milliseconds.
-
-	// TODO: Iterate over requests channel and retrieve the limiter channel and print the request and time
-
-	
+	serve(requests, limiter)
 
 	// We may want to allow short bursts of requests in
 	// our rate limiting scheme while preserving the
 	// overall rate limit. We can accomplish this by
 	// buffering our limiter channel. This `burstyLimiter`
 	// channel will allow bursts of up to 3 events.
-
-	// TODO: Create burstyLimiter channel of time.Time with buffer size 3
-
-	// Fill up the channel to represent allowed bursting.
-
-	// TODO: Iterate over 3 and send the time to the burstyLimiter channel
-
-	// Every 200 milliseconds we'll try to add a new
-	// value to `burstyLimiter`, up to its limit of 3.
-
-	// TODO: Creat a goroutine that sends the time to the burstyLimiter channel every 200 milliseconds
-	
+	burstyLimiter := newBurstyLimiter(3, 200*time.Millisecond)
 
 	// Now simulate 5 more incoming requests. The first
 	// 3 of these will benefit from the burst capability
 	// of `burstyLimiter`.
-
-	// TODO: Create burstyRequests channel of int with buffer size 5
-
-	// TODO: Send 5 requests to the burstyRequests channel
-
-	// TODO: Close the burstyRequests channel
-
-	// TODO: Iterate over burstyRequests channel and retrieve the burstyLimiter channel and print the request and time
-
-}
\ No newline at end of file
+	serve(newRequests(5), burstyLimiter)
+}
@@ -0,0 +1,23 @@
+package main
+
+import "testing"
+
+func TestCountOps(t *testing.T) {
+	tests := []struct {
+		goroutines, increments int
+		want                   uint64
+	}{
+		{50, 1000, 50000},
+		{1, 10, 10},
+		{100, 1, 100},
+		{0, 1000, 0},
+	}
+
+	for _, tt := range tests {
+		// Running under -race also proves that no increment
+		// bypasses the atomic counter.
+		if got := countOps(tt.goroutines, tt.increments); got != tt.want {
+			t.Errorf("countOps(%d, %d) = %d, want %d", tt.goroutines, tt.increments, got, tt.want)
+		}
+	}
+}
@@ -7,36 +7,39 @@
 
 package main
 
-import (
-	"fmt"
-	"sync"
-	"sync/atomic"
-)
+import "fmt"
 
-func main() {
+// countOps starts `goroutines` goroutines that each
+// increment a shared counter `increments` times and
+// returns the final count.
+func countOps(goroutines, increments int) uint64 {
 
 	// We'll use an atomic integer type to represent our
 	// (always-positive) counter.
 
-	// TODO: Create ops atomic.Uint64
+	// TODO: Create var ops atomic.Uint64
 
 	// A WaitGroup will help us wait for all goroutines
 	// to finish their work.
 
-	// TODO: Create wg sync.WaitGroup
-
-	// We'll start 50 goroutines that each increment the
-	// counter exactly 1000 times.
-
-	// TODO: Iterate over 50 and create a goroutine that increments the counter exactly 1000 times
+	// TODO: Create var wg sync.WaitGroup
 
-	// Wait until all the goroutines are done.
+	// TODO: Use wg.Go to start goroutines goroutines that each
+	// call ops.Add(1) increments times
 
-	// TODO: Wait for all the goroutines to finish
+	// TODO: Wait until all the goroutines are done
 
 	// Here no goroutines are writing to 'ops', but using
 	// `Load` it's safe to atomically read a value even while
 	// other goroutines are (atomically) updating it.
 
-	// TODO: Print the result of the counter with ops.Load()
-}
\ No newline at end of file
+	// TODO: Return ops.Load()
+	return 0
+}
+
+func main() {
+
+	// We'll start 50 goroutines that each increment the
+	// counter exactly 1000 times.
+	fmt.Println("ops:", countOps(50, 1000))
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"maps"
+	"sync"
+	"testing"
+)
+
+func TestContainerIncConcurrent(t *testing.T) {
+	c := Container{counters: map[string]int{"a": 0, "b": 0}}
+
+	// Same workload as main: two goroutines share "a".
+	var wg sync.WaitGroup
+	for _, name := range []string{"a", "a", "b"} {
+		wg.Go(func() {
+			for range 10000 {
+				c.inc(name)
+			}
+		})
+	}
+	wg.Wait()
+
+	want := map[string]int{"a": 20000, "b": 10000}
+	if !maps.Equal(c.counters, want) {
+		t.Errorf("Expected %v, got %v", want, c.counters)
+	}
+}
+
+func TestContainerIncNewKey(t *testing.T) {
+	c := Container{counters: map[string]int{}}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() { c.inc("new") })
+	}
+	wg.Wait()
+
+	if got := c.counters["new"]; got != 8 {
+		t.Errorf("Expected 8, got %d", got)
+	}
+}
@@ -16,38 +16,50 @@ import (
 // Note that mutexes must not be copied, so if this
 // `struct` is passed around, it should be done by
 // pointer.
+type Container struct {
+	mu       sync.Mutex
+	counters map[string]int
+}
 
-// TODO: Define struct Container with mu (sync.Mutex) and counters (map[string]int) fields
-
-// TODO: Create method inc(name string) on Container that locks the mutex and increments the counter for the given name
 // Lock the mutex before accessing `counters`; unlock
 // it at the end of the function using a [defer](defer)
 // statement.
-
+func (c *Container) inc(name string) {
+	// TODO: Lock c.mu, defer c.mu.Unlock() and increment c.counters[name]
+}
 
 func main() {
-	
+
 	// Note that the zero value of a mutex is usable as-is, so no
 	// initialization is required here.
+	c := Container{
+		counters: map[string]int{"a": 0, "b": 0},
+	}
 
-	// TODO: Create c Container with counters map[string]int{"a": 0, "b": 0}
-
-	// TODO: Create wg sync.WaitGroup
+	var wg sync.WaitGroup
 
 	// This function increments a named counter
 	// in a loop.
-
-	// TODO: Define function doIncrement(name string, n int) that increments the counter for the given name in a loop
+	doIncrement := func(name string, n int) {
+		for range n {
+			c.inc(name)
+		}
+	}
 
 	// Run several goroutines concurrently; note
 	// that they all access the same `Container`,
 	// and two of them access the same counter.
-
-	// TODO: Launch 3 goroutines using wg.Go that call doIncrement with "a" and 10000, "a" and 10000, and "b" and 10000
+	wg.Go(func() {
+		doIncrement("a", 10000)
+	})
+	wg.Go(func() {
+		doIncrement("a", 10000)
+	})
+	wg.Go(func() {
+		doIncrement("b", 10000)
+	})
 
 	// Wait for the goroutines to finish
-
-	// TODO: Wait for all the goroutines to finish.
-
-	// TODO: Print the result of the counters with c.counters
-}
\ No newline at end of file
+	wg.Wait()
+	fmt.Println(c.counters)
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+// startState starts a state-owning goroutine and returns
+// the channels to talk to it.
+func startState() (chan readOp, chan writeOp) {
+	reads := make(chan readOp)
+	writes := make(chan writeOp)
+	go serveState(reads, writes)
+	return reads, writes
+}
+
+func read(reads chan<- readOp, key int) int {
+	op := readOp{key: key, resp: make(chan int)}
+	reads <- op
+	return <-op.resp
+}
+
+func write(writes chan<- writeOp, key, val int) bool {
+	op := writeOp{key: key, val: val, resp: make(chan bool)}
+	writes <- op
+	return <-op.resp
+}
+
+func TestReadWrite(t *testing.T) {
+	reads, writes := startState()
+
+	practicetest.Wait(t, "the state goroutine to answer", func() {
+		if got := read(reads, 1); got != 0 {
+			t.Errorf("Expected 0 for a missing key, got %d", got)
+		}
+		if !write(writes, 1, 42) {
+			t.Error("Expected write to succeed")
+			return
+		}
+		if got := read(reads, 1); got != 42 {
+			t.Errorf("Expected 42, got %d", got)
+		}
+		write(writes, 1, 7)
+		if got := read(reads, 1); got != 7 {
+			t.Errorf("Expected overwritten value 7, got %d", got)
+		}
+	})
+}
+
+func TestConcurrentOps(t *testing.T) {
+	reads, writes := startState()
+
+	// Many writers and readers hit the owner at once; with
+	// -race this proves the map is only touched by the
+	// owning goroutine.
+	const writers = 10
+	const perWriter = 100
+	var readOps, writeOps int
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for w := range writers {
+		wg.Go(func() {
+			for i := range perWriter {
+				write(writes, w, i)
+				mu.Lock()
+				writeOps++
+				mu.Unlock()
+			}
+		})
+	}
+	for r := range 100 {
+		wg.Go(func() {
+			read(reads, r%writers)
+			mu.Lock()
+			readOps++
+			mu.Unlock()
+		})
+	}
+	practicetest.Wait(t, "the readers and writers", wg.Wait)
+
+	if writeOps != writers*perWriter || readOps != 100 {
+		t.Errorf("Expected %d writes and 100 reads, got %d and %d", writers*perWriter, writeOps, readOps)
+	}
+	// Each writer wrote its own key in order, so the last
+	// value must have won.
+	practicetest.Wait(t, "the state goroutine to answer", func() {
+		for w := range writers {
+			if got := read(reads, w); got != perWriter-1 {
+				t.Errorf("Expected key %d to be %d, got %d", w, perWriter-1, got)
+			}
+		}
+	})
+}
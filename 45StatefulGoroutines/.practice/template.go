@@ -24,78 +24,87 @@ import (
 // replies. These `readOp` and `writeOp` `struct`s
 // encapsulate those requests and a way for the owning
 // goroutine to respond.
-
-// TODO: Define struct readOp with key (int) and resp (chan int) fields
-
-// TODO: Define struct writeOp with key (int), val (int), and resp (chan bool) fields
-
+type readOp struct {
+	key  int
+	resp chan int
+}
+type writeOp struct {
+	key  int
+	val  int
+	resp chan bool
+}
+
+// serveState owns the `state`, which is a map as in the
+// previous example but now private to the stateful
+// goroutine. It repeatedly selects on the `reads` and
+// `writes` channels, responding to requests as they
+// arrive. A response is executed by first performing the
+// requested operation and then sending a value on the
+// response channel `resp` to indicate success (and the
+// desired value in the case of `reads`).
+func serveState(reads <-chan readOp, writes <-chan writeOp) {
+	// TODO: Create var state = make(map[int]int)
+	// In a for loop, select on reads and writes:
+	// for a read, send state[read.key] on read.resp;
+	// for a write, set state[write.key] = write.val and send true on write.resp
+}
 
 func main() {
 
 	// As before we'll count how many operations we perform.
-
-	// TODO: Create readOps uint64
-
-	// TODO: Create writeOps uint64
-
+	var readOps uint64
+	var writeOps uint64
 
 	// The `reads` and `writes` channels will be used by
 	// other goroutines to issue read and write requests,
 	// respectively.
+	reads := make(chan readOp)
+	writes := make(chan writeOp)
 
-	// TODO: Create reads channel of readOp
-
-	// TODO: Create writes channel of writeOp
-
-	// Here is the goroutine that owns the `state`, which
-	// is a map as in the previous example but now private
-	// to the stateful goroutine. This goroutine repeatedly
-	// selects on the `reads` and `writes` channels,
-	// responding to requests as they arrive. A response
-	// is executed by first performing the requested
-	// operation and then sending a value on the response
-	// channel `resp` to indicate success (and the desired
-	// value in the case of `reads`).
-
-	// TODO: Create a goroutine that owns the state, which is a map as in the previous example but now private to the stateful goroutine.
-	// Inside, use for range to select on reads and writes channels and perform the requested operation and send a value on the response channel resp to indicate success (and the desired value in the case of reads).
-
+	go serveState(reads, writes)
 
 	// This starts 100 goroutines to issue reads to the
 	// state-owning goroutine via the `reads` channel.
 	// Each read requires constructing a `readOp`, sending
 	// it over the `reads` channel, and then receiving the
 	// result over the provided `resp` channel.
-
-
-	// TODO: Iterate over 100 and create a goroutine that issues reads to the state-owning goroutine via the reads channel.
-	// Inside, create read readOp with key rand.Intn(5) and resp make(chan int)
-	// Send read to reads channel
-	// Receive the result from read.resp
-	// Add 1 to readOps
-	// Sleep for 1 millisecond
-
+	for range 100 {
+		go func() {
+			for {
+				read := readOp{
+					key:  rand.Intn(5),
+					resp: make(chan int)}
+				reads <- read
+				<-read.resp
+				atomic.AddUint64(&readOps, 1)
+				time.Sleep(time.Millisecond)
+			}
+		}()
+	}
 
 	// We start 10 writes as well, using a similar
 	// approach.
-
-
-	// TODO: Iterate over 10 and create a goroutine that issues writes to the state-owning goroutine via the writes channel.
-	// Inside, create write writeOp with key rand.Intn(5) and val rand.Intn(100) and resp make(chan bool)
-	// Send write to writes channel
-	// Receive the result from write.resp
-	// Add 1 to writeOps
-	// Sleep for 1 millisecond
-
+	for range 10 {
+		go func() {
+			for {
+				write := writeOp{
+					key:  rand.Intn(5),
+					val:  rand.Intn(100),
+					resp: make(chan bool)}
+				writes <- write
+				<-write.resp
+				atomic.AddUint64(&writeOps, 1)
+				time.Sleep(time.Millisecond)
+			}
+		}()
+	}
 
 	// Let the goroutines work for a second.
-
-	// TODO: Sleep for 1 second
+	time.Sleep(time.Second)
 
 	// Finally, capture and report the op counts.
-
-	// TODO: Print the result of the reads with readOps
-
-	// TODO: Print the result of the writes with writeOps
-
-}
\ No newline at end of file
+	readOpsFinal := atomic.LoadUint64(&readOps)
+	fmt.Println("readOps:", readOpsFinal)
+	writeOpsFinal := atomic.LoadUint64(&writeOps)
+	fmt.Println("writeOps:", writeOpsFinal)
+}
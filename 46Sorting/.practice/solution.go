@@ -0,0 +1,41 @@
+// Go's `slices` package implements sorting for builtins
+// and user-defined types. We'll look at sorting for
+// builtins first.
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// Sorting functions are generic, and work for any
+// _ordered_ built-in type. For a list of ordered
+// types, see [cmp.Ordered](https://pkg.go.dev/cmp#Ordered).
+// `sortInPlace` sorts `s` using `slices.Sort`; note that
+// sorting is in-place, so it changes the given slice
+// and doesn't return a new one.
+func sortInPlace[E cmp.Ordered](s []E) {
+	slices.Sort(s)
+}
+
+// We can also use the `slices` package to check if
+// a slice is already in sorted order.
+func isSorted[E cmp.Ordered](s []E) bool {
+	return slices.IsSorted(s)
+}
+
+func main() {
+	strs := []string{"c", "a", "b"}
+	sortInPlace(strs)
+	fmt.Println("Strings:", strs)
+
+	// An example of sorting `int`s.
+	ints := []int{7, 2, 4}
+	sortInPlace(ints)
+	fmt.Println("Ints:   ", ints)
+
+	s := isSorted(ints)
+	fmt.Println("Sorted: ", s)
+}
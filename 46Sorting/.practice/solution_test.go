@@ -0,0 +1,43 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSortInPlace(t *testing.T) {
+	strs := []string{"c", "a", "b"}
+	sortInPlace(strs)
+	if want := []string{"a", "b", "c"}; !slices.Equal(strs, want) {
+		t.Errorf("Expected %v, got %v", want, strs)
+	}
+
+	ints := []int{7, 2, 4, -1}
+	sortInPlace(ints)
+	if want := []int{-1, 2, 4, 7}; !slices.Equal(ints, want) {
+		t.Errorf("Expected %v, got %v", want, ints)
+	}
+
+	floats := []float64{2.5, 1.5}
+	sortInPlace(floats)
+	if want := []float64{1.5, 2.5}; !slices.Equal(floats, want) {
+		t.Errorf("Expected %v, got %v", want, floats)
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	tests := []struct {
+		in   []int
+		want bool
+	}{
+		{[]int{2, 4, 7}, true},
+		{[]int{7, 2, 4}, false},
+		{[]int{1, 1, 2}, true},
+		{nil, true},
+	}
+	for _, tt := range tests {
+		if got := isSorted(tt.in); got != tt.want {
+			t.Errorf("isSorted(%v): expected %v, got %v", tt.in, tt.want, got)
+		}
+	}
+}
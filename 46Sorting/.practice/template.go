@@ -5,37 +5,37 @@
 package main
 
 import (
+	"cmp"
 	"fmt"
-	"slices"
 )
 
-func main() {
-
-	// Sorting functions are generic, and work for any
-	// _ordered_ built-in type. For a list of ordered
-	// types, see [cmp.Ordered](https://pkg.go.dev/cmp#Ordered).
-
-	// TODO: Create slice strs of strings with values "c", "a", "b"
-
-	// TODO: Sort strs using slices.Sort
-
-	// TODO: Print Strings: strs
+// Sorting functions are generic, and work for any
+// _ordered_ built-in type. For a list of ordered
+// types, see [cmp.Ordered](https://pkg.go.dev/cmp#Ordered).
+// `sortInPlace` sorts `s` using `slices.Sort`; note that
+// sorting is in-place, so it changes the given slice
+// and doesn't return a new one.
+func sortInPlace[E cmp.Ordered](s []E) {
+	// TODO: Sort s using slices.Sort
+}
+
+// We can also use the `slices` package to check if
+// a slice is already in sorted order.
+func isSorted[E cmp.Ordered](s []E) bool {
+	// TODO: Check if s is sorted using slices.IsSorted
+	return false
+}
 
+func main() {
+	strs := []string{"c", "a", "b"}
+	sortInPlace(strs)
+	fmt.Println("Strings:", strs)
 
 	// An example of sorting `int`s.
+	ints := []int{7, 2, 4}
+	sortInPlace(ints)
+	fmt.Println("Ints:   ", ints)
 
-	// TODO: Create slice ints of ints with values 7, 2, 4
-
-	// TODO: Sort ints using slices.Sort
-
-	// TODO: Print Ints: ints
-
-
-	// We can also use the `slices` package to check if
-	// a slice is already in sorted order.
-
-	// TODO: Check if ints is sorted using slices.IsSorted
-
-	// TODO: Print Sorted: s
-
-}
\ No newline at end of file
+	s := isSorted(ints)
+	fmt.Println("Sorted: ", s)
+}
@@ -0,0 +1,59 @@
+// Sometimes we'll want to sort a collection by something
+// other than its natural order. For example, suppose we
+// wanted to sort strings by their length instead of
+// alphabetically. Here's an example of custom sorts
+// in Go.
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// We implement a comparison function for string
+// lengths. `cmp.Compare` is helpful for this.
+func lenCmp(a, b string) int {
+	return cmp.Compare(len(a), len(b))
+}
+
+// Now we can call `slices.SortFunc` with this custom
+// comparison function to sort `fruits` by name length.
+func sortByLength(fruits []string) {
+	slices.SortFunc(fruits, lenCmp)
+}
+
+// We can use the same technique to sort a slice of
+// values that aren't built-in types.
+type Person struct {
+	name string
+	age  int
+}
+
+// Sort `people` by age using `slices.SortFunc`.
+//
+// Note: if the `Person` struct is large,
+// you may want the slice to contain `*Person` instead
+// and adjust the sorting function accordingly. If in
+// doubt, [benchmark](testing-and-benchmarking)!
+func sortByAge(people []Person) {
+	slices.SortFunc(people,
+		func(a, b Person) int {
+			return cmp.Compare(a.age, b.age)
+		})
+}
+
+func main() {
+	fruits := []string{"peach", "banana", "kiwi"}
+	sortByLength(fruits)
+	fmt.Println(fruits)
+
+	people := []Person{
+		Person{name: "Jax", age: 37},
+		Person{name: "TJ", age: 25},
+		Person{name: "Alex", age: 72},
+	}
+	sortByAge(people)
+	fmt.Println(people)
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLenCmp(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"kiwi", "peach", -1},
+		{"banana", "peach", 1},
+		{"kiwi", "pear", 0},
+	}
+	for _, tt := range tests {
+		if got := lenCmp(tt.a, tt.b); got != tt.want {
+			t.Errorf("lenCmp(%q, %q): expected %d, got %d", tt.a, tt.b, tt.want, got)
+		}
+	}
+}
+
+func TestSortByLength(t *testing.T) {
+	fruits := []string{"peach", "banana", "kiwi"}
+	sortByLength(fruits)
+	if want := []string{"kiwi", "peach", "banana"}; !slices.Equal(fruits, want) {
+		t.Errorf("Expected %v, got %v", want, fruits)
+	}
+}
+
+func TestSortByAge(t *testing.T) {
+	people := []Person{
+		{name: "Jax", age: 37},
+		{name: "TJ", age: 25},
+		{name: "Alex", age: 72},
+	}
+	sortByAge(people)
+	want := []Person{
+		{name: "TJ", age: 25},
+		{name: "Jax", age: 37},
+		{name: "Alex", age: 72},
+	}
+	if !slices.Equal(people, want) {
+		t.Errorf("Expected %v, got %v", want, people)
+	}
+}
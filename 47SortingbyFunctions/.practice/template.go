@@ -6,48 +6,49 @@
 
 package main
 
-import (
-	"cmp"
-	"fmt"
-	"slices"
-)
-
-func main() {
-
-	// TODO: Create slice fruits of strings with values "peach", "banana", "kiwi"
-
-
-	// We implement a comparison function for string
-	// lengths. `cmp.Compare` is helpful for this.
-
-	// TODO: Create lenCmp function that returns the comparison of the lengths of a and b
-
-	// Now we can call `slices.SortFunc` with this custom
-	// comparison function to sort `fruits` by name length.
-
+import "fmt"
+
+// We implement a comparison function for string
+// lengths. `cmp.Compare` is helpful for this.
+func lenCmp(a, b string) int {
+	// TODO: Return the comparison of the lengths of a and b using cmp.Compare
+	return 0
+}
+
+// Now we can call `slices.SortFunc` with this custom
+// comparison function to sort `fruits` by name length.
+func sortByLength(fruits []string) {
 	// TODO: Sort fruits using slices.SortFunc with lenCmp
+}
+
+// We can use the same technique to sort a slice of
+// values that aren't built-in types.
+type Person struct {
+	name string
+	age  int
+}
+
+// Sort `people` by age using `slices.SortFunc`.
+//
+// Note: if the `Person` struct is large,
+// you may want the slice to contain `*Person` instead
+// and adjust the sorting function accordingly. If in
+// doubt, [benchmark](testing-and-benchmarking)!
+func sortByAge(people []Person) {
+	// TODO: Sort people using slices.SortFunc with func(a, b Person) int
+	// that returns the comparison of the ages of a and b
+}
 
-	// TODO: Print Fruits: fruits
-
-
-	// We can use the same technique to sort a slice of
-	// values that aren't built-in types.
-
-	// TODO: Define struct Person with name (string) and age (int) fields
-
-	// TODO: Create slice people of Person with values Person{name: "Jax", age: 37}, Person{name: "TJ", age: 25}, Person{name: "Alex", age: 72}
-
-
-	// Sort `people` by age using `slices.SortFunc`.
-	//
-	// Note: if the `Person` struct is large,
-	// you may want the slice to contain `*Person` instead
-	// and adjust the sorting function accordingly. If in
-	// doubt, [benchmark](testing-and-benchmarking)!
-
-
-	// TODO: Sort people using slices.SortFunc with func(a, b Person) int that returns the comparison of the ages of a and b
-
-	// TODO: Print People: people
-
-}
\ No newline at end of file
+func main() {
+	fruits := []string{"peach", "banana", "kiwi"}
+	sortByLength(fruits)
+	fmt.Println(fruits)
+
+	people := []Person{
+		Person{name: "Jax", age: 37},
+		Person{name: "TJ", age: 25},
+		Person{name: "Alex", age: 72},
+	}
+	sortByAge(people)
+	fmt.Println(people)
+}
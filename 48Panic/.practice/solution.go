@@ -0,0 +1,34 @@
+// A `panic` typically means something went unexpectedly
+// wrong. Mostly we use it to fail fast on errors that
+// shouldn't occur during normal operation, or that we
+// aren't prepared to handle gracefully.
+
+package main
+
+import "os"
+
+// We'll use panic throughout this site to check for
+// unexpected errors. This is the only program on the
+// site designed to panic.
+func fail() {
+	panic("a problem")
+}
+
+// A common use of panic is to abort if a function
+// returns an error value that we don't know how to
+// (or want to) handle. Here's an example of
+// `panic`king if we get an unexpected error when creating a new file.
+func mustCreate(path string) *os.File {
+	f, err := os.Create(path)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+func main() {
+	fail()
+
+	f := mustCreate("/tmp/file")
+	f.Close()
+}
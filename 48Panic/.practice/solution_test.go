@@ -0,0 +1,42 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"testing"
+)
+
+func TestFail(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "a problem" {
+			t.Errorf("Expected panic with %q, got %v", "a problem", r)
+		}
+	}()
+	fail()
+}
+
+func TestMustCreate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file")
+	f := mustCreate(path)
+	if f == nil {
+		t.Fatal("Expected a file, got nil")
+	}
+	f.Close()
+}
+
+func TestMustCreatePanicsOnError(t *testing.T) {
+	defer func() {
+		// The panic value is the error from os.Create,
+		// not just a message.
+		r := recover()
+		err, ok := r.(error)
+		if !ok {
+			t.Fatalf("Expected panic with an error, got %v", r)
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Expected a not-exist error, got %v", err)
+		}
+	}()
+	mustCreate(filepath.Join(t.TempDir(), "missing", "file"))
+}
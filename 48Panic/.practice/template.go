@@ -7,21 +7,27 @@ package main
 
 import "os"
 
-func main() {
-
-	// We'll use panic throughout this site to check for
-	// unexpected errors. This is the only program on the
-	// site designed to panic.
-
+// We'll use panic throughout this site to check for
+// unexpected errors. This is the only program on the
+// site designed to panic.
+func fail() {
 	// TODO: Panic with "a problem"
-
-
-	// A common use of panic is to abort if a function
-	// returns an error value that we don't know how to
-	// (or want to) handle. Here's an example of
-	// `panic`king if we get an unexpected error when creating a new file.
-
-	// TODO: Create a new file with os.Create("/tmp/file")
+}
+
+// A common use of panic is to abort if a function
+// returns an error value that we don't know how to
+// (or want to) handle. Here's an example of
+// `panic`king if we get an unexpected error when creating a new file.
+func mustCreate(path string) *os.File {
+	// TODO: Create a new file with os.Create(path)
 	// TODO: Check if err is not nil, panic with err
+	// TODO: Return the file
+	return nil
+}
+
+func main() {
+	fail()
 
-}
\ No newline at end of file
+	f := mustCreate("/tmp/file")
+	f.Close()
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+func TestDeferredClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "defer.txt")
+
+	// The deferred close runs after writeFile, when the
+	// enclosing function returns.
+	got := practicetest.Capture(t, func() {
+		f := createFile(path)
+		defer closeFile(f)
+		writeFile(f)
+	})
+	if want := "creating\nwriting\nclosing\n"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	if string(data) != "data\n" {
+		t.Errorf("Expected file contents %q, got %q", "data\n", data)
+	}
+}
+
+func TestCloseFilePanicsOnError(t *testing.T) {
+	f, err := os.Create(filepath.Join(t.TempDir(), "closed.txt"))
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	f.Close()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected closeFile to panic when Close fails")
+		}
+	}()
+	closeFile(f)
+}
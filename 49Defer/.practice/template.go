@@ -5,10 +5,7 @@
 
 package main
 
-import (
-	"fmt"
-	"os"
-)
+import "os"
 
 // Suppose we wanted to create a file, write to it,
 // and then close when we're done. Here's how we could
@@ -20,23 +17,28 @@ func main() {
 	// with `closeFile`. This will be executed at the end
 	// of the enclosing function (`main`), after
 	// `writeFile` has finished.
-
-	// TODO: Create a file with createFile("/tmp/defer.txt")
-	// TODO: Defer the closing of the file with defer closeFile(f)
-	// TODO: Write to the file with writeFile(f)
-
+	f := createFile("/tmp/defer.txt")
+	defer closeFile(f)
+	writeFile(f)
 }
 
-// TODO: Create function createFile(p string) *os.File that creates a file at the given path and returns the file
-// Inside, create a file with os.Create(p) and check if err is not nil, panic with err
-// Return the file
+func createFile(p string) *os.File {
+	// TODO: Print "creating", then create a file with os.Create(p)
+	// and check if err is not nil, panic with err
+	// Return the file
+	return nil
+}
 
-// TODO: Create function writeFile(f *os.File) that writes "data" to the file
-// Inside, use fmt.Fprintln(f, "data")
+func writeFile(f *os.File) {
+	// TODO: Print "writing", then write "data" to the file
+	// Hint: fmt.Println and fmt.Fprintln(f, "data")
+}
 
+func closeFile(f *os.File) {
+	// TODO: Print "closing", then close the file with f.Close()
 
-// TODO: Create function closeFile(f *os.File) that closes the file
-// Inside, use f.Close() and check if err is not nil, panic with err
+	// It's important to check for errors when closing a
+	// file, even in a deferred function.
 
-// It's important to check for errors when closing a
-// file, even in a deferred function.
\ No newline at end of file
+	// TODO: Check if err is not nil, panic with err
+}
@@ -0,0 +1,38 @@
+package main
+
+import "testing"
+
+func TestMayPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "a problem" {
+			t.Errorf("Expected panic with %q, got %v", "a problem", r)
+		}
+	}()
+	mayPanic()
+}
+
+func TestProtect(t *testing.T) {
+	if r := protect(mayPanic); r != "a problem" {
+		t.Errorf("Expected to recover %q, got %v", "a problem", r)
+	}
+
+	// Code after the panic must not run.
+	ran := false
+	protect(func() {
+		mayPanic()
+		ran = true
+	})
+	if ran {
+		t.Error("Expected execution to stop at the panic")
+	}
+}
+
+func TestProtectNoPanic(t *testing.T) {
+	called := false
+	if r := protect(func() { called = true }); r != nil {
+		t.Errorf("Expected nil when fn does not panic, got %v", r)
+	}
+	if !called {
+		t.Error("Expected protect to call fn")
+	}
+}
@@ -15,26 +15,36 @@ package main
 import "fmt"
 
 // This function panics.
+func mayPanic() {
+	// TODO: Panic with "a problem"
+}
 
-// TODO: Create function mayPanic that panics with "a problem"
+// `protect` runs `fn` and returns the value it panicked
+// with, or nil if it returned normally.
+func protect(fn func()) (recovered any) {
 
-func main() {
 	// `recover` must be called within a deferred function.
 	// When the enclosing function panics, the defer will
 	// activate and a `recover` call within it will catch
 	// the panic.
-
-	// TODO: Defer a function that recovers from a panic
-	// Inside, check if recover is not nil, print the error
+	// TODO: Defer a function that sets recovered to the result of recover()
 
 	// The return value of `recover` is the error raised in
 	// the call to `panic`.
 
-	// TODO: Call mayPanic
+	fn()
+	return nil
+}
 
-	// This code will not run, because `mayPanic` panics.
-	// The execution of `main` stops at the point of the
-	// panic and resumes in the deferred closure.
-
-	// TODO: Print "After mayPanic()"
-}
\ No newline at end of file
+func main() {
+	if r := protect(func() {
+		mayPanic()
+
+		// This code will not run, because `mayPanic` panics.
+		// The execution stops at the point of the
+		// panic and resumes in the deferred closure.
+		fmt.Println("After mayPanic()")
+	}); r != nil {
+		fmt.Println("Recovered. Error:\n", r)
+	}
+}
@@ -0,0 +1,83 @@
+// The standard library's `strings` package provides many
+// useful string-related functions. Here are some examples
+// to give you a sense of the package.
+
+package main
+
+import (
+	"fmt"
+	s "strings"
+)
+
+// We alias `fmt.Println` to a shorter name as we'll use
+// it a lot below.
+var p = fmt.Println
+
+// Here's a sample of the functions available in
+// `strings`. Since these are functions from the
+// package, not methods on the string object itself,
+// we need to pass the string in question as the first
+// argument to the function. You can find more
+// functions in the [`strings`](https://pkg.go.dev/strings)
+// package docs.
+
+func contains(str, substr string) bool {
+	return s.Contains(str, substr)
+}
+
+func count(str, substr string) int {
+	return s.Count(str, substr)
+}
+
+func hasPrefix(str, prefix string) bool {
+	return s.HasPrefix(str, prefix)
+}
+
+func hasSuffix(str, suffix string) bool {
+	return s.HasSuffix(str, suffix)
+}
+
+func index(str, substr string) int {
+	return s.Index(str, substr)
+}
+
+func join(elems []string, sep string) string {
+	return s.Join(elems, sep)
+}
+
+func repeat(str string, n int) string {
+	return s.Repeat(str, n)
+}
+
+// `replace` replaces the first `n` occurrences of `old`;
+// a negative `n` replaces all of them.
+func replace(str, old, new string, n int) string {
+	return s.Replace(str, old, new, n)
+}
+
+func split(str, sep string) []string {
+	return s.Split(str, sep)
+}
+
+func toLower(str string) string {
+	return s.ToLower(str)
+}
+
+func toUpper(str string) string {
+	return s.ToUpper(str)
+}
+
+func main() {
+	p("Contains:  ", contains("test", "es"))
+	p("Count:     ", count("test", "t"))
+	p("HasPrefix: ", hasPrefix("test", "te"))
+	p("HasSuffix: ", hasSuffix("test", "st"))
+	p("Index:     ", index("test", "e"))
+	p("Join:      ", join([]string{"a", "b"}, "-"))
+	p("Repeat:    ", repeat("a", 5))
+	p("Replace:   ", replace("foo", "o", "0", -1))
+	p("Replace:   ", replace("foo", "o", "0", 1))
+	p("Split:     ", split("a-b-c-d-e", "-"))
+	p("ToLower:   ", toLower("TEST"))
+	p("ToUpper:   ", toUpper("test"))
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPredicates(t *testing.T) {
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"contains", contains("test", "es"), true},
+		{"contains missing", contains("test", "x"), false},
+		{"hasPrefix", hasPrefix("test", "te"), true},
+		{"hasPrefix suffix", hasPrefix("test", "st"), false},
+		{"hasSuffix", hasSuffix("test", "st"), true},
+		{"hasSuffix prefix", hasSuffix("test", "te"), false},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, tt.got)
+		}
+	}
+}
+
+func TestCountAndIndex(t *testing.T) {
+	if got := count("test", "t"); got != 2 {
+		t.Errorf("count: expected 2, got %d", got)
+	}
+	if got := index("test", "e"); got != 1 {
+		t.Errorf("index: expected 1, got %d", got)
+	}
+	if got := index("test", "x"); got != -1 {
+		t.Errorf("index of a missing substring: expected -1, got %d", got)
+	}
+}
+
+func TestTransforms(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"join", join([]string{"a", "b"}, "-"), "a-b"},
+		{"repeat", repeat("a", 5), "aaaaa"},
+		{"replace all", replace("foo", "o", "0", -1), "f00"},
+		{"replace first", replace("foo", "o", "0", 1), "f0o"},
+		{"toLower", toLower("TEST"), "test"},
+		{"toUpper", toUpper("test"), "TEST"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.want, tt.got)
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	want := []string{"a", "b", "c", "d", "e"}
+	if got := split("a-b-c-d-e", "-"); !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
@@ -4,35 +4,90 @@
 
 package main
 
-import (
-	"fmt"
-	s "strings"
-)
+import "fmt"
+
+// TODO: Import the strings package with the alias s, i.e. s "strings"
 
 // We alias `fmt.Println` to a shorter name as we'll use
 // it a lot below.
 var p = fmt.Println
 
-func main() {
+// Here's a sample of the functions available in
+// `strings`. Since these are functions from the
+// package, not methods on the string object itself,
+// we need to pass the string in question as the first
+// argument to the function. You can find more
+// functions in the [`strings`](https://pkg.go.dev/strings)
+// package docs.
+
+func contains(str, substr string) bool {
+	// TODO: Return s.Contains(str, substr)
+	return false
+}
+
+func count(str, substr string) int {
+	// TODO: Return s.Count(str, substr)
+	return 0
+}
+
+func hasPrefix(str, prefix string) bool {
+	// TODO: Return s.HasPrefix(str, prefix)
+	return false
+}
+
+func hasSuffix(str, suffix string) bool {
+	// TODO: Return s.HasSuffix(str, suffix)
+	return false
+}
+
+func index(str, substr string) int {
+	// TODO: Return s.Index(str, substr)
+	return 0
+}
 
-	// Here's a sample of the functions available in
-	// `strings`. Since these are functions from the
-	// package, not methods on the string object itself,
-	// we need to pass the string in question as the first
-	// argument to the function. You can find more
-	// functions in the [`strings`](https://pkg.go.dev/strings)
-	// package docs.
-
-	// TODO: Print Contains:  s.Contains("test", "es")
-	// TODO: Print Count:     s.Count("test", "t")
-	// TODO: Print HasPrefix: s.HasPrefix("test", "te")
-	// TODO: Print HasSuffix: s.HasSuffix("test", "st")
-	// TODO: Print Index:     s.Index("test", "e")
-	// TODO: Print Join:      s.Join([]string{"a", "b"}, "-")
-	// TODO: Print Repeat:    s.Repeat("a", 5)
-	// TODO: Print Replace:   s.Replace("foo", "o", "0", -1)
-	// TODO: Print Split:     s.Split("a-b-c-d-e", "-")
-	// TODO: Print ToLower:   s.ToLower("TEST")
-	// TODO: Print ToUpper:   s.ToUpper("test")
-
-}
\ No newline at end of file
+func join(elems []string, sep string) string {
+	// TODO: Return s.Join(elems, sep)
+	return ""
+}
+
+func repeat(str string, n int) string {
+	// TODO: Return s.Repeat(str, n)
+	return ""
+}
+
+// `replace` replaces the first `n` occurrences of `old`;
+// a negative `n` replaces all of them.
+func replace(str, old, new string, n int) string {
+	// TODO: Return s.Replace(str, old, new, n)
+	return ""
+}
+
+func split(str, sep string) []string {
+	// TODO: Return s.Split(str, sep)
+	return nil
+}
+
+func toLower(str string) string {
+	// TODO: Return s.ToLower(str)
+	return ""
+}
+
+func toUpper(str string) string {
+	// TODO: Return s.ToUpper(str)
+	return ""
+}
+
+func main() {
+	p("Contains:  ", contains("test", "es"))
+	p("Count:     ", count("test", "t"))
+	p("HasPrefix: ", hasPrefix("test", "te"))
+	p("HasSuffix: ", hasSuffix("test", "st"))
+	p("Index:     ", index("test", "e"))
+	p("Join:      ", join([]string{"a", "b"}, "-"))
+	p("Repeat:    ", repeat("a", 5))
+	p("Replace:   ", replace("foo", "o", "0", -1))
+	p("Replace:   ", replace("foo", "o", "0", 1))
+	p("Split:     ", split("a-b-c-d-e", "-"))
+	p("ToLower:   ", toLower("TEST"))
+	p("ToUpper:   ", toUpper("test"))
+}
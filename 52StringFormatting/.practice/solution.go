@@ -0,0 +1,127 @@
+// Go offers excellent support for string formatting in
+// the `printf` tradition. Here are some examples of
+// common string formatting tasks.
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+type point struct {
+	x, y int
+}
+
+// Go offers several printing "verbs" designed to
+// format general Go values. `describe` returns a
+// value formatted with `%v`; if the value is a struct,
+// the `%+v` variant will include the struct's field
+// names; the `%#v` variant prints a Go syntax
+// representation of the value, i.e. the source code
+// snippet that would produce that value; and `%T`
+// prints the type of the value.
+func describe(v any) (plain, fields, syntax, typ string) {
+	return fmt.Sprintf("%v", v),
+		fmt.Sprintf("%+v", v),
+		fmt.Sprintf("%#v", v),
+		fmt.Sprintf("%T", v)
+}
+
+// There are many options for formatting integers.
+// `%d` is standard, base-10 formatting; `%b` prints a
+// binary representation; `%c` prints the character
+// corresponding to the given integer; and `%x`
+// provides hex encoding.
+func formatInt(verb byte, n int) string {
+	return fmt.Sprintf("%"+string(verb), n)
+}
+
+// When formatting numbers you will often want to
+// control the width and precision of the resulting
+// figure. To specify the width of an integer, use a
+// number after the `%` in the verb. By default the
+// result will be right-justified and padded with
+// spaces.
+func intColumns(a, b int) string {
+	return fmt.Sprintf("|%6d|%6d|", a, b)
+}
+
+// You can also specify the width of printed floats,
+// though usually you'll also want to restrict the
+// decimal precision at the same time with the
+// width.precision syntax. To left-justify, use the
+// `-` flag.
+func floatColumns(a, b float64, left bool) string {
+	if left {
+		return fmt.Sprintf("|%-6.2f|%-6.2f|", a, b)
+	}
+	return fmt.Sprintf("|%6.2f|%6.2f|", a, b)
+}
+
+// You may also want to control width when formatting
+// strings, especially to ensure that they align in
+// table-like output. To left-justify use the `-` flag
+// as with numbers.
+func stringColumns(a, b string, left bool) string {
+	if left {
+		return fmt.Sprintf("|%-6s|%-6s|", a, b)
+	}
+	return fmt.Sprintf("|%6s|%6s|", a, b)
+}
+
+func main() {
+	p := point{1, 2}
+	plain, fields, syntax, typ := describe(p)
+	fmt.Printf("struct1: %s\n", plain)
+	fmt.Printf("struct2: %s\n", fields)
+	fmt.Printf("struct3: %s\n", syntax)
+	fmt.Printf("type: %s\n", typ)
+
+	// Formatting booleans is straight-forward.
+	fmt.Printf("bool: %t\n", true)
+
+	fmt.Printf("int: %s\n", formatInt('d', 123))
+	fmt.Printf("bin: %s\n", formatInt('b', 14))
+	fmt.Printf("char: %s\n", formatInt('c', 33))
+	fmt.Printf("hex: %s\n", formatInt('x', 456))
+
+	// There are also several formatting options for
+	// floats. For basic decimal formatting use `%f`.
+	fmt.Printf("float1: %f\n", 78.9)
+
+	// `%e` and `%E` format the float in (slightly
+	// different versions of) scientific notation.
+	fmt.Printf("float2: %e\n", 123400000.0)
+	fmt.Printf("float3: %E\n", 123400000.0)
+
+	// For basic string printing use `%s`.
+	fmt.Printf("str1: %s\n", "\"string\"")
+
+	// To double-quote strings as in Go source, use `%q`.
+	fmt.Printf("str2: %q\n", "\"string\"")
+
+	// As with integers seen earlier, `%x` renders
+	// the string in base-16, with two output characters
+	// per byte of input.
+	fmt.Printf("str3: %x\n", "hex this")
+
+	// To print a representation of a pointer, use `%p`.
+	fmt.Printf("pointer: %p\n", &p)
+
+	fmt.Printf("width1: %s\n", intColumns(12, 345))
+	fmt.Printf("width2: %s\n", floatColumns(1.2, 3.45, false))
+	fmt.Printf("width3: %s\n", floatColumns(1.2, 3.45, true))
+	fmt.Printf("width4: %s\n", stringColumns("foo", "b", false))
+	fmt.Printf("width5: %s\n", stringColumns("foo", "b", true))
+
+	// So far we've seen `Printf`, which prints the
+	// formatted string to `os.Stdout`. `Sprintf` formats
+	// and returns a string without printing it anywhere.
+	s := fmt.Sprintf("sprintf: a %s", "string")
+	fmt.Println(s)
+
+	// You can format+print to `io.Writers` other than
+	// `os.Stdout` using `Fprintf`.
+	fmt.Fprintf(os.Stderr, "io: an %s\n", "error")
+}
@@ -0,0 +1,55 @@
+package main
+
+import "testing"
+
+func TestDescribe(t *testing.T) {
+	plain, fields, syntax, typ := describe(point{1, 2})
+	tests := []struct {
+		verb, got, want string
+	}{
+		{"%v", plain, "{1 2}"},
+		{"%+v", fields, "{x:1 y:2}"},
+		{"%#v", syntax, "main.point{x:1, y:2}"},
+		{"%T", typ, "main.point"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.verb, tt.want, tt.got)
+		}
+	}
+}
+
+func TestFormatInt(t *testing.T) {
+	tests := []struct {
+		verb byte
+		n    int
+		want string
+	}{
+		{'d', 123, "123"},
+		{'b', 14, "1110"},
+		{'c', 33, "!"},
+		{'x', 456, "1c8"},
+	}
+	for _, tt := range tests {
+		if got := formatInt(tt.verb, tt.n); got != tt.want {
+			t.Errorf("formatInt(%q, %d): expected %q, got %q", tt.verb, tt.n, tt.want, got)
+		}
+	}
+}
+
+func TestColumns(t *testing.T) {
+	tests := []struct {
+		name, got, want string
+	}{
+		{"ints", intColumns(12, 345), "|    12|   345|"},
+		{"floats", floatColumns(1.2, 3.45, false), "|  1.20|  3.45|"},
+		{"floats left", floatColumns(1.2, 3.45, true), "|1.20  |3.45  |"},
+		{"strings", stringColumns("foo", "b", false), "|   foo|     b|"},
+		{"strings left", stringColumns("foo", "b", true), "|foo   |b     |"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.want, tt.got)
+		}
+	}
+}
@@ -9,119 +9,119 @@ import (
 	"os"
 )
 
-// TODO: Define struct point with x (int) and y (int) fields
+type point struct {
+	x, y int
+}
+
+// Go offers several printing "verbs" designed to
+// format general Go values. `describe` returns a
+// value formatted with `%v`; if the value is a struct,
+// the `%+v` variant will include the struct's field
+// names; the `%#v` variant prints a Go syntax
+// representation of the value, i.e. the source code
+// snippet that would produce that value; and `%T`
+// prints the type of the value.
+func describe(v any) (plain, fields, syntax, typ string) {
+	// TODO: Return v formatted with %v, %+v, %#v and %T
+	// Hint: fmt.Sprintf
+	return "", "", "", ""
+}
+
+// There are many options for formatting integers.
+// `%d` is standard, base-10 formatting; `%b` prints a
+// binary representation; `%c` prints the character
+// corresponding to the given integer; and `%x`
+// provides hex encoding.
+func formatInt(verb byte, n int) string {
+	// TODO: Return n formatted with the given verb, e.g. 'd', 'b', 'c' or 'x'
+	// Hint: build the format string with "%"+string(verb)
+	return ""
+}
+
+// When formatting numbers you will often want to
+// control the width and precision of the resulting
+// figure. To specify the width of an integer, use a
+// number after the `%` in the verb. By default the
+// result will be right-justified and padded with
+// spaces.
+func intColumns(a, b int) string {
+	// TODO: Return "|a|b|" with each number right-justified in a width of 6
+	return ""
+}
+
+// You can also specify the width of printed floats,
+// though usually you'll also want to restrict the
+// decimal precision at the same time with the
+// width.precision syntax. To left-justify, use the
+// `-` flag.
+func floatColumns(a, b float64, left bool) string {
+	// TODO: Return "|a|b|" with each number in a width of 6 with 2 decimals,
+	// left-justified if left is true
+	return ""
+}
+
+// You may also want to control width when formatting
+// strings, especially to ensure that they align in
+// table-like output. To left-justify use the `-` flag
+// as with numbers.
+func stringColumns(a, b string, left bool) string {
+	// TODO: Return "|a|b|" with each string in a width of 6,
+	// left-justified if left is true
+	return ""
+}
 
 func main() {
-
-	// Go offers several printing "verbs" designed to
-	// format general Go values. For example, this prints
-	// an instance of our `point` struct.
-
-	// TODO: Create p := point{1, 2}
-	// TODO: Print p using %v
-
-	// If the value is a struct, the `%+v` variant will
-	// include the struct's field names.
-
-	// TODO: Print p using %+v
-
-	// The `%#v` variant prints a Go syntax representation
-	// of the value, i.e. the source code snippet that
-	// would produce that value.
-
-	// TODO: Print p using %#v
-
-	// To print the type of a value, use `%T`.
-
-	// TODO: Print p using %T
+	p := point{1, 2}
+	plain, fields, syntax, typ := describe(p)
+	fmt.Printf("struct1: %s\n", plain)
+	fmt.Printf("struct2: %s\n", fields)
+	fmt.Printf("struct3: %s\n", syntax)
+	fmt.Printf("type: %s\n", typ)
 
 	// Formatting booleans is straight-forward.
+	fmt.Printf("bool: %t\n", true)
 
-	// TODO: Print true using %t
-
-	// There are many options for formatting integers.
-	// Use `%d` for standard, base-10 formatting.
-
-	// TODO: Print 123 using %d
-
-	// This prints a binary representation.
-	// TODO: Print 14 using %b
-
-	// This prints the character corresponding to the
-	// given integer.
-
-	// TODO: Print 33 using %c
-
-	// `%x` provides hex encoding.
-	// TODO: Print 456 using %x
+	fmt.Printf("int: %s\n", formatInt('d', 123))
+	fmt.Printf("bin: %s\n", formatInt('b', 14))
+	fmt.Printf("char: %s\n", formatInt('c', 33))
+	fmt.Printf("hex: %s\n", formatInt('x', 456))
 
 	// There are also several formatting options for
 	// floats. For basic decimal formatting use `%f`.
-
-	// TODO: Print 78.9 using %f
+	fmt.Printf("float1: %f\n", 78.9)
 
 	// `%e` and `%E` format the float in (slightly
 	// different versions of) scientific notation.
-
-	// TODO: Print 123400000.0 using %e
-	// TODO: Print 123400000.0 using %E
+	fmt.Printf("float2: %e\n", 123400000.0)
+	fmt.Printf("float3: %E\n", 123400000.0)
 
 	// For basic string printing use `%s`.
-
-	// TODO: Print ""string"" using %s
+	fmt.Printf("str1: %s\n", "\"string\"")
 
 	// To double-quote strings as in Go source, use `%q`.
-
-	// TODO: Print ""string"" using %q
+	fmt.Printf("str2: %q\n", "\"string\"")
 
 	// As with integers seen earlier, `%x` renders
 	// the string in base-16, with two output characters
 	// per byte of input.
-
-	// TODO: Print "hex this" using %x
+	fmt.Printf("str3: %x\n", "hex this")
 
 	// To print a representation of a pointer, use `%p`.
+	fmt.Printf("pointer: %p\n", &p)
 
-	// TODO: Print pointer of p using %p
-
-	// When formatting numbers you will often want to
-	// control the width and precision of the resulting
-	// figure. To specify the width of an integer, use a
-	// number after the `%` in the verb. By default the
-	// result will be right-justified and padded with
-	// spaces.
-
-	// TODO: Print "12" and "345" using %6d
-
-	// You can also specify the width of printed floats,
-	// though usually you'll also want to restrict the
-	// decimal precision at the same time with the
-	// width.precision syntax.
-
-	// TODO: Print "1.2" and "3.45" using %6.2f
-
-	// To left-justify, use the `-` flag.
-
-	// TODO: Print "1.2" and "3.45" using %-6.2f
-
-	// You may also want to control width when formatting
-	// strings, especially to ensure that they align in
-	// table-like output. For basic right-justified width.
-
-	// TODO: Print "foo" and "b" using %6s
-
-	// To left-justify use the `-` flag as with numbers.
-
-	// TODO: Print "foo" and "b" using %-6s
+	fmt.Printf("width1: %s\n", intColumns(12, 345))
+	fmt.Printf("width2: %s\n", floatColumns(1.2, 3.45, false))
+	fmt.Printf("width3: %s\n", floatColumns(1.2, 3.45, true))
+	fmt.Printf("width4: %s\n", stringColumns("foo", "b", false))
+	fmt.Printf("width5: %s\n", stringColumns("foo", "b", true))
 
 	// So far we've seen `Printf`, which prints the
 	// formatted string to `os.Stdout`. `Sprintf` formats
 	// and returns a string without printing it anywhere.
-
-	// TODO: Print "sprintf: a string" using %Sprintf
+	s := fmt.Sprintf("sprintf: a %s", "string")
+	fmt.Println(s)
 
 	// You can format+print to `io.Writers` other than
 	// `os.Stdout` using `Fprintf`.
-
-	// TODO: Print "io: an error" using %Fprintf
-}
\ No newline at end of file
+	fmt.Fprintf(os.Stderr, "io: an %s\n", "error")
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCreatePanicsOnBadTemplate(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected Create to panic on an unterminated action")
+		}
+	}()
+	Create("bad", "Value: {{.")
+}
+
+func TestRender(t *testing.T) {
+	names := []string{"Go", "Rust", "C++", "C#"}
+	tests := []struct {
+		name string
+		tmpl string
+		data any
+		want string
+	}{
+		{"string", "Value: {{.}}\n", "some text", "Value: some text\n"},
+		{"int", "Value: {{.}}\n", 5, "Value: 5\n"},
+		{"slice", "Value: {{.}}\n", names, "Value: [Go Rust C++ C#]\n"},
+		{"struct", "Name: {{.Name}}\n", struct{ Name string }{"Jane Doe"}, "Name: Jane Doe\n"},
+		{"map", "Name: {{.Name}}\n", map[string]string{"Name": "Mickey Mouse"}, "Name: Mickey Mouse\n"},
+		{"if", "{{if . -}} yes {{else -}} no {{end}}\n", "not empty", "yes \n"},
+		{"else", "{{if . -}} yes {{else -}} no {{end}}\n", "", "no \n"},
+		{"range", "Range: {{range .}}{{.}} {{end}}\n", names, "Range: Go Rust C++ C# \n"},
+	}
+	for _, tt := range tests {
+		got, err := render(Create(tt.name, tt.tmpl), tt.data)
+		if err != nil {
+			t.Errorf("%s: render failed: %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestRenderUnexportedField(t *testing.T) {
+	// Unexported fields can't be accessed while the
+	// template is executing.
+	data := struct{ name string }{"Jane Doe"}
+	_, err := render(Create("t", "Name: {{.name}}"), data)
+	if err == nil {
+		t.Fatal("Expected an error for an unexported field, got nil")
+	}
+	if !strings.Contains(err.Error(), "name") {
+		t.Errorf("Expected the error to mention the field, got %v", err)
+	}
+}
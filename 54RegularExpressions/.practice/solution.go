@@ -0,0 +1,94 @@
+// Go offers built-in support for [regular expressions](https://en.wikipedia.org/wiki/Regular_expression).
+// Here are some examples of  common regexp-related tasks
+// in Go.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+)
+
+// This finds the first match for the regexp and the
+// start and end indexes for that match.
+func firstMatch(r *regexp.Regexp, s string) (string, []int) {
+	return r.FindString(s), r.FindStringIndex(s)
+}
+
+// The `Submatch` variants include information about
+// both the whole-pattern matches and the submatches
+// within those matches. For example this will return
+// information for both `p([a-z]+)ch` and `([a-z]+)`.
+func submatches(r *regexp.Regexp, s string) []string {
+	return r.FindStringSubmatch(s)
+}
+
+// The `All` variants of these functions apply to all
+// matches in the input, not just the first. Providing
+// a non-negative integer as `n` will limit the number
+// of matches.
+func allMatches(r *regexp.Regexp, s string, n int) []string {
+	return r.FindAllString(s, n)
+}
+
+// The `Func` variant of `ReplaceAll` allows you to
+// transform matched text with a given function.
+func upperMatches(r *regexp.Regexp, s string) string {
+	return string(r.ReplaceAllFunc([]byte(s), bytes.ToUpper))
+}
+
+func main() {
+
+	// This tests whether a pattern matches a string.
+	match, _ := regexp.MatchString("p([a-z]+)ch", "peach")
+	fmt.Println(match)
+
+	// Above we used a string pattern directly, but for
+	// other regexp tasks you'll need to `Compile` an
+	// optimized `Regexp` struct.
+	r, _ := regexp.Compile("p([a-z]+)ch")
+
+	// Many methods are available on these structs. Here's
+	// a match test like we saw earlier.
+	fmt.Println(r.MatchString("peach"))
+
+	m, idx := firstMatch(r, "peach punch")
+	fmt.Println(m)
+	fmt.Println("idx:", idx)
+
+	fmt.Println(submatches(r, "peach punch"))
+
+	// Similarly this will return information about the
+	// indexes of matches and submatches.
+	fmt.Println(r.FindStringSubmatchIndex("peach punch"))
+
+	fmt.Println(allMatches(r, "peach punch pinch", -1))
+
+	// These `All` variants are available for the other
+	// functions we saw above as well.
+	fmt.Println("all:", r.FindAllStringSubmatchIndex(
+		"peach punch pinch", -1))
+
+	fmt.Println(allMatches(r, "peach punch pinch", 2))
+
+	// Our examples above had string arguments and used
+	// names like `MatchString`. We can also provide
+	// `[]byte` arguments and drop `String` from the
+	// function name.
+	fmt.Println(r.Match([]byte("peach")))
+
+	// When creating global variables with regular
+	// expressions you can use the `MustCompile` variation
+	// of `Compile`. `MustCompile` panics instead of
+	// returning an error, which makes it safer to use for
+	// global variables.
+	r = regexp.MustCompile("p([a-z]+)ch")
+	fmt.Println("regexp:", r)
+
+	// The `regexp` package can also be used to replace
+	// subsets of strings with other values.
+	fmt.Println(r.ReplaceAllString("a peach", "<fruit>"))
+
+	fmt.Println(upperMatches(r, "a peach"))
+}
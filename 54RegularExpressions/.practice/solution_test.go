@@ -0,0 +1,53 @@
+package main
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+)
+
+var fruit = regexp.MustCompile("p([a-z]+)ch")
+
+func TestFirstMatch(t *testing.T) {
+	m, idx := firstMatch(fruit, "peach punch")
+	if m != "peach" {
+		t.Errorf("Expected %q, got %q", "peach", m)
+	}
+	if want := []int{0, 5}; !slices.Equal(idx, want) {
+		t.Errorf("Expected index %v, got %v", want, idx)
+	}
+
+	m, idx = firstMatch(fruit, "apple")
+	if m != "" || idx != nil {
+		t.Errorf("Expected no match, got %q %v", m, idx)
+	}
+}
+
+func TestSubmatches(t *testing.T) {
+	want := []string{"peach", "ea"}
+	if got := submatches(fruit, "peach punch"); !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestAllMatches(t *testing.T) {
+	tests := []struct {
+		n    int
+		want []string
+	}{
+		{-1, []string{"peach", "punch", "pinch"}},
+		{2, []string{"peach", "punch"}},
+		{1, []string{"peach"}},
+	}
+	for _, tt := range tests {
+		if got := allMatches(fruit, "peach punch pinch", tt.n); !slices.Equal(got, tt.want) {
+			t.Errorf("n=%d: expected %v, got %v", tt.n, tt.want, got)
+		}
+	}
+}
+
+func TestUpperMatches(t *testing.T) {
+	if got := upperMatches(fruit, "a peach and a pinch"); got != "a PEACH and a PINCH" {
+		t.Errorf("Expected %q, got %q", "a PEACH and a PINCH", got)
+	}
+}
@@ -5,92 +5,93 @@
 package main
 
 import (
-	"bytes"
 	"fmt"
 	"regexp"
 )
 
+// This finds the first match for the regexp and the
+// start and end indexes for that match.
+func firstMatch(r *regexp.Regexp, s string) (string, []int) {
+	// TODO: Return r.FindString(s) and r.FindStringIndex(s)
+	return "", nil
+}
+
+// The `Submatch` variants include information about
+// both the whole-pattern matches and the submatches
+// within those matches. For example this will return
+// information for both `p([a-z]+)ch` and `([a-z]+)`.
+func submatches(r *regexp.Regexp, s string) []string {
+	// TODO: Return r.FindStringSubmatch(s)
+	return nil
+}
+
+// The `All` variants of these functions apply to all
+// matches in the input, not just the first. Providing
+// a non-negative integer as `n` will limit the number
+// of matches.
+func allMatches(r *regexp.Regexp, s string, n int) []string {
+	// TODO: Return r.FindAllString(s, n)
+	return nil
+}
+
+// The `Func` variant of `ReplaceAll` allows you to
+// transform matched text with a given function.
+func upperMatches(r *regexp.Regexp, s string) string {
+	// TODO: Use r.ReplaceAllFunc on []byte(s) with bytes.ToUpper and return the result as a string
+	return ""
+}
+
 func main() {
 
 	// This tests whether a pattern matches a string.
-
-	// TODO: Create match, _ := regexp.MatchString("p([a-z]+)ch", "peach") and print it
+	match, _ := regexp.MatchString("p([a-z]+)ch", "peach")
+	fmt.Println(match)
 
 	// Above we used a string pattern directly, but for
 	// other regexp tasks you'll need to `Compile` an
 	// optimized `Regexp` struct.
-
-	// TODO: Create r, _ := regexp.Compile("p([a-z]+)ch")
+	r, _ := regexp.Compile("p([a-z]+)ch")
 
 	// Many methods are available on these structs. Here's
 	// a match test like we saw earlier.
+	fmt.Println(r.MatchString("peach"))
 
-	// TODO: Print r.MatchString("peach")
-
-	// This finds the match for the regexp.
-	// TODO: Print r.FindString("peach punch")
+	m, idx := firstMatch(r, "peach punch")
+	fmt.Println(m)
+	fmt.Println("idx:", idx)
 
-	// This also finds the first match but returns the
-	// start and end indexes for the match instead of the
-	// matching text.
-
-	// TODO: Print r.FindStringIndex("peach punch")
-
-	// The `Submatch` variants include information about
-	// both the whole-pattern matches and the submatches
-	// within those matches. For example this will return
-	// information for both `p([a-z]+)ch` and `([a-z]+)`.
-
-	// TODO: Print r.FindStringSubmatch("peach punch")
+	fmt.Println(submatches(r, "peach punch"))
 
 	// Similarly this will return information about the
 	// indexes of matches and submatches.
+	fmt.Println(r.FindStringSubmatchIndex("peach punch"))
 
-	// TODO: Print r.FindStringSubmatchIndex("peach punch")
-
-	// The `All` variants of these functions apply to all
-	// matches in the input, not just the first. For
-	// example to find all matches for a regexp.
-
-	// TODO: Print r.FindAllString("peach punch pinch", -1)
+	fmt.Println(allMatches(r, "peach punch pinch", -1))
 
 	// These `All` variants are available for the other
 	// functions we saw above as well.
+	fmt.Println("all:", r.FindAllStringSubmatchIndex(
+		"peach punch pinch", -1))
 
-	// TODO: Print r.FindAllStringSubmatchIndex("peach punch pinch", -1)
-
-	// Providing a non-negative integer as the second
-	// argument to these functions will limit the number
-	// of matches.
-
-	// TODO: Print r.FindAllString("peach punch pinch", 2)
+	fmt.Println(allMatches(r, "peach punch pinch", 2))
 
 	// Our examples above had string arguments and used
 	// names like `MatchString`. We can also provide
 	// `[]byte` arguments and drop `String` from the
 	// function name.
-
-	// TODO: Print r.Match([]byte("peach"))
+	fmt.Println(r.Match([]byte("peach")))
 
 	// When creating global variables with regular
 	// expressions you can use the `MustCompile` variation
 	// of `Compile`. `MustCompile` panics instead of
 	// returning an error, which makes it safer to use for
 	// global variables.
-
-	// TODO: Create r = regexp.MustCompile("p([a-z]+)ch")
-	// TODO: Print r
+	r = regexp.MustCompile("p([a-z]+)ch")
+	fmt.Println("regexp:", r)
 
 	// The `regexp` package can also be used to replace
 	// subsets of strings with other values.
+	fmt.Println(r.ReplaceAllString("a peach", "<fruit>"))
 
-	// TODO: Print r.ReplaceAllString("a peach", "<fruit>")
-
-	// The `Func` variant allows you to transform matched
-	// text with a given function.
-
-	// TODO: Create in := []byte("a peach")
-	// TODO: Create out := r.ReplaceAllFunc(in, bytes.ToUpper)
-	// TODO: Print string(out)
-
-}
\ No newline at end of file
+	fmt.Println(upperMatches(r, "a peach"))
+}
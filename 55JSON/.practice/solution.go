@@ -0,0 +1,139 @@
+// Go offers built-in support for JSON encoding and
+// decoding, including to and from built-in and custom
+// data types.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// We'll use these two structs to demonstrate encoding and
+// decoding of custom types below.
+type response1 struct {
+	Page   int
+	Fruits []string
+}
+
+// Only exported fields will be encoded/decoded in JSON.
+// Fields must start with capital letters to be exported.
+// You can use tags on struct field declarations
+// to customize the encoded JSON key names.
+type response2 struct {
+	Page   int      `json:"page"`
+	Fruits []string `json:"fruits"`
+}
+
+// `encode` returns the JSON encoding of `v` as a string.
+// The JSON package can automatically encode your
+// custom data types. It will only include exported
+// fields in the encoded output and will by default
+// use those names as the JSON keys.
+func encode(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+// We need to provide a variable where the JSON
+// package can put the decoded data. This
+// `map[string]interface{}` will hold a map of strings
+// to arbitrary data types.
+func decodeGeneric(byt []byte) (map[string]interface{}, error) {
+	var dat map[string]interface{}
+	if err := json.Unmarshal(byt, &dat); err != nil {
+		return nil, err
+	}
+	return dat, nil
+}
+
+// We can also decode JSON into custom data types.
+// This has the advantages of adding additional
+// type-safety to our programs and eliminating the
+// need for type assertions when accessing the decoded
+// data. Streaming reads from `io.Reader`s like `os.Stdin`
+// or HTTP request bodies is done with `json.Decoder`.
+func decodeResponse(r io.Reader) (response2, error) {
+	res := response2{}
+	err := json.NewDecoder(r).Decode(&res)
+	return res, err
+}
+
+func main() {
+
+	// First we'll look at encoding basic data types to
+	// JSON strings. Here are some examples for atomic
+	// values.
+	fmt.Println(encode(true))
+	fmt.Println(encode(1))
+	fmt.Println(encode(2.34))
+	fmt.Println(encode("gopher"))
+
+	// And here are some for slices and maps, which encode
+	// to JSON arrays and objects as you'd expect.
+	slcD := []string{"apple", "peach", "pear"}
+	fmt.Println(encode(slcD))
+
+	mapD := map[string]int{"apple": 5, "lettuce": 7}
+	fmt.Println(encode(mapD))
+
+	res1D := &response1{
+		Page:   1,
+		Fruits: []string{"apple", "peach", "pear"}}
+	fmt.Println(encode(res1D))
+
+	res2D := &response2{
+		Page:   1,
+		Fruits: []string{"apple", "peach", "pear"}}
+	fmt.Println(encode(res2D))
+
+	// Now let's look at decoding JSON data into Go
+	// values. Here's an example for a generic data
+	// structure.
+	byt := []byte(`{"num":6.13,"strs":["a","b"]}`)
+	dat, err := decodeGeneric(byt)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(dat)
+
+	// In order to use the values in the decoded map,
+	// we'll need to convert them to their appropriate type.
+	// For example here we convert the value in `num` to
+	// the expected `float64` type.
+	num, _ := dat["num"].(float64)
+	fmt.Println(num)
+
+	// Accessing nested data requires a series of
+	// conversions.
+	strs, _ := dat["strs"].([]interface{})
+	if len(strs) > 0 {
+		str1 := strs[0].(string)
+		fmt.Println(str1)
+	}
+
+	str := `{"page": 1, "fruits": ["apple", "peach"]}`
+	res, _ := decodeResponse(strings.NewReader(str))
+	fmt.Println(res)
+	if len(res.Fruits) > 0 {
+		fmt.Println(res.Fruits[0])
+	}
+
+	// In the examples above we always used bytes and
+	// strings as intermediates between the data and
+	// JSON representation on standard out. We can also
+	// stream JSON encodings directly to `io.Writer`s like
+	// `os.Stdout` or even HTTP response bodies.
+	enc := json.NewEncoder(os.Stdout)
+	d := map[string]int{"apple": 5, "lettuce": 7}
+	enc.Encode(d)
+
+	res1, _ := decodeResponse(strings.NewReader(str))
+	fmt.Println(res1)
+}
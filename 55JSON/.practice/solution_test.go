@@ -0,0 +1,61 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		in   any
+		want string
+	}{
+		{true, "true"},
+		{1, "1"},
+		{2.34, "2.34"},
+		{"gopher", `"gopher"`},
+		{[]string{"apple", "peach", "pear"}, `["apple","peach","pear"]`},
+		{map[string]int{"lettuce": 7, "apple": 5}, `{"apple":5,"lettuce":7}`},
+		{&response1{Page: 1, Fruits: []string{"apple"}}, `{"Page":1,"Fruits":["apple"]}`},
+		{&response2{Page: 1, Fruits: []string{"apple"}}, `{"page":1,"fruits":["apple"]}`},
+	}
+	for _, tt := range tests {
+		if got := encode(tt.in); got != tt.want {
+			t.Errorf("encode(%v): expected %s, got %s", tt.in, tt.want, got)
+		}
+	}
+}
+
+func TestDecodeGeneric(t *testing.T) {
+	dat, err := decodeGeneric([]byte(`{"num":6.13,"strs":["a","b"]}`))
+	if err != nil {
+		t.Fatalf("decodeGeneric failed: %v", err)
+	}
+	// JSON numbers decode to float64 and arrays to
+	// []interface{}.
+	if num, ok := dat["num"].(float64); !ok || num != 6.13 {
+		t.Errorf("Expected num 6.13, got %v", dat["num"])
+	}
+	strs, ok := dat["strs"].([]interface{})
+	if !ok || len(strs) != 2 || strs[0] != "a" {
+		t.Errorf("Expected strs [a b], got %v", dat["strs"])
+	}
+
+	if _, err := decodeGeneric([]byte(`{"num":`)); err == nil {
+		t.Error("Expected an error for invalid JSON, got nil")
+	}
+}
+
+func TestDecodeResponse(t *testing.T) {
+	res, err := decodeResponse(strings.NewReader(`{"page": 1, "fruits": ["apple", "peach"]}`))
+	if err != nil {
+		t.Fatalf("decodeResponse failed: %v", err)
+	}
+	if res.Page != 1 || len(res.Fruits) != 2 || res.Fruits[0] != "apple" {
+		t.Errorf("Expected {1 [apple peach]}, got %v", res)
+	}
+
+	if _, err := decodeResponse(strings.NewReader(`{"page": "one"}`)); err == nil {
+		t.Error("Expected an error for a mistyped field, got nil")
+	}
+}
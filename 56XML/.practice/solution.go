@@ -0,0 +1,84 @@
+// Go offers built-in support for XML and XML-like
+// formats with the `encoding/xml` package.
+
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// Plant will be mapped to XML. Similarly to the
+// JSON examples, field tags contain directives for the
+// encoder and decoder. Here we use some special features
+// of the XML package: the `XMLName` field name dictates
+// the name of the XML element representing this struct;
+// `id,attr` means that the `Id` field is an XML
+// _attribute_ rather than a nested element.
+type Plant struct {
+	XMLName xml.Name `xml:"plant"`
+	Id      int      `xml:"id,attr"`
+	Name    string   `xml:"name"`
+	Origin  []string `xml:"origin"`
+}
+
+func (p Plant) String() string {
+	return fmt.Sprintf("Plant id=%v, name=%v, origin=%v",
+		p.Id, p.Name, p.Origin)
+}
+
+// The `parent>child>plant` field tag tells the encoder
+// to nest all `plant`s under `<parent><child>...`
+type Nesting struct {
+	XMLName xml.Name `xml:"nesting"`
+	Plants  []*Plant `xml:"parent>child>plant"`
+}
+
+// Emit XML representing `v`; using `MarshalIndent`
+// to produce a more human-readable output.
+func marshal(v any) []byte {
+	out, err := xml.MarshalIndent(v, " ", "  ")
+	if err != nil {
+		panic(err)
+	}
+	return out
+}
+
+// Use `Unmarshal` to parse a stream of bytes with XML
+// into a data structure. If the XML is malformed or
+// cannot be mapped onto Plant, a descriptive error
+// will be returned.
+func parsePlant(data []byte) (Plant, error) {
+	var p Plant
+	if err := xml.Unmarshal(data, &p); err != nil {
+		return Plant{}, err
+	}
+	return p, nil
+}
+
+func main() {
+	coffee := &Plant{Id: 27, Name: "Coffee"}
+	coffee.Origin = []string{"Ethiopia", "Brazil"}
+
+	out := marshal(coffee)
+	fmt.Println(string(out))
+
+	// To add a generic XML header to the output, append
+	// it explicitly.
+	fmt.Println(xml.Header + string(out))
+
+	p, err := parsePlant(out)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(p)
+
+	tomato := &Plant{Id: 81, Name: "Tomato"}
+	tomato.Origin = []string{"Mexico", "California"}
+
+	nesting := &Nesting{}
+	nesting.Plants = []*Plant{coffee, tomato}
+
+	out = marshal(nesting)
+	fmt.Println(string(out))
+}
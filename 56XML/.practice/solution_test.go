@@ -0,0 +1,54 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestPlantString(t *testing.T) {
+	p := Plant{Id: 27, Name: "Coffee", Origin: []string{"Ethiopia", "Brazil"}}
+	if got, want := p.String(), "Plant id=27, name=Coffee, origin=[Ethiopia Brazil]"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestMarshalPlant(t *testing.T) {
+	coffee := &Plant{Id: 27, Name: "Coffee", Origin: []string{"Ethiopia", "Brazil"}}
+	want := ` <plant id="27">
+   <name>Coffee</name>
+   <origin>Ethiopia</origin>
+   <origin>Brazil</origin>
+ </plant>`
+	if got := string(marshal(coffee)); got != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestMarshalNesting(t *testing.T) {
+	nesting := &Nesting{Plants: []*Plant{{Id: 1, Name: "A"}, {Id: 2, Name: "B"}}}
+	got := string(marshal(nesting))
+	for _, want := range []string{"<nesting>", "<parent>", "<child>", `<plant id="1">`, `<plant id="2">`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected %s in:\n%s", want, got)
+		}
+	}
+	if strings.Index(got, "<child>") > strings.Index(got, "<plant") {
+		t.Errorf("Expected plants to be nested under <parent><child>:\n%s", got)
+	}
+}
+
+func TestParsePlant(t *testing.T) {
+	coffee := &Plant{Id: 27, Name: "Coffee", Origin: []string{"Ethiopia", "Brazil"}}
+	p, err := parsePlant(marshal(coffee))
+	if err != nil {
+		t.Fatalf("parsePlant failed: %v", err)
+	}
+	if p.Id != 27 || p.Name != "Coffee" || !slices.Equal(p.Origin, coffee.Origin) {
+		t.Errorf("Expected the round trip to preserve %v, got %v", *coffee, p)
+	}
+
+	if _, err := parsePlant([]byte("<plant>")); err == nil {
+		t.Error("Expected an error for malformed XML, got nil")
+	}
+}
@@ -15,53 +15,70 @@ import (
 // the name of the XML element representing this struct;
 // `id,attr` means that the `Id` field is an XML
 // _attribute_ rather than a nested element.
-
-// TODO: Create Plant struct with XMLName (xml.Name), Id (int), Name (string), and Origin ([]string) fields
-
-
-// TODO: Create String method for Plant struct that returns a string with the Id, Name, and Origin
-// TODO: Return a string with the Id, Name, and Origin
+type Plant struct {
+	// TODO: Add xml tags: XMLName as "plant", Id as the "id" attribute,
+	// Name as "name" and Origin as "origin"
+	XMLName xml.Name
+	Id      int
+	Name    string
+	Origin  []string
+}
+
+func (p Plant) String() string {
+	// TODO: Return a string with the Id, Name, and Origin
+	// formatted as "Plant id=%v, name=%v, origin=%v"
+	return ""
+}
+
+// The `parent>child>plant` field tag tells the encoder
+// to nest all `plant`s under `<parent><child>...`
+type Nesting struct {
+	// TODO: Add xml tags: XMLName as "nesting" and Plants as "parent>child>plant"
+	XMLName xml.Name
+	Plants  []*Plant
+}
+
+// Emit XML representing `v`; using `MarshalIndent`
+// to produce a more human-readable output.
+func marshal(v any) []byte {
+	// TODO: Create out, err := xml.MarshalIndent(v, " ", "  ")
+	// Panic if err is not nil, otherwise return out
+	return nil
+}
+
+// Use `Unmarshal` to parse a stream of bytes with XML
+// into a data structure. If the XML is malformed or
+// cannot be mapped onto Plant, a descriptive error
+// will be returned.
+func parsePlant(data []byte) (Plant, error) {
+	// TODO: Create var p Plant and decode data into it with xml.Unmarshal
+	// Return the error if decoding fails, otherwise return p
+	return Plant{}, nil
+}
 
 func main() {
+	coffee := &Plant{Id: 27, Name: "Coffee"}
+	coffee.Origin = []string{"Ethiopia", "Brazil"}
 
-	// TODO: Create coffee := &Plant{Id: 27, Name: "Coffee"}
-	// TODO: Create coffee.Origin = []string{"Ethiopia", "Brazil"}
-
-
-	// Emit XML representing our plant; using
-	// `MarshalIndent` to produce a more
-	// human-readable output.
-
-	// TODO: Create out, _ := xml.MarshalIndent(coffee, " ", "  ")
-	// TODO: Print string(out)
+	out := marshal(coffee)
+	fmt.Println(string(out))
 
 	// To add a generic XML header to the output, append
 	// it explicitly.
+	fmt.Println(xml.Header + string(out))
 
-	// TODO: Create fmt.Println(xml.Header + string(out))
-
-	// Use `Unmarshal` to parse a stream of bytes with XML
-	// into a data structure. If the XML is malformed or
-	// cannot be mapped onto Plant, a descriptive error
-	// will be returned.
-
-	// TODO: Create var p Plant
-	// TODO: Create if err := xml.Unmarshal(out, &p); err != nil {
-	// TODO: Print err
-
-	// TODO: Create tomato := &Plant{Id: 81, Name: "Tomato"}
-	// TODO: Create tomato.Origin = []string{"Mexico", "California"}
-
-
-	// The `parent>child>plant` field tag tells the encoder
-	// to nest all `plant`s under `<parent><child>...`
-
-	// TODO: Create Nesting struct with XMLName (xml.Name), Plants ([]*Plant) fields	
+	p, err := parsePlant(out)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(p)
 
+	tomato := &Plant{Id: 81, Name: "Tomato"}
+	tomato.Origin = []string{"Mexico", "California"}
 
-	// TODO: Create nesting := &Nesting{}
-	// TODO: Create nesting.Plants = []*Plant{coffee, tomato}
+	nesting := &Nesting{}
+	nesting.Plants = []*Plant{coffee, tomato}
 
-	// TODO: Create out, _ = xml.MarshalIndent(nesting, " ", "  ")
-	// TODO: Create fmt.Println(string(out))
-}
\ No newline at end of file
+	out = marshal(nesting)
+	fmt.Println(string(out))
+}
@@ -0,0 +1,89 @@
+// Go offers extensive support for times and durations;
+// here are some examples.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit"
+)
+
+// clock works like the time package: clock.Now is
+// time.Now. `practice check` replaces it with a
+// simulated clock, so "now" is the same on every run.
+var clock = practicekit.ClockFromEnv()
+
+// You can build a `time` struct by providing the
+// year, month, day, etc. Times are always associated
+// with a `Location`, i.e. time zone.
+func reference() time.Time {
+	return time.Date(
+		2009, 11, 17, 20, 34, 58, 651387237, time.UTC)
+}
+
+// These methods compare two times, testing if the
+// first occurs before, after, or at the same time
+// as the second, respectively.
+func compare(a, b time.Time) (before, after, equal bool) {
+	return a.Before(b), a.After(b), a.Equal(b)
+}
+
+// We can compute the length of the duration in
+// various units.
+func units(d time.Duration) (hours, minutes, seconds float64, nanos int64) {
+	return d.Hours(), d.Minutes(), d.Seconds(), d.Nanoseconds()
+}
+
+// You can use `Add` to advance a time by a given
+// duration, or with a `-` to move backwards by a
+// duration.
+func shift(t time.Time, d time.Duration) (forward, backward time.Time) {
+	return t.Add(d), t.Add(-d)
+}
+
+func main() {
+	p := fmt.Println
+
+	// We'll start by getting the current time.
+	now := clock.Now()
+	p(now)
+
+	then := reference()
+	p(then)
+
+	// You can extract the various components of the time
+	// value as expected.
+	p(then.Year())
+	p(then.Month())
+	p(then.Day())
+	p(then.Hour())
+	p(then.Minute())
+	p(then.Second())
+	p(then.Nanosecond())
+	p(then.Location())
+
+	// The Monday-Sunday `Weekday` is also available.
+	p(then.Weekday())
+
+	before, after, equal := compare(then, now)
+	p(before)
+	p(after)
+	p(equal)
+
+	// The `Sub` methods returns a `Duration` representing
+	// the interval between two times.
+	diff := now.Sub(then)
+	p(diff)
+
+	hours, minutes, seconds, nanos := units(diff)
+	p(hours)
+	p(minutes)
+	p(seconds)
+	p(nanos)
+
+	forward, backward := shift(then, diff)
+	p(forward)
+	p(backward)
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+func TestReference(t *testing.T) {
+	then := reference()
+	if then.Year() != 2009 || then.Month() != time.November || then.Day() != 17 {
+		t.Errorf("Expected 2009-11-17, got %v", then)
+	}
+	if then.Hour() != 20 || then.Minute() != 34 || then.Second() != 58 || then.Nanosecond() != 651387237 {
+		t.Errorf("Expected 20:34:58.651387237, got %v", then)
+	}
+	if then.Location() != time.UTC {
+		t.Errorf("Expected UTC, got %v", then.Location())
+	}
+	if then.Weekday() != time.Tuesday {
+		t.Errorf("Expected Tuesday, got %v", then.Weekday())
+	}
+}
+
+func TestCompare(t *testing.T) {
+	then := time.Date(2009, 11, 17, 20, 34, 58, 0, time.UTC)
+	later := then.Add(time.Hour)
+
+	tests := []struct {
+		a, b                 time.Time
+		before, after, equal bool
+	}{
+		{then, later, true, false, false},
+		{later, then, false, true, false},
+		{then, then, false, false, true},
+		// Equal compares instants, not locations.
+		{then, then.In(time.FixedZone("UTC+1", 3600)), false, false, true},
+	}
+	for _, tt := range tests {
+		before, after, equal := compare(tt.a, tt.b)
+		if before != tt.before || after != tt.after || equal != tt.equal {
+			t.Errorf("compare(%v, %v): expected %v %v %v, got %v %v %v",
+				tt.a, tt.b, tt.before, tt.after, tt.equal, before, after, equal)
+		}
+	}
+}
+
+func TestUnits(t *testing.T) {
+	hours, minutes, seconds, nanos := units(90 * time.Minute)
+	if hours != 1.5 || minutes != 90 || seconds != 5400 || nanos != 5400e9 {
+		t.Errorf("Expected 1.5 90 5400 5400000000000, got %v %v %v %v", hours, minutes, seconds, nanos)
+	}
+}
+
+func TestShift(t *testing.T) {
+	then := reference()
+	forward, backward := shift(then, 48*time.Hour)
+	if want := then.AddDate(0, 0, 2); !forward.Equal(want) {
+		t.Errorf("Expected %v, got %v", want, forward)
+	}
+	if want := then.AddDate(0, 0, -2); !backward.Equal(want) {
+		t.Errorf("Expected %v, got %v", want, backward)
+	}
+}
+
+func TestMainOutput(t *testing.T) {
+	practicetest.Simulate(t, &clock, 1)
+
+	lines := strings.Split(strings.TrimSpace(practicetest.Capture(t, main)), "\n")
+	if len(lines) != 21 {
+		t.Fatalf("Expected 21 lines, got %d: %q", len(lines), lines)
+	}
+	want := map[int]string{
+		0:  "2026-01-02 15:04:05 +0000 UTC",
+		14: "141354h29m6.348612763s",
+		18: "508876146348612763",
+		// Shifting then forward by now - then gives back now.
+		19: "2026-01-02 15:04:05 +0000 UTC",
+		20: "1993-10-03 02:05:52.302774474 +0000 UTC",
+	}
+	for i, w := range want {
+		if lines[i] != w {
+			t.Errorf("Line %d: expected %q, got %q", i+1, w, lines[i])
+		}
+	}
+}
@@ -6,64 +6,87 @@ package main
 import (
 	"fmt"
 	"time"
+
+	"github.com/orsenthil/practicego/practicekit"
 )
 
+// clock works like the time package: clock.Now is
+// time.Now. `practice check` replaces it with a
+// simulated clock, so "now" is the same on every run.
+var clock = practicekit.ClockFromEnv()
+
+// You can build a `time` struct by providing the
+// year, month, day, etc. Times are always associated
+// with a `Location`, i.e. time zone.
+func reference() time.Time {
+	// TODO: Return time.Date(2009, 11, 17, 20, 34, 58, 651387237, time.UTC)
+	return time.Time{}
+}
+
+// These methods compare two times, testing if the
+// first occurs before, after, or at the same time
+// as the second, respectively.
+func compare(a, b time.Time) (before, after, equal bool) {
+	// TODO: Return a.Before(b), a.After(b) and a.Equal(b)
+	return false, false, false
+}
+
+// We can compute the length of the duration in
+// various units.
+func units(d time.Duration) (hours, minutes, seconds float64, nanos int64) {
+	// TODO: Return d.Hours(), d.Minutes(), d.Seconds() and d.Nanoseconds()
+	return 0, 0, 0, 0
+}
+
+// You can use `Add` to advance a time by a given
+// duration, or with a `-` to move backwards by a
+// duration.
+func shift(t time.Time, d time.Duration) (forward, backward time.Time) {
+	// TODO: Return t.Add(d) and t.Add(-d)
+	return t, t
+}
+
 func main() {
 	p := fmt.Println
 
 	// We'll start by getting the current time.
-	now := time.Now()
+	now := clock.Now()
 	p(now)
 
-	// You can build a `time` struct by providing the
-	// year, month, day, etc. Times are always associated
-	// with a `Location`, i.e. time zone.
-
-	// TODO: Create then := time.Date(2009, 11, 17, 20, 34, 58, 651387237, time.UTC)
-	// TODO: Print then
+	then := reference()
+	p(then)
 
 	// You can extract the various components of the time
 	// value as expected.
-
-	// TODO: Print then.Year()
-	// TODO: Print then.Month()
-	// TODO: Print then.Day()
-	// TODO: Print then.Hour()
-	// TODO: Print then.Minute()
-	// TODO: Print then.Second()
-	// TODO: Print then.Nanosecond()
-	// TODO: Print then.Location()
+	p(then.Year())
+	p(then.Month())
+	p(then.Day())
+	p(then.Hour())
+	p(then.Minute())
+	p(then.Second())
+	p(then.Nanosecond())
+	p(then.Location())
 
 	// The Monday-Sunday `Weekday` is also available.
+	p(then.Weekday())
 
-	// TODO: Print then.Weekday()
-
-	// These methods compare two times, testing if the
-	// first occurs before, after, or at the same time
-	// as the second, respectively.
-
-	// TODO: Print then.Before(now)
-	// TODO: Print then.After(now)
-	// TODO: Print then.Equal(now)
+	before, after, equal := compare(then, now)
+	p(before)
+	p(after)
+	p(equal)
 
 	// The `Sub` methods returns a `Duration` representing
 	// the interval between two times.
-
-	// TODO: Create diff := now.Sub(then)
-	// TODO: Print diff
-
-	// We can compute the length of the duration in
-	// various units.
-
-	// TODO: Print diff.Hours()
-	// TODO: Print diff.Minutes()
-	// TODO: Print diff.Seconds()
-	// TODO: Print diff.Nanoseconds()
-
-	// You can use `Add` to advance a time by a given
-	// duration, or with a `-` to move backwards by a
-	// duration.
-
-	// TODO: Print then.Add(diff)
-	// TODO: Print then.Add(-diff)
-}
\ No newline at end of file
+	diff := now.Sub(then)
+	p(diff)
+
+	hours, minutes, seconds, nanos := units(diff)
+	p(hours)
+	p(minutes)
+	p(seconds)
+	p(nanos)
+
+	forward, backward := shift(then, diff)
+	p(forward)
+	p(backward)
+}
@@ -0,0 +1,44 @@
+// A common requirement in programs is getting the number
+// of seconds, milliseconds, or nanoseconds since the
+// [Unix epoch](https://en.wikipedia.org/wiki/Unix_time).
+// Here's how to do it in Go.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit"
+)
+
+// clock works like the time package: clock.Now is
+// time.Now. `practice check` replaces it with a
+// simulated clock, so "now" is the same on every run.
+var clock = practicekit.ClockFromEnv()
+
+// Use `Unix`, `UnixMilli` or `UnixNano` to get elapsed
+// time since the Unix epoch in seconds, milliseconds
+// or nanoseconds, respectively.
+func sinceEpoch(t time.Time) (sec, milli, nano int64) {
+	return t.Unix(), t.UnixMilli(), t.UnixNano()
+}
+
+// You can also convert integer seconds or nanoseconds
+// since the epoch into the corresponding `time`.
+func fromEpoch(sec, nsec int64) time.Time {
+	return time.Unix(sec, nsec)
+}
+
+func main() {
+	now := clock.Now()
+	fmt.Println(now)
+
+	sec, milli, nano := sinceEpoch(now)
+	fmt.Println(sec)
+	fmt.Println(milli)
+	fmt.Println(nano)
+
+	fmt.Println(fromEpoch(sec, 0))
+	fmt.Println(fromEpoch(0, nano))
+}
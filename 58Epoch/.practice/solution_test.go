@@ -0,0 +1,58 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+func TestSinceEpoch(t *testing.T) {
+	tm := time.Date(2026, 10, 16, 22, 33, 1, 906794307, time.UTC)
+	sec, milli, nano := sinceEpoch(tm)
+	if sec != 1792189981 {
+		t.Errorf("Expected 1792189981 seconds, got %d", sec)
+	}
+	if milli != 1792189981906 {
+		t.Errorf("Expected 1792189981906 milliseconds, got %d", milli)
+	}
+	if nano != 1792189981906794307 {
+		t.Errorf("Expected 1792189981906794307 nanoseconds, got %d", nano)
+	}
+
+	if sec, _, _ := sinceEpoch(time.Unix(0, 0)); sec != 0 {
+		t.Errorf("Expected 0 at the epoch, got %d", sec)
+	}
+}
+
+func TestFromEpoch(t *testing.T) {
+	tm := time.Date(2026, 10, 16, 22, 33, 1, 906794307, time.UTC)
+
+	// Whole seconds drop the fractional part.
+	if got, want := fromEpoch(tm.Unix(), 0), tm.Truncate(time.Second); !got.Equal(want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if got := fromEpoch(0, tm.UnixNano()); !got.Equal(tm) {
+		t.Errorf("Expected %v, got %v", tm, got)
+	}
+}
+
+func TestMainOutput(t *testing.T) {
+	practicetest.Simulate(t, &clock, 1)
+	local := time.Local
+	// fromEpoch returns local times; print them in UTC, as
+	// `practice check` does with TZ=UTC.
+	time.Local = time.UTC
+	defer func() { time.Local = local }()
+
+	got := practicetest.Capture(t, main)
+	want := "2026-01-02 15:04:05 +0000 UTC\n" +
+		"1767366245\n" +
+		"1767366245000\n" +
+		"1767366245000000000\n" +
+		"2026-01-02 15:04:05 +0000 UTC\n" +
+		"2026-01-02 15:04:05 +0000 UTC\n"
+	if got != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+}
@@ -8,23 +8,39 @@ package main
 import (
 	"fmt"
 	"time"
-)
-
-func main() {
 
-	// Use `time.Now` with `Unix`, `UnixMilli` or `UnixNano`
-	// to get elapsed time since the Unix epoch in seconds,
-	// milliseconds or nanoseconds, respectively.
+	"github.com/orsenthil/practicego/practicekit"
+)
 
-	// TODO: Create now := time.Now() and print it
+// clock works like the time package: clock.Now is
+// time.Now. `practice check` replaces it with a
+// simulated clock, so "now" is the same on every run.
+var clock = practicekit.ClockFromEnv()
+
+// Use `Unix`, `UnixMilli` or `UnixNano` to get elapsed
+// time since the Unix epoch in seconds, milliseconds
+// or nanoseconds, respectively.
+func sinceEpoch(t time.Time) (sec, milli, nano int64) {
+	// TODO: Return t.Unix(), t.UnixMilli() and t.UnixNano()
+	return 0, 0, 0
+}
+
+// You can also convert integer seconds or nanoseconds
+// since the epoch into the corresponding `time`.
+func fromEpoch(sec, nsec int64) time.Time {
+	// TODO: Return time.Unix(sec, nsec)
+	return time.Time{}
+}
 
-	// TODO: Print now.Unix()
-	// TODO: Print now.UnixMilli()
-	// TODO: Print now.UnixNano()
+func main() {
+	now := clock.Now()
+	fmt.Println(now)
 
-	// You can also convert integer seconds or nanoseconds
-	// since the epoch into the corresponding `time`.
+	sec, milli, nano := sinceEpoch(now)
+	fmt.Println(sec)
+	fmt.Println(milli)
+	fmt.Println(nano)
 
-	// TODO: Print time.Unix(now.Unix(), 0)
-	// TODO: Print time.Unix(0, now.UnixNano())
-}
\ No newline at end of file
+	fmt.Println(fromEpoch(sec, 0))
+	fmt.Println(fromEpoch(0, nano))
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKitchen(t *testing.T) {
+	tests := []struct {
+		t    time.Time
+		want string
+	}{
+		{time.Date(2026, 10, 16, 22, 33, 2, 0, time.UTC), "10:33PM"},
+		{time.Date(2026, 10, 16, 8, 5, 0, 0, time.UTC), "8:05AM"},
+		{time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), "12:00PM"},
+	}
+	for _, tt := range tests {
+		if got := kitchen(tt.t); got != tt.want {
+			t.Errorf("kitchen(%v): expected %q, got %q", tt.t, tt.want, got)
+		}
+	}
+}
+
+func TestParseClock(t *testing.T) {
+	got, err := parseClock("8 41 PM")
+	if err != nil {
+		t.Fatalf("parseClock failed: %v", err)
+	}
+	// Fields missing from the layout default to zero, so
+	// the date is January 1 of year 0.
+	want := time.Date(0, 1, 1, 20, 41, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	if _, err := parseClock("8:41PM"); err == nil {
+		t.Error("Expected an error for input that doesn't match the layout, got nil")
+	}
+}
+
+func TestNumeric(t *testing.T) {
+	tm := time.Date(2026, 3, 7, 9, 5, 2, 0, time.UTC)
+	if got, want := numeric(tm), "2026-03-07T09:05:02-00:00"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
@@ -8,51 +8,64 @@ import (
 	"time"
 )
 
+// `Format` and `Parse` use example-based layouts. Usually
+// you'll use a constant from `time` for these layouts, but
+// you can also supply custom layouts. Layouts must use the
+// reference time `Mon Jan 2 15:04:05 MST 2006` to show the
+// pattern with which to format/parse a given time/string.
+// The example time must be exactly as shown: the year 2006,
+// 15 for the hour, Monday for the day of the week, etc.
+const clockLayout = "3 04 PM"
+
+// `kitchen` formats `t` as a 12-hour clock time like `8:41PM`.
+func kitchen(t time.Time) string {
+	// TODO: Return t formatted with the layout "3:04PM"
+	return ""
+}
+
+// Time parsing uses the same layout values as `Format`.
+// `parseClock` parses a time written in `clockLayout`.
+func parseClock(value string) (time.Time, error) {
+	// TODO: Return the result of time.Parse with clockLayout
+	return time.Time{}, nil
+}
+
+// For purely numeric representations you can also
+// use standard string formatting with the extracted
+// components of the time value.
+func numeric(t time.Time) string {
+	// TODO: Return fmt.Sprintf("%d-%02d-%02dT%02d:%02d:%02d-00:00")
+	// with t.Year(), t.Month(), t.Day(),
+	// t.Hour(), t.Minute(), t.Second()
+	return ""
+}
+
 func main() {
 	p := fmt.Println
 
 	// Here's a basic example of formatting a time
 	// according to RFC3339, using the corresponding layout
 	// constant.
+	t := time.Now()
+	p(t.Format(time.RFC3339))
 
-	// TODO: Create t := time.Now()
-	// TODO: Print t.Format(time.RFC3339)
-	
-
-	// Time parsing uses the same layout values as `Format`.
+	t1, e := time.Parse(
+		time.RFC3339,
+		"2012-11-01T22:08:41+00:00")
+	p(t1)
 
-	// TODO: Create t1, e := time.Parse(time.RFC3339, "2012-11-01T22:08:41+00:00")
-	// TODO: Print t1
+	p(kitchen(t))
+	p(t.Format("Mon Jan _2 15:04:05 2006"))
+	p(t.Format("2006-01-02T15:04:05.999999-07:00"))
 
-	// `Format` and `Parse` use example-based layouts. Usually
-	// you'll use a constant from `time` for these layouts, but
-	// you can also supply custom layouts. Layouts must use the
-	// reference time `Mon Jan 2 15:04:05 MST 2006` to show the
-	// pattern with which to format/parse a given time/string.
-	// The example time must be exactly as shown: the year 2006,
-	// 15 for the hour, Monday for the day of the week, etc.
+	t2, e := parseClock("8 41 PM")
+	p(t2)
 
-	// TODO: Print t.Format("3:04PM")
-	// TODO: Print t.Format("Mon Jan _2 15:04:05 2006")
-	// TODO: Print t.Format("2006-01-02T15:04:05.999999-07:00")
-	
-
-	// TODO: Create form := "3 04 PM"
-	// TODO: Create t2, e := time.Parse(form, "8 41 PM")
-	// TODO: Print t2
-
-	// For purely numeric representations you can also
-	// use standard string formatting with the extracted
-	// components of the time value.
-
-	// TODO: Printf("%d-%02d-%02dT%02d:%02d:%02d-00:00")
-	// TODO: with t.Year(), t.Month(), t.Day(),
-	// t.Hour(), t.Minute(), t.Second()
+	fmt.Println(numeric(t))
 
 	// `Parse` will return an error on malformed input
 	// explaining the parsing problem.
-
-	// TODO: Create ansic := "Mon Jan _2 15:04:05 2006"
-	// TODO: Create _, e = time.Parse(ansic, "8:41PM")
-	// TODO: Print e
-}
\ No newline at end of file
+	ansic := "Mon Jan _2 15:04:05 2006"
+	_, e = time.Parse(ansic, "8:41PM")
+	p(e)
+}
@@ -0,0 +1,64 @@
+// Go's `math/rand/v2` package provides
+// [pseudorandom number](https://en.wikipedia.org/wiki/Pseudorandom_number_generator)
+// generation.
+
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/orsenthil/practicego/practicekit"
+)
+
+// rng is a `*rand.Rand` with the same methods as the
+// top-level functions of `math/rand/v2`. It is randomly
+// seeded, except under `practice check`, which seeds it
+// so that the output is the same on every run.
+var rng = practicekit.RandFromEnv()
+
+// `rng.Float64` returns a `float64` `f`,
+// `0.0 <= f < 1.0`. This can be used to generate
+// random floats in other ranges; `scale` maps `f` to
+// `lo <= f' < hi`.
+func scale(f, lo, hi float64) float64 {
+	return (f * (hi - lo)) + lo
+}
+
+// If you want a known seed, create a new
+// `rand.Source` and pass it into the `New`
+// constructor. `NewPCG` creates a new
+// [PCG](https://en.wikipedia.org/wiki/Permuted_congruential_generator)
+// source that requires a seed of two `uint64`
+// numbers.
+func seeded(seed1, seed2 uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed1, seed2))
+}
+
+func main() {
+
+	// For example, `rng.IntN` returns a random `int` n,
+	// `0 <= n < 100`.
+	fmt.Print(rng.IntN(100), ",")
+	fmt.Print(rng.IntN(100))
+	fmt.Println()
+
+	fmt.Println(rng.Float64())
+
+	// For example `5.0 <= f' < 10.0`.
+	fmt.Print(scale(rng.Float64(), 5, 10), ",")
+	fmt.Print(scale(rng.Float64(), 5, 10))
+	fmt.Println()
+
+	r2 := seeded(42, 1024)
+	fmt.Print(r2.IntN(100), ",")
+	fmt.Print(r2.IntN(100))
+	fmt.Println()
+
+	// Sources created with the same seed produce the
+	// same sequence of numbers.
+	r3 := seeded(42, 1024)
+	fmt.Print(r3.IntN(100), ",")
+	fmt.Print(r3.IntN(100))
+	fmt.Println()
+}
@@ -7,41 +7,60 @@ package main
 import (
 	"fmt"
 	"math/rand/v2"
-)
 
-func main() {
+	"github.com/orsenthil/practicego/practicekit"
+)
 
-	// For example, `rand.IntN` returns a random `int` n,
-	// `0 <= n < 100`.
+// rng is a `*rand.Rand` with the same methods as the
+// top-level functions of `math/rand/v2`. It is randomly
+// seeded, except under `practice check`, which seeds it
+// so that the output is the same on every run.
+var rng = practicekit.RandFromEnv()
 
-	// TODO: Print rand.IntN(100), ","
-	// TODO: Print rand.IntN(100)
+// `rng.Float64` returns a `float64` `f`,
+// `0.0 <= f < 1.0`. This can be used to generate
+// random floats in other ranges; `scale` maps `f` to
+// `lo <= f' < hi`.
+func scale(f, lo, hi float64) float64 {
+	// TODO: Return f scaled from [0.0, 1.0) to [lo, hi)
+	return 0
+}
 
-	// `rand.Float64` returns a `float64` `f`,
-	// `0.0 <= f < 1.0`.
+// If you want a known seed, create a new
+// `rand.Source` and pass it into the `New`
+// constructor. `NewPCG` creates a new
+// [PCG](https://en.wikipedia.org/wiki/Permuted_congruential_generator)
+// source that requires a seed of two `uint64`
+// numbers.
+func seeded(seed1, seed2 uint64) *rand.Rand {
+	// TODO: Return rand.New(rand.NewPCG(seed1, seed2))
+	return rand.New(rand.NewPCG(0, 0))
+}
 
-	// TODO: Print rand.Float64()
+func main() {
 
-	// This can be used to generate random floats in
-	// other ranges, for example `5.0 <= f' < 10.0`.
+	// For example, `rng.IntN` returns a random `int` n,
+	// `0 <= n < 100`.
+	fmt.Print(rng.IntN(100), ",")
+	fmt.Print(rng.IntN(100))
+	fmt.Println()
 
-	// TODO: Print (rand.Float64()*5)+5, ","
-	// TODO: Print (rand.Float64() * 5) + 5
+	fmt.Println(rng.Float64())
 
-	// If you want a known seed, create a new
-	// `rand.Source` and pass it into the `New`
-	// constructor. `NewPCG` creates a new
-	// [PCG](https://en.wikipedia.org/wiki/Permuted_congruential_generator)
-	// source that requires a seed of two `uint64`
-	// numbers.
+	// For example `5.0 <= f' < 10.0`.
+	fmt.Print(scale(rng.Float64(), 5, 10), ",")
+	fmt.Print(scale(rng.Float64(), 5, 10))
+	fmt.Println()
 
-	// TODO: Create s2 := rand.NewPCG(42, 1024)
-	// TODO: Create r2 := rand.New(s2)
-	// TODO: Print r2.IntN(100), ","
-	// TODO: Print r2.IntN(100)
+	r2 := seeded(42, 1024)
+	fmt.Print(r2.IntN(100), ",")
+	fmt.Print(r2.IntN(100))
+	fmt.Println()
 
-	// TODO: Create s3 := rand.NewPCG(42, 1024)
-	// TODO: Create r3 := rand.New(s3)
-	// TODO: Print r3.IntN(100), ","
-	// TODO: Print r3.IntN(100)
-}
\ No newline at end of file
+	// Sources created with the same seed produce the
+	// same sequence of numbers.
+	r3 := seeded(42, 1024)
+	fmt.Print(r3.IntN(100), ",")
+	fmt.Print(r3.IntN(100))
+	fmt.Println()
+}
@@ -0,0 +1,56 @@
+// Parsing numbers from strings is a basic but common task
+// in many programs; here's how to do it in Go.
+
+package main
+
+// The built-in package `strconv` provides the number
+// parsing.
+import (
+	"fmt"
+	"strconv"
+)
+
+// With `ParseFloat`, this `64` tells how many bits of
+// precision to parse.
+func parseFloat(s string) (float64, error) {
+	return strconv.ParseFloat(s, 64)
+}
+
+// For `ParseInt`, the `0` means infer the base from
+// the string. `64` requires that the result fit in 64
+// bits. `ParseInt` will recognize hex-formatted numbers.
+func parseInt(s string) (int64, error) {
+	return strconv.ParseInt(s, 0, 64)
+}
+
+// A `ParseUint` is also available.
+func parseUint(s string) (uint64, error) {
+	return strconv.ParseUint(s, 0, 64)
+}
+
+// `Atoi` is a convenience function for basic base-10
+// `int` parsing.
+func atoi(s string) (int, error) {
+	return strconv.Atoi(s)
+}
+
+func main() {
+	f, _ := parseFloat("1.234")
+	fmt.Println(f)
+
+	i, _ := parseInt("123")
+	fmt.Println(i)
+
+	d, _ := parseInt("0x1c8")
+	fmt.Println(d)
+
+	u, _ := parseUint("789")
+	fmt.Println(u)
+
+	k, _ := atoi("135")
+	fmt.Println(k)
+
+	// Parse functions return an error on bad input.
+	_, e := atoi("wat")
+	fmt.Println(e)
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestParseFloat(t *testing.T) {
+	f, err := parseFloat("1.234")
+	if err != nil || f != 1.234 {
+		t.Errorf("Expected 1.234, <nil>, got %v, %v", f, err)
+	}
+}
+
+func TestParseInt(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int64
+	}{
+		{"123", 123},
+		{"0x1c8", 456},
+		{"-42", -42},
+		{"0b101", 5},
+	}
+	for _, tt := range tests {
+		got, err := parseInt(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("parseInt(%q): expected %d, <nil>, got %d, %v", tt.in, tt.want, got, err)
+		}
+	}
+}
+
+func TestParseUint(t *testing.T) {
+	u, err := parseUint("789")
+	if err != nil || u != 789 {
+		t.Errorf("Expected 789, <nil>, got %v, %v", u, err)
+	}
+	if _, err := parseUint("-1"); err == nil {
+		t.Error("Expected an error for a negative number, got nil")
+	}
+}
+
+func TestAtoi(t *testing.T) {
+	k, err := atoi("135")
+	if err != nil || k != 135 {
+		t.Errorf("Expected 135, <nil>, got %v, %v", k, err)
+	}
+
+	_, err = atoi("wat")
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Expected a syntax error, got %v", err)
+	}
+}
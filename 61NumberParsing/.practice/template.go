@@ -5,44 +5,53 @@ package main
 
 // The built-in package `strconv` provides the number
 // parsing.
-import (
-	"fmt"
-	"strconv"
-)
+import "fmt"
+
+// With `ParseFloat`, this `64` tells how many bits of
+// precision to parse.
+func parseFloat(s string) (float64, error) {
+	// TODO: Return strconv.ParseFloat(s, 64)
+	return 0, nil
+}
+
+// For `ParseInt`, the `0` means infer the base from
+// the string. `64` requires that the result fit in 64
+// bits. `ParseInt` will recognize hex-formatted numbers.
+func parseInt(s string) (int64, error) {
+	// TODO: Return strconv.ParseInt(s, 0, 64)
+	return 0, nil
+}
+
+// A `ParseUint` is also available.
+func parseUint(s string) (uint64, error) {
+	// TODO: Return strconv.ParseUint(s, 0, 64)
+	return 0, nil
+}
+
+// `Atoi` is a convenience function for basic base-10
+// `int` parsing.
+func atoi(s string) (int, error) {
+	// TODO: Return strconv.Atoi(s)
+	return 0, nil
+}
 
 func main() {
+	f, _ := parseFloat("1.234")
+	fmt.Println(f)
 
-	// With `ParseFloat`, this `64` tells how many bits of
-	// precision to parse.
+	i, _ := parseInt("123")
+	fmt.Println(i)
 
-	// TODO: Create f, _ := strconv.ParseFloat("1.234", 64)
-	// TODO: Print f
+	d, _ := parseInt("0x1c8")
+	fmt.Println(d)
 
-	// For `ParseInt`, the `0` means infer the base from
-	// the string. `64` requires that the result fit in 64
-	// bits.
+	u, _ := parseUint("789")
+	fmt.Println(u)
 
-	// TODO: Create i, _ := strconv.ParseInt("123", 0, 64)
-	// TODO: Print i
-
-	// `ParseInt` will recognize hex-formatted numbers.
-
-	// TODO: Create d, _ := strconv.ParseInt("0x1c8", 0, 64)
-	// TODO: Print d
-
-	// A `ParseUint` is also available.
-
-	// TODO: Create u, _ := strconv.ParseUint("789", 0, 64)
-	// TODO: Print u
-
-	// `Atoi` is a convenience function for basic base-10
-	// `int` parsing.
-
-	// TODO: Create k, _ := strconv.Atoi("135")
-	// TODO: Print k
+	k, _ := atoi("135")
+	fmt.Println(k)
 
 	// Parse functions return an error on bad input.
-
-	// TODO: Create _, e := strconv.Atoi("wat")
-	// TODO: Print e
-}
\ No newline at end of file
+	_, e := atoi("wat")
+	fmt.Println(e)
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestHash(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"sha256 this string", "1af1dfa857bf1d8814fe1af8983c18080019922e557f15a8a0d3db739d77aacb"},
+		{"", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+	}
+	for _, tt := range tests {
+		if got := hex.EncodeToString(hash(tt.in)); got != tt.want {
+			t.Errorf("hash(%q): expected %s, got %s", tt.in, tt.want, got)
+		}
+	}
+}
+
+func TestHashLength(t *testing.T) {
+	// SHA256 always produces 32 bytes.
+	if got := len(hash("any input at all")); got != 32 {
+		t.Errorf("Expected 32 bytes, got %d", got)
+	}
+}
@@ -8,15 +8,12 @@ package main
 
 // Go implements several hash functions in various
 // `crypto/*` packages.
-import (
-	"crypto/sha256"
-	"fmt"
-)
+import "fmt"
 
-func main() {
-	// TODO: Create s := "sha256 this string"
+func hash(s string) []byte {
 
 	// Here we start with a new hash.
+
 	// TODO: Create h := sha256.New()
 
 	// `Write` expects bytes. If you have a string `s`,
@@ -28,9 +25,14 @@ func main() {
 	// slice. The argument to `Sum` can be used to append
 	// to an existing byte slice: it usually isn't needed.
 
-	// TODO: Create bs := h.Sum(nil)
+	// TODO: Return h.Sum(nil)
+	return nil
+}
+
+func main() {
+	s := "sha256 this string"
+	bs := hash(s)
 
-	// TODO: Print s
-	// TODO: Print fmt.Printf("%x
-", bs)
-}
\ No newline at end of file
+	fmt.Println(s)
+	fmt.Printf("%x\n", bs)
+}
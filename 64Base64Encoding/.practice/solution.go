@@ -0,0 +1,53 @@
+// Go provides built-in support for [base64
+// encoding/decoding](https://en.wikipedia.org/wiki/Base64).
+
+package main
+
+// This syntax imports the `encoding/base64` package with
+// the `b64` name instead of the default `base64`. It'll
+// save us some space below.
+import (
+	b64 "encoding/base64"
+	"fmt"
+)
+
+// Go supports both standard and URL-compatible
+// base64, each represented by an `Encoding`. The
+// encoder requires a `[]byte` so we convert our
+// `string` to that type.
+func encode(enc *b64.Encoding, data string) string {
+	return enc.EncodeToString([]byte(data))
+}
+
+// Decoding may return an error, which you can check
+// if you don't already know the input to be
+// well-formed.
+func decode(enc *b64.Encoding, s string) (string, error) {
+	b, err := enc.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func main() {
+
+	// Here's the `string` we'll encode/decode.
+	data := "abc123!?$*&()'-=@~"
+
+	// Here's how to encode using the standard
+	// encoder.
+	sEnc := encode(b64.StdEncoding, data)
+	fmt.Println(sEnc)
+
+	sDec, _ := decode(b64.StdEncoding, sEnc)
+	fmt.Println(sDec)
+	fmt.Println()
+
+	// This encodes/decodes using a URL-compatible base64
+	// format.
+	uEnc := encode(b64.URLEncoding, data)
+	fmt.Println(uEnc)
+	uDec, _ := decode(b64.URLEncoding, uEnc)
+	fmt.Println(uDec)
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	b64 "encoding/base64"
+	"testing"
+)
+
+const data = "abc123!?$*&()'-=@~"
+
+func TestEncode(t *testing.T) {
+	// The encodings differ only in the characters used
+	// for 62 and 63: standard uses + and /, URL uses - and _.
+	if got, want := encode(b64.StdEncoding, data), "YWJjMTIzIT8kKiYoKSctPUB+"; got != want {
+		t.Errorf("Standard: expected %s, got %s", want, got)
+	}
+	if got, want := encode(b64.URLEncoding, data), "YWJjMTIzIT8kKiYoKSctPUB-"; got != want {
+		t.Errorf("URL: expected %s, got %s", want, got)
+	}
+}
+
+func TestDecodeRoundTrip(t *testing.T) {
+	for _, enc := range []*b64.Encoding{b64.StdEncoding, b64.URLEncoding} {
+		got, err := decode(enc, encode(enc, data))
+		if err != nil {
+			t.Fatalf("decode failed: %v", err)
+		}
+		if got != data {
+			t.Errorf("Expected %q, got %q", data, got)
+		}
+	}
+}
+
+func TestDecodeMalformed(t *testing.T) {
+	// "+" is not part of the URL-compatible alphabet.
+	if _, err := decode(b64.URLEncoding, "YWJjMTIzIT8kKiYoKSctPUB+"); err == nil {
+		t.Error("Expected an error for malformed input, got nil")
+	}
+}
@@ -11,33 +11,42 @@ import (
 	"fmt"
 )
 
+// Go supports both standard and URL-compatible
+// base64, each represented by an `Encoding`. The
+// encoder requires a `[]byte` so we convert our
+// `string` to that type.
+func encode(enc *b64.Encoding, data string) string {
+	// TODO: Return enc.EncodeToString([]byte(data))
+	return ""
+}
+
+// Decoding may return an error, which you can check
+// if you don't already know the input to be
+// well-formed.
+func decode(enc *b64.Encoding, s string) (string, error) {
+	// TODO: Decode s with enc.DecodeString and return the result as a string,
+	// or return the error if decoding fails
+	return "", nil
+}
+
 func main() {
 
 	// Here's the `string` we'll encode/decode.
-	// TODO: Create data := "abc123!?$*&()'-=@~"
-
-	// Go supports both standard and URL-compatible
-	// base64. Here's how to encode using the standard
-	// encoder. The encoder requires a `[]byte` so we
-	// convert our `string` to that type.
+	data := "abc123!?$*&()'-=@~"
 
-	// TODO: Create sEnc := b64.StdEncoding.EncodeToString([]byte(data))
-	// TODO: Print sEnc
+	// Here's how to encode using the standard
+	// encoder.
+	sEnc := encode(b64.StdEncoding, data)
+	fmt.Println(sEnc)
 
-	// Decoding may return an error, which you can check
-	// if you don't already know the input to be
-	// well-formed.
-
-	// TODO: Create sDec, _ := b64.StdEncoding.DecodeString(sEnc)
-	// TODO: Print sDec
-	// TODO: Print fmt.Println()
+	sDec, _ := decode(b64.StdEncoding, sEnc)
+	fmt.Println(sDec)
+	fmt.Println()
 
 	// This encodes/decodes using a URL-compatible base64
 	// format.
-
-	// TODO: Create uEnc := b64.URLEncoding.EncodeToString([]byte(data))
-	// TODO: Print uEnc
-	// TODO: Create uDec, _ := b64.URLEncoding.DecodeString(uEnc)
-	// TODO: Print uDec
-	// TODO: Print fmt.Println()
-}
\ No newline at end of file
+	uEnc := encode(b64.URLEncoding, data)
+	fmt.Println(uEnc)
+	uDec, _ := decode(b64.URLEncoding, uEnc)
+	fmt.Println(uDec)
+}
@@ -0,0 +1,103 @@
+// Reading and writing files are basic tasks needed for
+// many Go programs. First we'll look at some examples of
+// reading files.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Reading files requires checking most calls for errors.
+// This helper will streamline our error checks below.
+func check(e error) {
+	if e != nil {
+		panic(e)
+	}
+}
+
+// Read some bytes from the current position of the file.
+// Allow up to `n` to be read but also note how many
+// actually were read.
+func readUpTo(f *os.File, n int) (string, error) {
+	b := make([]byte, n)
+	read, err := f.Read(b)
+	return string(b[:read]), err
+}
+
+// You can also `Seek` to a known location in the file
+// and read from there. The `io` package provides some
+// functions that may be helpful for file reading. For
+// example, reads like the ones above can be more
+// robustly implemented with `ReadAtLeast`.
+func readAt(f *os.File, offset int64, n int) (string, error) {
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return "", err
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadAtLeast(f, b, n); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// The `bufio` package implements a buffered
+// reader that may be useful both for its efficiency
+// with many small reads and because of the additional
+// reading methods it provides. There is no built-in
+// rewind, but `Seek(0, io.SeekStart)` accomplishes this.
+func peekStart(f *os.File, n int) (string, error) {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	b, err := bufio.NewReader(f).Peek(n)
+	return string(b), err
+}
+
+func main() {
+
+	// Perhaps the most basic file reading task is
+	// slurping a file's entire contents into memory.
+	dat, err := os.ReadFile("/tmp/dat")
+	check(err)
+	fmt.Print(string(dat))
+
+	// You'll often want more control over how and what
+	// parts of a file are read. For these tasks, start
+	// by `Open`ing a file to obtain an `os.File` value.
+	f, err := os.Open("/tmp/dat")
+	check(err)
+
+	// Close the file when you're done (usually this would
+	// be scheduled immediately after `Open`ing with
+	// `defer`).
+	defer f.Close()
+
+	s1, err := readUpTo(f, 5)
+	check(err)
+	fmt.Printf("%d bytes: %s\n", len(s1), s1)
+
+	s2, err := readAt(f, 6, 2)
+	check(err)
+	fmt.Printf("%d bytes @ %d: %s\n", len(s2), 6, s2)
+
+	// Other methods of seeking are relative to the
+	// current cursor position,
+	_, err = f.Seek(2, io.SeekCurrent)
+	check(err)
+
+	// and relative to the end of the file.
+	_, err = f.Seek(-4, io.SeekEnd)
+	check(err)
+
+	s3, err := readAt(f, 6, 2)
+	check(err)
+	fmt.Printf("%d bytes @ %d: %s\n", len(s3), 6, s3)
+
+	s4, err := peekStart(f, 5)
+	check(err)
+	fmt.Printf("5 bytes: %s\n", s4)
+}
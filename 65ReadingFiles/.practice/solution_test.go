@@ -0,0 +1,76 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// openDat creates a file holding "hello\ngo\n" and opens it.
+func openDat(t *testing.T) *os.File {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "dat")
+	if err := os.WriteFile(path, []byte("hello\ngo\n"), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	t.Cleanup(func() { f.Close() })
+	return f
+}
+
+func TestCheck(t *testing.T) {
+	check(nil)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected check to panic on a non-nil error")
+		}
+	}()
+	check(os.ErrNotExist)
+}
+
+func TestReadUpTo(t *testing.T) {
+	f := openDat(t)
+	got, err := readUpTo(f, 5)
+	if err != nil || got != "hello" {
+		t.Errorf("Expected %q, <nil>, got %q, %v", "hello", got, err)
+	}
+
+	// Fewer bytes than requested are left in the file.
+	got, err = readUpTo(f, 100)
+	if err != nil || got != "\ngo\n" {
+		t.Errorf("Expected %q, <nil>, got %q, %v", "\ngo\n", got, err)
+	}
+}
+
+func TestReadAt(t *testing.T) {
+	f := openDat(t)
+
+	// The offset is absolute, wherever the cursor is.
+	if _, err := f.Seek(-4, 2); err != nil {
+		t.Fatalf("Seek failed: %v", err)
+	}
+	got, err := readAt(f, 6, 2)
+	if err != nil || got != "go" {
+		t.Errorf("Expected %q, <nil>, got %q, %v", "go", got, err)
+	}
+
+	// Asking for more bytes than remain is an error.
+	if _, err := readAt(f, 6, 10); err == nil {
+		t.Error("Expected an error reading past the end, got nil")
+	}
+}
+
+func TestPeekStart(t *testing.T) {
+	f := openDat(t)
+	if _, err := readUpTo(f, 8); err != nil {
+		t.Fatalf("readUpTo failed: %v", err)
+	}
+	got, err := peekStart(f, 5)
+	if err != nil || got != "hello" {
+		t.Errorf("Expected %q, <nil>, got %q, %v", "hello", got, err)
+	}
+}
@@ -5,7 +5,6 @@
 package main
 
 import (
-	"bufio"
 	"fmt"
 	"io"
 	"os"
@@ -13,92 +12,83 @@ import (
 
 // Reading files requires checking most calls for errors.
 // This helper will streamline our error checks below.
-
-// TODO: Create function check(e error) that checks if e is not nil, panic with e
-
+func check(e error) {
+	// TODO: Check if e is not nil, panic with e
+}
+
+// Read some bytes from the current position of the file.
+// Allow up to `n` to be read but also note how many
+// actually were read.
+func readUpTo(f *os.File, n int) (string, error) {
+	// TODO: Create b := make([]byte, n) and read into it with f.Read(b)
+	// Return the bytes actually read as a string and the error
+	return "", nil
+}
+
+// You can also `Seek` to a known location in the file
+// and read from there. The `io` package provides some
+// functions that may be helpful for file reading. For
+// example, reads like the ones above can be more
+// robustly implemented with `ReadAtLeast`.
+func readAt(f *os.File, offset int64, n int) (string, error) {
+	// TODO: Seek to offset with f.Seek(offset, io.SeekStart)
+	// TODO: Create b := make([]byte, n) and fill it with io.ReadAtLeast(f, b, n)
+	// Return b as a string, or the first error
+	return "", nil
+}
+
+// The `bufio` package implements a buffered
+// reader that may be useful both for its efficiency
+// with many small reads and because of the additional
+// reading methods it provides. There is no built-in
+// rewind, but `Seek(0, io.SeekStart)` accomplishes this.
+func peekStart(f *os.File, n int) (string, error) {
+	// TODO: Rewind f with f.Seek(0, io.SeekStart)
+	// TODO: Create a reader with bufio.NewReader(f) and return the result of Peek(n) as a string
+	return "", nil
+}
 
 func main() {
 
 	// Perhaps the most basic file reading task is
 	// slurping a file's entire contents into memory.
-
-	// TODO: Create dat, err := os.ReadFile("/tmp/dat")
-	// TODO: Print err
-	// TODO: Print fmt.Print(string(dat))
+	dat, err := os.ReadFile("/tmp/dat")
+	check(err)
+	fmt.Print(string(dat))
 
 	// You'll often want more control over how and what
 	// parts of a file are read. For these tasks, start
 	// by `Open`ing a file to obtain an `os.File` value.
+	f, err := os.Open("/tmp/dat")
+	check(err)
 
-	// TODO: Create f, err := os.Open("/tmp/dat")
-	// TODO: Print err
-
-	// Read some bytes from the beginning of the file.
-	// Allow up to 5 to be read but also note how many
-	// actually were read.
-
-	// TODO: Create b1 := make([]byte, 5)
-	// TODO: Create n1, err := f.Read(b1)
-	// TODO: Print err
-	// TODO: Print fmt.Printf("%d bytes: %s
-", n1, string(b1[:n1]))
+	// Close the file when you're done (usually this would
+	// be scheduled immediately after `Open`ing with
+	// `defer`).
+	defer f.Close()
 
-	// You can also `Seek` to a known location in the file
-	// and `Read` from there.
+	s1, err := readUpTo(f, 5)
+	check(err)
+	fmt.Printf("%d bytes: %s\n", len(s1), s1)
 
-	// TODO: Create o2, err := f.Seek(6, io.SeekStart)
-	// TODO: Print err
-	// TODO: Create b2 := make([]byte, 2)
-	// TODO: Create n2, err := f.Read(b2)
-	// TODO: Print err
-	// TODO: Print fmt.Printf("%d bytes @ %d: ", n2, o2)
-	// TODO: Print fmt.Printf("%v
-", string(b2[:n2]))
+	s2, err := readAt(f, 6, 2)
+	check(err)
+	fmt.Printf("%d bytes @ %d: %s\n", len(s2), 6, s2)
 
 	// Other methods of seeking are relative to the
 	// current cursor position,
-
-	// TODO: Create _, err = f.Seek(2, io.SeekCurrent)
-	// TODO: Print err
+	_, err = f.Seek(2, io.SeekCurrent)
+	check(err)
 
 	// and relative to the end of the file.
+	_, err = f.Seek(-4, io.SeekEnd)
+	check(err)
 
-	// TODO: Create _, err = f.Seek(-4, io.SeekEnd)
-	// TODO: Print err
-
-	// The `io` package provides some functions that may
-	// be helpful for file reading. For example, reads
-	// like the ones above can be more robustly
-	// implemented with `ReadAtLeast`.
-
-	// TODO: Create o3, err := f.Seek(6, io.SeekStart)
-	// TODO: Print err
-	// TODO: Create b3 := make([]byte, 2)
-	// TODO: Create n3, err := io.ReadAtLeast(f, b3, 2)
-	// TODO: Print err
-	// TODO: Print fmt.Printf("%d bytes @ %d: %s
-", n3, o3, string(b3))
-
-	// There is no built-in rewind, but
-	// `Seek(0, io.SeekStart)` accomplishes this.
-
-	// TODO: Create _, err = f.Seek(0, io.SeekStart)
-	// TODO: Print err
-
-	// The `bufio` package implements a buffered
-	// reader that may be useful both for its efficiency
-	// with many small reads and because of the additional
-	// reading methods it provides.
-
-	// TODO: Create r4 := bufio.NewReader(f)
-	// TODO: Create b4, err := r4.Peek(5)
-	// TODO: Print err
-	// TODO: Print fmt.Printf("5 bytes: %s
-", string(b4))
-
-	// Close the file when you're done (usually this would
-	// be scheduled immediately after `Open`ing with
-	// `defer`).
+	s3, err := readAt(f, 6, 2)
+	check(err)
+	fmt.Printf("%d bytes @ %d: %s\n", len(s3), 6, s3)
 
-	// TODO: Create f.Close()
-}
\ No newline at end of file
+	s4, err := peekStart(f, 5)
+	check(err)
+	fmt.Printf("5 bytes: %s\n", s4)
+}
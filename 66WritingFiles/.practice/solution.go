@@ -0,0 +1,67 @@
+// Writing files in Go follows similar patterns to the
+// ones we saw earlier for reading.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+)
+
+func check(e error) {
+	if e != nil {
+		panic(e)
+	}
+}
+
+// To start, here's how to dump a string (or just
+// bytes) into a file.
+func dump(path string, data []byte) error {
+	return os.WriteFile(path, data, 0644)
+}
+
+// `bufio` provides buffered writers in addition
+// to the buffered readers we saw earlier. Use `Flush`
+// to ensure all buffered operations have been applied
+// to the underlying writer.
+func writeBuffered(f *os.File, s string) (int, error) {
+	w := bufio.NewWriter(f)
+	n, err := w.WriteString(s)
+	if err != nil {
+		return n, err
+	}
+	return n, w.Flush()
+}
+
+func main() {
+	d1 := []byte("hello\ngo\n")
+	err := dump("/tmp/dat1", d1)
+	check(err)
+
+	// For more granular writes, open a file for writing.
+	f, err := os.Create("/tmp/dat2")
+	check(err)
+
+	// It's idiomatic to defer a `Close` immediately
+	// after opening a file.
+	defer f.Close()
+
+	// You can `Write` byte slices as you'd expect.
+	d2 := []byte{115, 111, 109, 101, 10}
+	n2, err := f.Write(d2)
+	check(err)
+	fmt.Printf("wrote %d bytes\n", n2)
+
+	// A `WriteString` is also available.
+	n3, err := f.WriteString("writes\n")
+	check(err)
+	fmt.Printf("wrote %d bytes\n", n3)
+
+	// Issue a `Sync` to flush writes to stable storage.
+	f.Sync()
+
+	n4, err := writeBuffered(f, "buffered\n")
+	check(err)
+	fmt.Printf("wrote %d bytes\n", n4)
+}
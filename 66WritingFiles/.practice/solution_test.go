@@ -0,0 +1,60 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	check(nil)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected check to panic on a non-nil error")
+		}
+	}()
+	check(os.ErrPermission)
+}
+
+func TestDump(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dat1")
+	if err := dump(path, []byte("hello\ngo\n")); err != nil {
+		t.Fatalf("dump failed: %v", err)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	if string(got) != "hello\ngo\n" {
+		t.Errorf("Expected %q, got %q", "hello\ngo\n", got)
+	}
+
+	if err := dump(filepath.Join(t.TempDir(), "missing", "dat1"), nil); err == nil {
+		t.Error("Expected an error writing into a missing directory, got nil")
+	}
+}
+
+func TestWriteBuffered(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dat2")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	defer f.Close()
+
+	n, err := writeBuffered(f, "buffered\n")
+	if err != nil || n != 9 {
+		t.Errorf("Expected 9, <nil>, got %d, %v", n, err)
+	}
+
+	// The data must be flushed to the file, not left
+	// sitting in the buffer.
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	if string(got) != "buffered\n" {
+		t.Errorf("Expected %q in the file, got %q", "buffered\n", got)
+	}
+}
@@ -4,65 +4,59 @@
 package main
 
 import (
-	"bufio"
 	"fmt"
 	"os"
 )
 
-// TODO: Create function check(e error) that checks if e is not nil, panic with e
+func check(e error) {
+	// TODO: Check if e is not nil, panic with e
+}
+
+// To start, here's how to dump a string (or just
+// bytes) into a file.
+func dump(path string, data []byte) error {
+	// TODO: Return os.WriteFile(path, data, 0644)
+	return nil
+}
+
+// `bufio` provides buffered writers in addition
+// to the buffered readers we saw earlier. Use `Flush`
+// to ensure all buffered operations have been applied
+// to the underlying writer.
+func writeBuffered(f *os.File, s string) (int, error) {
+	// TODO: Create w := bufio.NewWriter(f) and write s with w.WriteString(s)
+	// TODO: Flush w and return the number of bytes written and any error
+	return 0, nil
+}
 
 func main() {
-
-	// To start, here's how to dump a string (or just
-	// bytes) into a file.
-
-	// TODO: Create d1 := []byte("hello
-go
-")
-	// TODO: Create err := os.WriteFile("/tmp/dat1", d1, 0644)
-	// TODO: Print err
+	d1 := []byte("hello\ngo\n")
+	err := dump("/tmp/dat1", d1)
+	check(err)
 
 	// For more granular writes, open a file for writing.
-
-	// TODO: Create f, err := os.Create("/tmp/dat2")
-	// TODO: Print err
+	f, err := os.Create("/tmp/dat2")
+	check(err)
 
 	// It's idiomatic to defer a `Close` immediately
 	// after opening a file.
-
-	// TODO: Defer the closing of the file with defer f.Close()
+	defer f.Close()
 
 	// You can `Write` byte slices as you'd expect.
-
-	// TODO: Create d2 := []byte{115, 111, 109, 101, 10}
-	// TODO: Create n2, err := f.Write(d2)
-	// TODO: Print err
-	// TODO: Print fmt.Printf("wrote %d bytes
-", n2)
+	d2 := []byte{115, 111, 109, 101, 10}
+	n2, err := f.Write(d2)
+	check(err)
+	fmt.Printf("wrote %d bytes\n", n2)
 
 	// A `WriteString` is also available.
-
-	// TODO: Create n3, err := f.WriteString("writes
-")
-	// TODO: Print err
-	// TODO: Print fmt.Printf("wrote %d bytes
-", n3)
+	n3, err := f.WriteString("writes\n")
+	check(err)
+	fmt.Printf("wrote %d bytes\n", n3)
 
 	// Issue a `Sync` to flush writes to stable storage.
+	f.Sync()
 
-	// TODO: Create f.Sync()
-
-	// `bufio` provides buffered writers in addition
-	// to the buffered readers we saw earlier.
-
-	// TODO: Create w := bufio.NewWriter(f)
-	// TODO: Print err
-	// TODO: Print fmt.Printf("wrote %d bytes
-", n4)
-
-	// Use `Flush` to ensure all buffered operations have
-	// been applied to the underlying writer.
-
-	// TODO: Create w.Flush()
-
-}
\ No newline at end of file
+	n4, err := writeBuffered(f, "buffered\n")
+	check(err)
+	fmt.Printf("wrote %d bytes\n", n4)
+}
@@ -0,0 +1,360 @@
+// A _line filter_ is a common type of program that reads
+// input on stdin, processes it, and then prints some
+// derived result to stdout. `grep` and `sed` are common
+// line filters.
+
+// Here's a line filter in Go that does a little of both:
+// like `grep`, it prints the lines matching a regular
+// expression, with line numbers and the lines around
+// them if asked, and like `sed`, it can substitute text
+// in the lines it prints. You can use this pattern to
+// write your own Go line filters.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// options are the command line's flags, and what they
+// select.
+type options struct {
+	pattern *regexp.Regexp // lines to print
+	invert  bool           // print the lines not matching instead
+	number  bool           // prefix lines with their numbers
+	before  int            // lines of context before matches
+	after   int            // lines of context after matches
+	subst   *substitution  // applied to every line printed
+	maxLine int            // longest line accepted, in bytes
+}
+
+// substitution is a `sed` command `s/re/repl/flags`. In
+// repl, `&` stands for the matched text, `\U` and `\L`
+// turn what follows into upper or lower case up to `\E`,
+// and a backslash makes the next character literal. The
+// flag `g` replaces every match instead of the first,
+// and `i` ignores case.
+type substitution struct {
+	re     *regexp.Regexp
+	repl   string
+	global bool
+}
+
+// parseSubstitution parses a `sed` command. As in `sed`,
+// any character can separate the parts, e.g.
+// `s|/usr|/opt|`, and a backslash escapes it.
+func parseSubstitution(cmd string) (*substitution, error) {
+	if len(cmd) < 2 || cmd[0] != 's' {
+		return nil, fmt.Errorf("substitution %q: must look like s/re/repl/", cmd)
+	}
+	delim := cmd[1]
+	var parts []string
+	var part strings.Builder
+	for i := 2; i < len(cmd); i++ {
+		switch {
+		case cmd[i] == '\\' && i+1 < len(cmd):
+			// Other escapes are left for the regexp or
+			// expand.
+			if cmd[i+1] != delim {
+				part.WriteByte('\\')
+			}
+			part.WriteByte(cmd[i+1])
+			i++
+		case cmd[i] == delim:
+			parts = append(parts, part.String())
+			part.Reset()
+		default:
+			part.WriteByte(cmd[i])
+		}
+	}
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("substitution %q: must look like s/re/repl/", cmd)
+	}
+
+	s := &substitution{repl: parts[1]}
+	expr := parts[0]
+	for _, f := range part.String() {
+		switch f {
+		case 'g':
+			s.global = true
+		case 'i':
+			expr = "(?i)" + expr
+		default:
+			return nil, fmt.Errorf("substitution %q: unknown flag %q", cmd, f)
+		}
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("substitution %q: %w", cmd, err)
+	}
+	s.re = re
+	return s, nil
+}
+
+// apply returns line with the substitution made. The
+// function given to `ReplaceAllStringFunc` is called with
+// each match, and returns its replacement; counting the
+// calls lets it leave all but the first match alone.
+func (s *substitution) apply(line string) string {
+	n := 0
+	return s.re.ReplaceAllStringFunc(line, func(match string) string {
+		n++
+		if n > 1 && !s.global {
+			return match
+		}
+		return s.expand(match)
+	})
+}
+
+// expand returns the replacement of match. Literal text
+// is collected in lit until the case changes, then
+// converted and flushed to out.
+func (s *substitution) expand(match string) string {
+	var out, lit strings.Builder
+	convert := func(s string) string { return s }
+	flush := func() {
+		out.WriteString(convert(lit.String()))
+		lit.Reset()
+	}
+	for i := 0; i < len(s.repl); i++ {
+		c := s.repl[i]
+		switch {
+		case c == '&':
+			lit.WriteString(match)
+		case c == '\\' && i+1 < len(s.repl):
+			i++
+			switch s.repl[i] {
+			case 'U':
+				flush()
+				convert = strings.ToUpper
+			case 'L':
+				flush()
+				convert = strings.ToLower
+			case 'E':
+				flush()
+				convert = func(s string) string { return s }
+			default:
+				lit.WriteByte(s.repl[i])
+			}
+		default:
+			lit.WriteByte(c)
+		}
+	}
+	flush()
+	return out.String()
+}
+
+// numbered is a line and its number.
+type numbered struct {
+	n    int
+	text string
+}
+
+// filter copies the lines of `r` selected by `opts` to
+// `w`, and returns how many matched. If name isn't empty,
+// lines are prefixed with it, to tell files apart. As in
+// `grep`, matching lines are marked with `:` and context
+// lines with `-`, and `--` separates groups of lines that
+// aren't next to each other. Taking an `io.Reader` and
+// `io.Writer` instead of using `os.Stdin` and
+// `os.Stdout` directly keeps it easy to test.
+func filter(r io.Reader, w io.Writer, name string, opts options) (int, error) {
+
+	// Wrapping the unbuffered reader with a buffered
+	// scanner gives us a convenient `Scan` method that
+	// advances the scanner to the next token; which is
+	// the next line in the default scanner. By default a
+	// line can't be longer than 64KB, `Buffer` allows
+	// longer ones: the scanner's buffer starts small and
+	// grows up to `maxLine` bytes as needed.
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, opts.maxLine)
+
+	var (
+		n       int // number of the current line
+		matches int
+		last    int        // number of the last line printed
+		pending []numbered // unprinted lines for context before
+		left    int        // lines of context after still to print
+		err     error
+	)
+	emit := func(l numbered, sep string) {
+		if err != nil {
+			return
+		}
+		if last > 0 && l.n > last+1 && (opts.before > 0 || opts.after > 0) {
+			_, err = fmt.Fprintln(w, "--")
+		}
+		last = l.n
+		text := l.text
+		if opts.subst != nil {
+			text = opts.subst.apply(text)
+		}
+		var prefix string
+		if name != "" {
+			prefix = name + sep
+		}
+		if opts.number {
+			prefix += fmt.Sprint(l.n) + sep
+		}
+		if err == nil {
+			_, err = fmt.Fprintln(w, prefix+text)
+		}
+	}
+
+	for scanner.Scan() {
+
+		// `Text` returns the current token, here the next
+		// line, from the input.
+		n++
+		l := numbered{n, scanner.Text()}
+		if opts.pattern.MatchString(l.text) != opts.invert {
+			matches++
+			for _, p := range pending {
+				emit(p, "-")
+			}
+			pending = pending[:0]
+			emit(l, ":")
+			left = opts.after
+		} else if left > 0 {
+			emit(l, "-")
+			left--
+		} else if opts.before > 0 {
+			if len(pending) == opts.before {
+				pending = append(pending[:0], pending[1:]...)
+			}
+			pending = append(pending, l)
+		}
+		if err != nil {
+			return matches, err
+		}
+	}
+
+	// Check for errors during `Scan`. End of file is
+	// expected and not reported by `Scan` as an error.
+	if err := scanner.Err(); err != nil {
+		return matches, fmt.Errorf("line %d: %w", n+1, err)
+	}
+	return matches, nil
+}
+
+// errUsage is returned for bad command lines, once the
+// usage has been printed.
+var errUsage = errors.New("bad command line")
+
+// parseArgs parses the command line into options and the
+// files to read.
+func parseArgs(args []string, stderr io.Writer) (options, []string, error) {
+	fs := flag.NewFlagSet("linefilter", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: linefilter [flags] pattern [file ...]")
+		fs.PrintDefaults()
+	}
+	invert := fs.Bool("v", false, "print the lines that don't match")
+	ignoreCase := fs.Bool("i", false, "ignore case when matching")
+	number := fs.Bool("n", false, "print line numbers")
+	after := fs.Int("A", 0, "print `n` lines after each match")
+	before := fs.Int("B", 0, "print `n` lines before each match")
+	context := fs.Int("C", 0, "print `n` lines around each match")
+	subst := fs.String("s", "", "substitute text in printed lines, like sed's `s/re/repl/flags`")
+	maxLine := fs.Int("maxline", 64<<20, "longest line accepted, in `bytes`")
+	// Parse prints the error and the usage itself.
+	if err := fs.Parse(args); err != nil {
+		return options{}, nil, errUsage
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return options{}, nil, errUsage
+	}
+
+	expr := fs.Arg(0)
+	if *ignoreCase {
+		expr = "(?i)" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return options{}, nil, err
+	}
+	opts := options{
+		pattern: re,
+		invert:  *invert,
+		number:  *number,
+		before:  max(*before, *context),
+		after:   max(*after, *context),
+		maxLine: *maxLine,
+	}
+	if *subst != "" {
+		if opts.subst, err = parseSubstitution(*subst); err != nil {
+			return options{}, nil, err
+		}
+	}
+	return opts, fs.Args()[1:], nil
+}
+
+// run runs the filter with the command line args, and
+// returns its exit status: like `grep`'s, 0 if a line
+// matched, 1 if none did and 2 if there was an error.
+// Files that can't be read are reported, and skipped.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	opts, files, err := parseArgs(args, stderr)
+	if err != nil {
+		if err != errUsage {
+			fmt.Fprintln(stderr, "linefilter:", err)
+		}
+		return 2
+	}
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+
+	// Writing each line straight to stdout would make a
+	// system call per line.
+	w := bufio.NewWriter(stdout)
+	status := 1
+	for _, file := range files {
+		// Name lines only when there are several files,
+		// and name stdin as `grep` does.
+		var name string
+		if len(files) > 1 {
+			name = file
+			if file == "-" {
+				name = "(standard input)"
+			}
+		}
+		var n int
+		if file == "-" {
+			n, err = filter(stdin, w, name, opts)
+		} else {
+			var f *os.File
+			if f, err = os.Open(file); err != nil {
+				fmt.Fprintln(stderr, "linefilter:", err)
+				status = 2
+				continue
+			}
+			n, err = filter(f, w, name, opts)
+			f.Close()
+		}
+		if err != nil {
+			fmt.Fprintf(stderr, "linefilter: %s: %v\n", file, err)
+			status = 2
+		} else if n > 0 && status == 1 {
+			status = 0
+		}
+	}
+	if err := w.Flush(); err != nil {
+		fmt.Fprintln(stderr, "linefilter:", err)
+		return 2
+	}
+	return status
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// mustParse returns the options of a command line without
+// files.
+func mustParse(t *testing.T, args ...string) options {
+	t.Helper()
+	opts, files, err := parseArgs(args, os.Stderr)
+	if err != nil {
+		t.Fatalf("parseArgs(%q) failed: %v", args, err)
+	}
+	if len(files) > 0 {
+		t.Fatalf("parseArgs(%q): expected no files, got %q", args, files)
+	}
+	return opts
+}
+
+func TestFilter(t *testing.T) {
+	const text = "alpha\nBeta\ngamma\ndelta\nepsilon\nzeta\neta\ntheta\n"
+	tests := []struct {
+		args     []string
+		in, want string
+		matches  int
+	}{
+		// An empty pattern matches every line, and \U
+		// upper-cases the rest of the replacement.
+		{[]string{"-s", `s/.*/\U&/`, ""}, "hello\nfilter\n", "HELLO\nFILTER\n", 2},
+		{[]string{"-s", `s/.*/\U&/`, ""}, "no trailing newline", "NO TRAILING NEWLINE\n", 1},
+		{[]string{""}, "", "", 0},
+		{[]string{"-s", `s/.*/\U&/`, ""}, "MiXeD 123\n\nend\n", "MIXED 123\n\nEND\n", 3},
+
+		{[]string{"eta"}, text, "Beta\nzeta\neta\ntheta\n", 4},
+		{[]string{"^eta$"}, text, "eta\n", 1},
+		{[]string{"-v", "eta"}, text, "alpha\ngamma\ndelta\nepsilon\n", 4},
+		{[]string{"beta"}, text, "", 0},
+		{[]string{"-i", "beta"}, text, "Beta\n", 1},
+		{[]string{"-n", "^[a-d]"}, text, "1:alpha\n4:delta\n", 2},
+		{[]string{"-n", "-v", "-i", "[aeiou]"}, "one\nsky\ntwo\nmyth\n", "2:sky\n4:myth\n", 2},
+
+		// Context lines are marked with -, and -- separates
+		// groups that aren't next to each other.
+		{[]string{"-n", "-A", "1", "^[ad]"}, text, "1:alpha\n2-Beta\n--\n4:delta\n5-epsilon\n", 2},
+		{[]string{"-n", "-B", "2", "zeta"}, text, "4-delta\n5-epsilon\n6:zeta\n", 1},
+		{[]string{"-n", "-C", "1", "^(alpha|zeta)$"}, text, "1:alpha\n2-Beta\n--\n5-epsilon\n6:zeta\n7-eta\n", 2},
+		{[]string{"-C", "1", "^(gamma|delta)$"}, text, "Beta\ngamma\ndelta\nepsilon\n", 2},
+		{[]string{"-C", "2", "^(alpha|epsilon)$"}, text, "alpha\nBeta\ngamma\ndelta\nepsilon\nzeta\neta\n", 2},
+		{[]string{"-A", "1", "-B", "3", "Beta"}, text, "alpha\nBeta\ngamma\n", 1},
+
+		// Substitutions apply to every line printed.
+		{[]string{"-s", "s/a/A/", "^.e"}, text, "BetA\ndeltA\nzetA\n", 3},
+		{[]string{"-s", "s/a/A/g", "-A", "1", "gamma"}, text, "gAmmA\ndeltA\n", 1},
+		{[]string{"-i", "-s", "s/E/[&]/gi", "^[be]"}, text, "B[e]ta\n[e]psilon\n[e]ta\n", 3},
+		{[]string{"-s", `s/\w+/\U&\E and \L&/`, "^B"}, text, "BETA and beta\n", 1},
+		{[]string{"-s", `s|/usr|/opt\|&|`, "bin"}, "/usr/bin\n", "/opt|/usr/bin\n", 1},
+		{[]string{"-s", `s/\//\\/g`, ""}, "a/b/c\n", `a\b\c` + "\n", 1},
+		{[]string{"-s", `s/é/\U&/g`, ""}, "café é\n", "cafÉ É\n", 1},
+	}
+
+	for _, tt := range tests {
+		var out bytes.Buffer
+		n, err := filter(strings.NewReader(tt.in), &out, "", mustParse(t, tt.args...))
+		if err != nil {
+			t.Fatalf("filter %q failed: %v", tt.args, err)
+		}
+		if out.String() != tt.want {
+			t.Errorf("filter %q: expected %q, got %q", tt.args, tt.want, out.String())
+		}
+		if n != tt.matches {
+			t.Errorf("filter %q: expected %d matches, got %d", tt.args, tt.matches, n)
+		}
+	}
+}
+
+func TestFilterName(t *testing.T) {
+	var out bytes.Buffer
+	opts := mustParse(t, "-n", "-B", "1", "b")
+	if _, err := filter(strings.NewReader("a\nb\n"), &out, "f.txt", opts); err != nil {
+		t.Fatalf("filter failed: %v", err)
+	}
+	if want := "f.txt-1-a\nf.txt:2:b\n"; out.String() != want {
+		t.Errorf("Expected %q, got %q", want, out.String())
+	}
+}
+
+func TestLongLines(t *testing.T) {
+	// Lines longer than the scanner's default 64KB buffer
+	// are read whole.
+	long := strings.Repeat("x", bufio.MaxScanTokenSize*4) + "needle"
+	var out bytes.Buffer
+	n, err := filter(strings.NewReader("short\n"+long+"\n"), &out, "", mustParse(t, "needle"))
+	if err != nil || n != 1 {
+		t.Fatalf("Expected 1 match, got %d, %v", n, err)
+	}
+	if out.String() != long+"\n" {
+		t.Errorf("Expected the long line, got %d bytes", out.Len())
+	}
+
+	// Longer lines than -maxline are reported through
+	// `scanner.Err`, with their number.
+	opts := mustParse(t, "-maxline", "100", "needle")
+	_, err = filter(strings.NewReader("short\n"+long+"\n"), &out, "", opts)
+	if !errors.Is(err, bufio.ErrTooLong) {
+		t.Errorf("Expected %v, got %v", bufio.ErrTooLong, err)
+	}
+	if err != nil && !strings.HasPrefix(err.Error(), "line 2: ") {
+		t.Errorf("Expected the error to name line 2, got %v", err)
+	}
+}
+
+// failingWriter fails every write.
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestFilterWriteError(t *testing.T) {
+	_, err := filter(strings.NewReader("a\nb\n"), failingWriter{}, "", mustParse(t, ""))
+	if err == nil || err.Error() != "disk full" {
+		t.Errorf("Expected the write error, got %v", err)
+	}
+}
+
+func TestParseSubstitution(t *testing.T) {
+	for _, cmd := range []string{"", "s", "x/a/b/", "s/a/b", "s/a/b/c/", "s/a/b/x", "s/(/b/"} {
+		if _, err := parseSubstitution(cmd); err == nil {
+			t.Errorf("parseSubstitution(%q): expected an error", cmd)
+		}
+	}
+	s, err := parseSubstitution(`s#a\#b#c#gi`)
+	if err != nil {
+		t.Fatalf("parseSubstitution failed: %v", err)
+	}
+	if s.re.String() != "(?i)a#b" || s.repl != "c" || !s.global {
+		t.Errorf("Expected (?i)a#b, c and global, got %q, %q and %v", s.re, s.repl, s.global)
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	one := filepath.Join(dir, "one.txt")
+	two := filepath.Join(dir, "two.txt")
+	os.WriteFile(one, []byte("apple\nbanana\n"), 0644)
+	os.WriteFile(two, []byte("cherry\navocado\n"), 0644)
+	missing := filepath.Join(dir, "missing.txt")
+
+	tests := []struct {
+		args       []string
+		stdin      string
+		want       string
+		wantStatus int
+		wantErr    string
+	}{
+		{[]string{"an"}, "plan\nplot\n", "plan\n", 0, ""},
+		{[]string{"an", "-"}, "plan\nplot\n", "plan\n", 0, ""},
+		{[]string{"xyz"}, "plan\n", "", 1, ""},
+		{[]string{"^a", one}, "", "apple\n", 0, ""},
+		// Lines of several files are prefixed with their
+		// file's name.
+		{[]string{"-n", "^a", one, "-", two}, "ant\n", one + ":1:apple\n(standard input):1:ant\n" + two + ":2:avocado\n", 0, ""},
+		// Files that can't be read are reported and
+		// skipped.
+		{[]string{"^a", missing, one}, "", one + ":apple\n", 2, "no such file"},
+		{[]string{}, "", "", 2, "usage:"},
+		{[]string{"-x", "a"}, "", "", 2, "not defined: -x"},
+		{[]string{"("}, "", "", 2, "missing closing )"},
+		{[]string{"-s", "s/a/b", "a"}, "", "", 2, "must look like"},
+		{[]string{"-maxline", "4", "a"}, "a\nabcdefgh\n", "a\n", 2, "-: line 2: bufio.Scanner: token too long"},
+	}
+
+	for _, tt := range tests {
+		var stdout, stderr bytes.Buffer
+		status := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+		if status != tt.wantStatus {
+			t.Errorf("run(%q): expected status %d, got %d", tt.args, tt.wantStatus, status)
+		}
+		if stdout.String() != tt.want {
+			t.Errorf("run(%q): expected %q, got %q", tt.args, tt.want, stdout.String())
+		}
+		if tt.wantErr == "" && stderr.Len() > 0 || !strings.Contains(stderr.String(), tt.wantErr) {
+			t.Errorf("run(%q): expected stderr to contain %q, got %q", tt.args, tt.wantErr, stderr.String())
+		}
+	}
+}
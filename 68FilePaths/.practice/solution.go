@@ -0,0 +1,75 @@
+// The `filepath` package provides functions to parse
+// and construct *file paths* in a way that is portable
+// between operating systems; `dir/file` on Linux vs.
+// `dir\file` on Windows, for example.
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// `Dir` and `Base` can be used to split a path to the
+// directory and the file. Alternatively, `Split` will
+// return both in the same call.
+func split(p string) (dir, base string) {
+	return filepath.Dir(p), filepath.Base(p)
+}
+
+// Some file names have extensions following a dot. We
+// can split the extension out of such names with `Ext`.
+// To find the file's name with the extension removed,
+// use `strings.TrimSuffix`.
+func stem(filename string) (name, ext string) {
+	ext = filepath.Ext(filename)
+	return strings.TrimSuffix(filename, ext), ext
+}
+
+// `Rel` finds a relative path between a *base* and a
+// *target*. It returns an error if the target cannot
+// be made relative to base.
+func rel(base, target string) (string, error) {
+	return filepath.Rel(base, target)
+}
+
+func main() {
+
+	// `Join` should be used to construct paths in a
+	// portable way. It takes any number of arguments
+	// and constructs a hierarchical path from them.
+	p := filepath.Join("dir1", "dir2", "filename")
+	fmt.Println("p:", p)
+
+	// You should always use `Join` instead of
+	// concatenating `/`s or `\`s manually. In addition
+	// to providing portability, `Join` will also
+	// normalize paths by removing superfluous separators
+	// and directory changes.
+	fmt.Println(filepath.Join("dir1//", "filename"))
+	fmt.Println(filepath.Join("dir1/../dir1", "filename"))
+
+	dir, base := split(p)
+	fmt.Println("Dir(p):", dir)
+	fmt.Println("Base(p):", base)
+
+	// We can check whether a path is absolute.
+	fmt.Println(filepath.IsAbs("dir/file"))
+	fmt.Println(filepath.IsAbs("/dir/file"))
+
+	name, ext := stem("config.json")
+	fmt.Println(ext)
+	fmt.Println(name)
+
+	r, err := rel("a/b", "a/b/t/file")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(r)
+
+	r, err = rel("a/b", "a/c/t/file")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(r)
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		p, dir, base string
+	}{
+		{filepath.Join("dir1", "dir2", "filename"), filepath.Join("dir1", "dir2"), "filename"},
+		{"filename", ".", "filename"},
+		{"/dir/file.txt", "/dir", "file.txt"},
+	}
+
+	for _, tt := range tests {
+		dir, base := split(tt.p)
+		if dir != tt.dir || base != tt.base {
+			t.Errorf("split(%q): expected %q, %q, got %q, %q", tt.p, tt.dir, tt.base, dir, base)
+		}
+	}
+}
+
+func TestStem(t *testing.T) {
+	tests := []struct {
+		filename, name, ext string
+	}{
+		{"config.json", "config", ".json"},
+		{"archive.tar.gz", "archive.tar", ".gz"},
+		{"Makefile", "Makefile", ""},
+	}
+
+	for _, tt := range tests {
+		name, ext := stem(tt.filename)
+		if name != tt.name || ext != tt.ext {
+			t.Errorf("stem(%q): expected %q, %q, got %q, %q", tt.filename, tt.name, tt.ext, name, ext)
+		}
+	}
+}
+
+func TestRel(t *testing.T) {
+	tests := []struct {
+		base, target, want string
+	}{
+		{"a/b", "a/b/t/file", filepath.Join("t", "file")},
+		{"a/b", "a/c/t/file", filepath.Join("..", "c", "t", "file")},
+	}
+
+	for _, tt := range tests {
+		got, err := rel(tt.base, tt.target)
+		if err != nil {
+			t.Fatalf("rel(%q, %q) failed: %v", tt.base, tt.target, err)
+		}
+		if got != tt.want {
+			t.Errorf("Expected %q, got %q", tt.want, got)
+		}
+	}
+
+	// A relative target can't be made relative to an
+	// absolute base.
+	if _, err := rel("/a", "b"); err == nil {
+		t.Error("Expected an error for mixed absolute and relative paths, got nil")
+	}
+}
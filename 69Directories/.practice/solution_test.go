@@ -0,0 +1,94 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+func TestCheck(t *testing.T) {
+	check(nil)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected check to panic on a non-nil error")
+		}
+	}()
+	check(os.ErrNotExist)
+}
+
+func TestCreateEmptyFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "file1")
+	createEmptyFile(name)
+
+	info, err := os.Stat(name)
+	if err != nil {
+		t.Fatalf("Stat failed: %v", err)
+	}
+	if info.Size() != 0 || info.IsDir() {
+		t.Errorf("Expected an empty regular file, got size %d, dir %v", info.Size(), info.IsDir())
+	}
+}
+
+// tree builds a small directory hierarchy under a
+// temporary directory and returns its root.
+func tree(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "parent", "child"), 0755); err != nil {
+		t.Fatalf("MkdirAll failed: %v", err)
+	}
+	for _, name := range []string{"file1", "parent/file2", "parent/child/file4"} {
+		if err := os.WriteFile(filepath.Join(root, name), nil, 0644); err != nil {
+			t.Fatalf("WriteFile failed: %v", err)
+		}
+	}
+	return root
+}
+
+func TestList(t *testing.T) {
+	root := tree(t)
+
+	got := practicetest.Capture(t, func() { list(filepath.Join(root, "parent")) })
+	want := "  child true\n  file2 false\n"
+	if got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestVisit(t *testing.T) {
+	root := tree(t)
+
+	var err error
+	got := practicetest.Capture(t, func() { err = filepath.WalkDir(root, visit) })
+	if err != nil {
+		t.Fatalf("WalkDir failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(got), "\n")
+	want := []string{
+		root + " true",
+		filepath.Join(root, "file1") + " false",
+		filepath.Join(root, "parent") + " true",
+		filepath.Join(root, "parent", "child") + " true",
+		filepath.Join(root, "parent", "child", "file4") + " false",
+		filepath.Join(root, "parent", "file2") + " false",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("Expected %d lines, got %d: %q", len(want), len(lines), got)
+	}
+	for i := range want {
+		if strings.TrimSpace(lines[i]) != want[i] {
+			t.Errorf("Line %d: expected %q, got %q", i, want[i], strings.TrimSpace(lines[i]))
+		}
+	}
+
+	// Errors reported by `WalkDir` are passed back.
+	err = filepath.WalkDir(filepath.Join(root, "missing"), visit)
+	if !os.IsNotExist(err) {
+		t.Errorf("Expected a not-exist error, got %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+// Throughout program execution, we often want to create
+// data that isn't needed after the program exits.
+// *Temporary files and directories* are useful for this
+// purpose since they don't pollute the file system over
+// time.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func check(e error) {
+	if e != nil {
+		panic(e)
+	}
+}
+
+// The easiest way to create a temporary file is by
+// calling `os.CreateTemp`. It creates a file *and*
+// opens it for reading and writing. We provide `""`
+// as the first argument, so `os.CreateTemp` will
+// create the file in the default location for our OS.
+// The file name starts with the prefix given as the
+// second argument to `os.CreateTemp` and the rest
+// is chosen automatically to ensure that concurrent
+// calls will always create different file names.
+func tempFile(prefix string, data []byte) (string, error) {
+	f, err := os.CreateTemp("", prefix)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	// We can write some data to the file.
+	if _, err := f.Write(data); err != nil {
+		return f.Name(), err
+	}
+	return f.Name(), nil
+}
+
+// Now we can synthesize temporary file names by
+// prefixing them with our temporary directory.
+func writeIn(dname, name string, data []byte) (string, error) {
+	fname := filepath.Join(dname, name)
+	return fname, os.WriteFile(fname, data, 0666)
+}
+
+func main() {
+	name, err := tempFile("sample", []byte{1, 2, 3, 4})
+	check(err)
+
+	// Display the name of the temporary file. On
+	// Unix-based OSes the directory will likely be `/tmp`.
+	fmt.Println("Temp file name:", name)
+
+	// Clean up the file after we're done. The OS is
+	// likely to clean up temporary files by itself after
+	// some time, but it's good practice to do this
+	// explicitly.
+	defer os.Remove(name)
+
+	// If we intend to write many temporary files, we may
+	// prefer to create a temporary *directory*.
+	// `os.MkdirTemp`'s arguments are the same as
+	// `CreateTemp`'s, but it returns a directory *name*
+	// rather than an open file.
+	dname, err := os.MkdirTemp("", "sampledir")
+	check(err)
+	fmt.Println("Temp dir name:", dname)
+
+	defer os.RemoveAll(dname)
+
+	_, err = writeIn(dname, "file1", []byte{1, 2})
+	check(err)
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTempFile(t *testing.T) {
+	// Point the default temporary directory at a
+	// directory the test cleans up.
+	dir := t.TempDir()
+	t.Setenv("TMPDIR", dir)
+
+	name, err := tempFile("sample", []byte{1, 2, 3, 4})
+	if err != nil {
+		t.Fatalf("tempFile failed: %v", err)
+	}
+	if filepath.Dir(name) != dir {
+		t.Errorf("Expected the file in %s, got %s", dir, name)
+	}
+	if !strings.HasPrefix(filepath.Base(name), "sample") {
+		t.Errorf("Expected the name to start with %q, got %s", "sample", name)
+	}
+
+	got, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	if !bytes.Equal(got, []byte{1, 2, 3, 4}) {
+		t.Errorf("Expected [1 2 3 4], got %v", got)
+	}
+
+	// Concurrent callers never share a name.
+	other, err := tempFile("sample", nil)
+	if err != nil {
+		t.Fatalf("tempFile failed: %v", err)
+	}
+	if other == name {
+		t.Errorf("Expected distinct names, got %s twice", name)
+	}
+}
+
+func TestWriteIn(t *testing.T) {
+	dname := t.TempDir()
+
+	fname, err := writeIn(dname, "file1", []byte{1, 2})
+	if err != nil {
+		t.Fatalf("writeIn failed: %v", err)
+	}
+	if want := filepath.Join(dname, "file1"); fname != want {
+		t.Errorf("Expected %s, got %s", want, fname)
+	}
+
+	got, err := os.ReadFile(fname)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	if !bytes.Equal(got, []byte{1, 2}) {
+		t.Errorf("Expected [1 2], got %v", got)
+	}
+
+	if _, err := writeIn(filepath.Join(dname, "missing"), "file1", nil); err == nil {
+		t.Error("Expected an error writing into a missing directory, got nil")
+	}
+}
@@ -9,60 +9,64 @@ package main
 import (
 	"fmt"
 	"os"
-	"path/filepath"
 )
 
+func check(e error) {
+	// TODO: Check if e is not nil, panic with e
+}
+
+// The easiest way to create a temporary file is by
+// calling `os.CreateTemp`. It creates a file *and*
+// opens it for reading and writing. We provide `""`
+// as the first argument, so `os.CreateTemp` will
+// create the file in the default location for our OS.
+// The file name starts with the prefix given as the
+// second argument to `os.CreateTemp` and the rest
+// is chosen automatically to ensure that concurrent
+// calls will always create different file names.
+func tempFile(prefix string, data []byte) (string, error) {
+	// TODO: Create f, err := os.CreateTemp("", prefix), returning any error
+	// TODO: Defer f.Close()
 
-// TODO: Create function check(e error) that checks if e is not nil, panic with e
-
-func main() {
+	// We can write some data to the file.
 
-	// The easiest way to create a temporary file is by
-	// calling `os.CreateTemp`. It creates a file *and*
-	// opens it for reading and writing. We provide `""`
-	// as the first argument, so `os.CreateTemp` will
-	// create the file in the default location for our OS.
+	// TODO: Write data with f.Write(data) and return f.Name() and any error
+	return "", nil
+}
 
+// Now we can synthesize temporary file names by
+// prefixing them with our temporary directory.
+func writeIn(dname, name string, data []byte) (string, error) {
+	// TODO: Create fname := filepath.Join(dname, name)
+	// TODO: Return fname and the error from os.WriteFile(fname, data, 0666)
+	return "", nil
+}
 
-	// TODO: Create f, err := os.CreateTemp("", "sample")
-	// TODO: Print err
+func main() {
+	name, err := tempFile("sample", []byte{1, 2, 3, 4})
+	check(err)
 
 	// Display the name of the temporary file. On
 	// Unix-based OSes the directory will likely be `/tmp`.
-	// The file name starts with the prefix given as the
-	// second argument to `os.CreateTemp` and the rest
-	// is chosen automatically to ensure that concurrent
-	// calls will always create different file names.
-
-	// TODO: Print fmt.Println("Temp file name:", f.Name())
+	fmt.Println("Temp file name:", name)
 
 	// Clean up the file after we're done. The OS is
 	// likely to clean up temporary files by itself after
 	// some time, but it's good practice to do this
 	// explicitly.
-
-	// TODO: Defer the removal of the file with defer os.Remove(f.Name())
-
-	// We can write some data to the file.
-
-	// TODO: Create _, err = f.Write([]byte{1, 2, 3, 4})
-	// TODO: Print err
+	defer os.Remove(name)
 
 	// If we intend to write many temporary files, we may
 	// prefer to create a temporary *directory*.
 	// `os.MkdirTemp`'s arguments are the same as
 	// `CreateTemp`'s, but it returns a directory *name*
 	// rather than an open file.
+	dname, err := os.MkdirTemp("", "sampledir")
+	check(err)
+	fmt.Println("Temp dir name:", dname)
 
-	// TODO: Create dname, err := os.MkdirTemp("", "sampledir")
-	// TODO: Print err
-
-	// TODO: Defer the removal of the directory with defer os.RemoveAll(dname)
-
-	// Now we can synthesize temporary file names by
-	// prefixing them with our temporary directory.
+	defer os.RemoveAll(dname)
 
-	// TODO: Create fname := filepath.Join(dname, "file1")
-	// TODO: Create err = os.WriteFile(fname, []byte{1, 2}, 0666)
-	// TODO: Print err
-}
\ No newline at end of file
+	_, err = writeIn(dname, "file1", []byte{1, 2})
+	check(err)
+}
@@ -0,0 +1,144 @@
+// `//go:embed` is a [compiler
+// directive](https://pkg.go.dev/cmd/compile#hdr-Compiler_Directives) that
+// allows programs to include arbitrary files and folders in the Go binary at
+// build time. Read more about the embed directive
+// [here](https://pkg.go.dev/embed).
+package main
+
+// Import the `embed` package; if you don't use any exported
+// identifiers from this package, you can do a blank import with `_ "embed"`.
+import (
+	"embed"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+)
+
+// `embed` directives accept paths relative to the directory containing the
+// Go source file. This directive embeds the contents of the file into the
+// `string` variable immediately following it.
+//
+//go:embed folder/single_file.txt
+var fileString string
+
+// Or embed the contents of the file into a `[]byte`.
+//
+//go:embed folder/single_file.txt
+var fileByte []byte
+
+// We can also embed multiple files or even folders with wildcards. This uses
+// a variable of the [embed.FS type](https://pkg.go.dev/embed#FS), which
+// implements a simple virtual file system.
+//
+//go:embed folder/single_file.txt
+//go:embed folder/*.hash
+var folder embed.FS
+
+// Embedding a directory embeds every file in it, except
+// those whose names start with `.` or `_`. Templates
+// parsed from an `embed.FS` ship with the binary, so it
+// doesn't depend on the directory it runs in.
+//
+//go:embed templates
+var templateFS embed.FS
+
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.tmpl"))
+
+// fileInfo describes a file for the index page.
+type fileInfo struct {
+	Name string
+	Size int64
+}
+
+// listFiles returns the files of fsys, in lexical order.
+// `embed.FS` implements `fs.FS`, so the functions of the
+// `io/fs` package, like `fs.WalkDir`, work on it as on
+// any other file system.
+func listFiles(fsys fs.FS) ([]fileInfo, error) {
+	var files []fileInfo
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		files = append(files, fileInfo{path, info.Size()})
+		return nil
+	})
+	return files, err
+}
+
+// newHandler serves the embedded files under `/static/`,
+// and an index of them at `/`. `fs.Sub` drops the
+// `folder/` prefix of their paths, and `http.FS` turns
+// the `fs.FS` into the `http.FileSystem` that
+// `http.FileServer` serves.
+func newHandler() (http.Handler, error) {
+	static, err := fs.Sub(folder, "folder")
+	if err != nil {
+		return nil, err
+	}
+	files, err := listFiles(static)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /static/", http.StripPrefix("/static/", http.FileServer(http.FS(static))))
+	mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		data := struct {
+			Title string
+			Files []fileInfo
+		}{"Files & hashes", files}
+		if err := templates.ExecuteTemplate(w, "index", data); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+	return mux, nil
+}
+
+// get returns the status and body of a GET request to h.
+func get(h http.Handler, path string) (int, string) {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec.Code, rec.Body.String()
+}
+
+func main() {
+
+	// Print out the contents of `single_file.txt`.
+	fmt.Print(fileString)
+	fmt.Print(string(fileByte))
+
+	// Retrieve some files from the embedded folder.
+	content1, _ := folder.ReadFile("folder/file1.hash")
+	fmt.Print(string(content1))
+
+	content2, _ := folder.ReadFile("folder/file2.hash")
+	fmt.Print(string(content2))
+
+	// Walk the embedded folder.
+	files, err := listFiles(folder)
+	if err != nil {
+		panic(err)
+	}
+	for _, f := range files {
+		fmt.Println(f.Name, f.Size)
+	}
+
+	// Serve it over HTTP. The requests go straight to the
+	// handler, without a server.
+	h, err := newHandler()
+	if err != nil {
+		panic(err)
+	}
+	for _, path := range []string{"/static/file1.hash", "/static/missing.hash", "/"} {
+		code, body := get(h, path)
+		fmt.Printf("GET %s: %d\n%s", path, code, body)
+	}
+}
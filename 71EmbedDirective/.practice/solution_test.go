@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"io/fs"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestEmbeddedFile(t *testing.T) {
+	if fileString != "hello go\n" {
+		t.Errorf("Expected fileString to be %q, got %q", "hello go\n", fileString)
+	}
+	if string(fileByte) != fileString {
+		t.Errorf("Expected fileByte to hold the same file, got %q", fileByte)
+	}
+}
+
+func TestEmbeddedFolder(t *testing.T) {
+	// TestFS checks that folder behaves as a file system
+	// should, and holds these files.
+	want := []string{"folder/file1.hash", "folder/file2.hash", "folder/single_file.txt"}
+	if err := fstest.TestFS(folder, want...); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{
+		"folder/file1.hash":      "123\n",
+		"folder/file2.hash":      "456\n",
+		"folder/single_file.txt": "hello go\n",
+	} {
+		got, err := folder.ReadFile(name)
+		if err != nil || string(got) != content {
+			t.Errorf("ReadFile(%q): expected %q, got %q, %v", name, content, got, err)
+		}
+	}
+	if _, err := folder.ReadFile("folder/missing.txt"); !os.IsNotExist(err) {
+		t.Errorf("Expected a missing file to not exist, got %v", err)
+	}
+
+	// Only the patterns of the directives are embedded.
+	matches, _ := fs.Glob(templateFS, "templates/*")
+	if !slices.Equal(matches, []string{"templates/file.html.tmpl", "templates/index.html.tmpl"}) {
+		t.Errorf("Expected the two templates, got %v", matches)
+	}
+}
+
+func TestListFiles(t *testing.T) {
+	fsys := fstest.MapFS{
+		"b.txt":       {Data: []byte("bb")},
+		"a/z.txt":     {Data: []byte("zzz")},
+		"a/deep/y.go": {Data: []byte("package y")},
+		"empty":       {Mode: fs.ModeDir},
+	}
+	got, err := listFiles(fsys)
+	if err != nil {
+		t.Fatalf("listFiles failed: %v", err)
+	}
+	want := []fileInfo{{"a/deep/y.go", 9}, {"a/z.txt", 3}, {"b.txt", 2}}
+	if !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	got, _ = listFiles(folder)
+	want = []fileInfo{{"folder/file1.hash", 4}, {"folder/file2.hash", 4}, {"folder/single_file.txt", 9}}
+	if !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	h, err := newHandler()
+	if err != nil {
+		t.Fatalf("newHandler failed: %v", err)
+	}
+	tests := []struct {
+		path string
+		code int
+		body string
+	}{
+		{"/static/file1.hash", http.StatusOK, "123\n"},
+		{"/static/file2.hash", http.StatusOK, "456\n"},
+		{"/static/single_file.txt", http.StatusOK, "hello go\n"},
+		{"/static/missing.hash", http.StatusNotFound, "404 page not found\n"},
+		{"/folder/file1.hash", http.StatusNotFound, "404 page not found\n"},
+		{"/elsewhere", http.StatusNotFound, "404 page not found\n"},
+	}
+	for _, tt := range tests {
+		code, body := get(h, tt.path)
+		if code != tt.code || body != tt.body {
+			t.Errorf("GET %s: expected %d %q, got %d %q", tt.path, tt.code, tt.body, code, body)
+		}
+	}
+
+	// The file server lists directories.
+	code, body := get(h, "/static/")
+	if code != http.StatusOK || !strings.Contains(body, `<a href="file1.hash">file1.hash</a>`) {
+		t.Errorf("Expected a listing of the folder, got %d %q", code, body)
+	}
+}
+
+func TestIndex(t *testing.T) {
+	h, err := newHandler()
+	if err != nil {
+		t.Fatalf("newHandler failed: %v", err)
+	}
+	code, body := get(h, "/")
+	if code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", code)
+	}
+	for _, want := range []string{
+		"<title>Files &amp; hashes</title>",
+		`<li><a href="/static/file1.hash">file1.hash</a> (4 bytes)</li>`,
+		`<li><a href="/static/single_file.txt">single_file.txt</a> (9 bytes)</li>`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("Expected the index to contain %q, got:\n%s", want, body)
+		}
+	}
+}
+
+func TestTemplatesEscape(t *testing.T) {
+	// html/template escapes data for where it appears in
+	// the page.
+	var b bytes.Buffer
+	err := templates.ExecuteTemplate(&b, "file", fileInfo{Name: `<x>"y"`, Size: 1})
+	if err != nil {
+		t.Fatalf("ExecuteTemplate failed: %v", err)
+	}
+	want := `<li><a href="/static/%3cx%3e%22y%22">&lt;x&gt;&#34;y&#34;</a> (1 bytes)</li>` + "\n"
+	if b.String() != want {
+		t.Errorf("Expected %q, got %q", want, b.String())
+	}
+}
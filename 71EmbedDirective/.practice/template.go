@@ -9,6 +9,11 @@ package main
 // identifiers from this package, you can do a blank import with `_ "embed"`.
 import (
 	"embed"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
 )
 
 // `embed` directives accept paths relative to the directory containing the
@@ -31,6 +36,68 @@ var fileByte []byte
 //go:embed folder/*.hash
 var folder embed.FS
 
+// Embedding a directory embeds every file in it, except
+// those whose names start with `.` or `_`. Templates
+// parsed from an `embed.FS` ship with the binary, so it
+// doesn't depend on the directory it runs in.
+//
+//go:embed templates
+var templateFS embed.FS
+
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.tmpl"))
+
+// fileInfo describes a file for the index page.
+type fileInfo struct {
+	Name string
+	Size int64
+}
+
+// listFiles returns the files of fsys, in lexical order.
+// `embed.FS` implements `fs.FS`, so the functions of the
+// `io/fs` package, like `fs.WalkDir`, work on it as on
+// any other file system.
+func listFiles(fsys fs.FS) ([]fileInfo, error) {
+	var files []fileInfo
+	// TODO: Call fs.WalkDir(fsys, ".", ...) with a function
+	// that returns err if it isn't nil, skips directories,
+	// and appends fileInfo{path, size} for files, taking
+	// the size from d.Info(). Return its error.
+	return files, nil
+}
+
+// newHandler serves the embedded files under `/static/`,
+// and an index of them at `/`. `fs.Sub` drops the
+// `folder/` prefix of their paths, and `http.FS` turns
+// the `fs.FS` into the `http.FileSystem` that
+// `http.FileServer` serves.
+func newHandler() (http.Handler, error) {
+	// TODO: Set static, err := fs.Sub(folder, "folder"), and
+	// list its files with listFiles, returning any error
+	var files []fileInfo
+
+	mux := http.NewServeMux()
+	// TODO: Handle "GET /static/" with http.FileServer of
+	// http.FS(static), wrapped in http.StripPrefix("/static/", ...)
+	mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		data := struct {
+			Title string
+			Files []fileInfo
+		}{"Files & hashes", files}
+		if err := templates.ExecuteTemplate(w, "index", data); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+	return mux, nil
+}
+
+// get returns the status and body of a GET request to h.
+func get(h http.Handler, path string) (int, string) {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec.Code, rec.Body.String()
+}
+
 func main() {
 
 	// Print out the contents of `single_file.txt`.
@@ -42,4 +109,24 @@ func main() {
 	// TODO: Print string(content1)
 	// TODO: Create content2, _ := folder.ReadFile("folder/file2.hash")
 	// TODO: Print string(content2)
-}
\ No newline at end of file
+
+	// Walk the embedded folder.
+	files, err := listFiles(folder)
+	if err != nil {
+		panic(err)
+	}
+	for _, f := range files {
+		fmt.Println(f.Name, f.Size)
+	}
+
+	// Serve it over HTTP. The requests go straight to the
+	// handler, without a server.
+	h, err := newHandler()
+	if err != nil {
+		panic(err)
+	}
+	for _, path := range []string{"/static/file1.hash", "/static/missing.hash", "/"} {
+		code, body := get(h, path)
+		fmt.Printf("GET %s: %d\n%s", path, code, body)
+	}
+}
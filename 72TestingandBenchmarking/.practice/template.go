@@ -8,65 +8,21 @@
 // typically lives in the same package as the code it tests.
 package main
 
-import (
-	"fmt"
-	"testing"
-)
+import "fmt"
 
 // We'll be testing this simple implementation of an
 // integer minimum. Typically, the code we're testing
 // would be in a source file named something like
 // `intutils.go`, and the test file for it would then
-// be named `intutils_test.go`.
-
-// TODO: Create function IntMin(a, b int) int that returns the minimum of a and b
-
-// A test is created by writing a function with a name
-// beginning with `Test`.
-
-// TODO: Create function TestIntMinBasic(t *testing.T) that tests IntMin(2, -2) = -2
-
-// `t.Error*` will report test failures but continue
-// executing the test. `t.Fatal*` will report test
-// failures and stop the test immediately.
-
-func TestIntMinBasic(t *testing.T) {
-	// TODO: Create ans := IntMin(2, -2)
-	// TODO: If ans != -2, t.Errorf("IntMin(2, -2) = %d; want -2", ans)
+// be named `intutils_test.go`. The tests for this
+// exercise live next to it in the `_test.go` file;
+// run them with `go test -v` and the benchmark with
+// `go test -bench=.`.
+func IntMin(a, b int) int {
+	// TODO: Return a if a < b, otherwise b
+	return 0
 }
 
-// Writing tests can be repetitive, so it's idiomatic to
-// use a *table-driven style*, where test inputs and
-// expected outputs are listed in a table and a single loop
-// walks over them and performs the test logic.
-func TestIntMinTableDriven(t *testing.T) {
-
-	// TODO: Create tests = []struct {
-	// a, b int
-	// want int
-	//}{
-	// {0, 1, 0},
-	// {1, 0, 0},
-	// {2, -2, -2},
-	// {0, -1, -1},
-	// {-1, 0, -1},
-	//}
-
-	// TODO: For _, tt := range tests, create testname := fmt.Sprintf("%d,%d", tt.a, tt.b)
-	// TODO: t.Run(testname, func(t *testing.T) {
-	// TODO: Create ans := IntMin(tt.a, tt.b)
-	// TODO: If ans != tt.want, t.Errorf("got %d, want %d", ans, tt.want)
-	// TODO: })
+func main() {
+	fmt.Println(IntMin(2, -2))
 }
-
-// Benchmark tests typically go in `_test.go` files and are
-// named beginning with `Benchmark`.
-// Any code that's required for the benchmark to run but should
-// not be measured goes before this loop.
-
-// TODO: Create function BenchmarkIntMin(b *testing.B) that benchmarks IntMin(1, 2)
-func BenchmarkIntMin(b *testing.B) {
-	for b.Loop() {
-		// TODO: IntMin(1, 2)
-	}
-}
\ No newline at end of file
@@ -0,0 +1,46 @@
+// [_Command-line arguments_](https://en.wikipedia.org/wiki/Command-line_interface#Arguments)
+// are a common way to parameterize execution of programs.
+// For example, `go run hello.go` uses `run` and
+// `hello.go` arguments to the `go` program.
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// `os.Args` provides access to raw command-line
+// arguments. Note that the first value in this slice
+// is the path to the program, and `os.Args[1:]`
+// holds the arguments to the program.
+func withoutProg(args []string) []string {
+	if len(args) == 0 {
+		return nil
+	}
+	return args[1:]
+}
+
+// You can get individual args with normal indexing,
+// as long as you check there are enough of them.
+func arg(args []string, i int) (string, bool) {
+	if i < 0 || i >= len(args) {
+		return "", false
+	}
+	return args[i], true
+}
+
+func main() {
+	argsWithProg := os.Args
+	argsWithoutProg := withoutProg(os.Args)
+
+	a, ok := arg(os.Args, 3)
+	if !ok {
+		fmt.Println("expected at least 3 arguments")
+		os.Exit(1)
+	}
+
+	fmt.Println(argsWithProg)
+	fmt.Println(argsWithoutProg)
+	fmt.Println(a)
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWithoutProg(t *testing.T) {
+	tests := []struct {
+		args, want []string
+	}{
+		{[]string{"./prog", "a", "b", "c", "d"}, []string{"a", "b", "c", "d"}},
+		{[]string{"./prog"}, []string{}},
+		{nil, nil},
+	}
+
+	for _, tt := range tests {
+		got := withoutProg(tt.args)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("withoutProg(%v): expected %v, got %v", tt.args, tt.want, got)
+		}
+	}
+}
+
+func TestArg(t *testing.T) {
+	args := []string{"./prog", "a", "b", "c", "d"}
+
+	tests := []struct {
+		i    int
+		want string
+		ok   bool
+	}{
+		{0, "./prog", true},
+		{3, "c", true},
+		{4, "d", true},
+		{5, "", false},
+		{-1, "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := arg(args, tt.i)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("arg(%d): expected %q, %v, got %q, %v", tt.i, tt.want, tt.ok, got, ok)
+		}
+	}
+}
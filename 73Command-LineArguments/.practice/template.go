@@ -10,22 +10,35 @@ import (
 	"os"
 )
 
-func main() {
-
-	// `os.Args` provides access to raw command-line
-	// arguments. Note that the first value in this slice
-	// is the path to the program, and `os.Args[1:]`
-	// holds the arguments to the program.
-
-	// TODO: Create argsWithProg := os.Args
-	// TODO: Create argsWithoutProg := os.Args[1:]
+// `os.Args` provides access to raw command-line
+// arguments. Note that the first value in this slice
+// is the path to the program, and `os.Args[1:]`
+// holds the arguments to the program.
+func withoutProg(args []string) []string {
+	// TODO: If args is empty, return nil
+	// TODO: Return args[1:]
+	return nil
+}
+
+// You can get individual args with normal indexing,
+// as long as you check there are enough of them.
+func arg(args []string, i int) (string, bool) {
+	// TODO: If i is out of range for args, return "", false
+	// TODO: Return args[i], true
+	return "", false
+}
 
-	// You can get individual args with normal indexing.
-
-	// TODO: Create arg := os.Args[3]	
-	// TODO: Print arg
-
-	// TODO: Print argsWithProg
-	// TODO: Print argsWithoutProg
-	// TODO: Print arg
-}
\ No newline at end of file
+func main() {
+	argsWithProg := os.Args
+	argsWithoutProg := withoutProg(os.Args)
+
+	a, ok := arg(os.Args, 3)
+	if !ok {
+		fmt.Println("expected at least 3 arguments")
+		os.Exit(1)
+	}
+
+	fmt.Println(argsWithProg)
+	fmt.Println(argsWithoutProg)
+	fmt.Println(a)
+}
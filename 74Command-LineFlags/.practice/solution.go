@@ -0,0 +1,85 @@
+// [_Command-line flags_](https://en.wikipedia.org/wiki/Command-line_interface#Command-line_option)
+// are a common way to specify options for command-line
+// programs. For example, in `wc -l` the `-l` is a
+// command-line flag.
+
+package main
+
+// Go provides a `flag` package supporting basic
+// command-line flag parsing. We'll use this package to
+// implement our example command-line program.
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+// options holds the parsed flags and any trailing
+// positional arguments.
+type options struct {
+	word string
+	numb int
+	fork bool
+	svar string
+	tail []string
+}
+
+// `parseFlags` declares the flags on its own `FlagSet`
+// rather than the global one in `flag`, so it can be
+// called more than once. The package level functions
+// like `flag.String` work the same way on the default
+// set used by `flag.Parse`.
+func parseFlags(args []string) (options, error) {
+	fs := flag.NewFlagSet("flags", flag.ContinueOnError)
+
+	// Basic flag declarations are available for string,
+	// integer, and boolean options. Here we declare a
+	// string flag `word` with a default value `"foo"`
+	// and a short description. This `fs.String` function
+	// returns a string pointer (not a string value);
+	// we'll see how to use this pointer below.
+	wordPtr := fs.String("word", "foo", "a string")
+
+	// This declares `numb` and `fork` flags, using a
+	// similar approach to the `word` flag.
+	numbPtr := fs.Int("numb", 42, "an int")
+	forkPtr := fs.Bool("fork", false, "a bool")
+
+	// It's also possible to declare an option that uses an
+	// existing var declared elsewhere in the program.
+	// Note that we need to pass in a pointer to the flag
+	// declaration function.
+	var svar string
+	fs.StringVar(&svar, "svar", "bar", "a string var")
+
+	// Once all flags are declared, call `Parse`
+	// to execute the command-line parsing.
+	if err := fs.Parse(args); err != nil {
+		return options{}, err
+	}
+
+	// Note that we need to dereference the pointers with
+	// e.g. `*wordPtr` to get the actual option values.
+	return options{
+		word: *wordPtr,
+		numb: *numbPtr,
+		fork: *forkPtr,
+		svar: svar,
+		tail: fs.Args(),
+	}, nil
+}
+
+func main() {
+	opts, err := parseFlags(os.Args[1:])
+	if err != nil {
+		os.Exit(2)
+	}
+
+	// Here we'll just dump out the parsed options and
+	// any trailing positional arguments.
+	fmt.Println("word:", opts.word)
+	fmt.Println("numb:", opts.numb)
+	fmt.Println("fork:", opts.fork)
+	fmt.Println("svar:", opts.svar)
+	fmt.Println("tail:", opts.tail)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"os"
+	"slices"
+	"testing"
+)
+
+func TestParseFlags(t *testing.T) {
+	tests := []struct {
+		args []string
+		want options
+	}{
+		{
+			[]string{"-word=opt", "-numb=7", "-fork", "-svar=flag", "a1", "a2", "a3"},
+			options{word: "opt", numb: 7, fork: true, svar: "flag", tail: []string{"a1", "a2", "a3"}},
+		},
+		{
+			nil,
+			options{word: "foo", numb: 42, fork: false, svar: "bar"},
+		},
+		{
+			[]string{"-word", "opt", "a1", "-numb=7"},
+			options{word: "opt", numb: 42, svar: "bar", tail: []string{"a1", "-numb=7"}},
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := parseFlags(tt.args)
+		if err != nil {
+			t.Fatalf("parseFlags(%v) failed: %v", tt.args, err)
+		}
+		if got.word != tt.want.word || got.numb != tt.want.numb || got.fork != tt.want.fork ||
+			got.svar != tt.want.svar || !slices.Equal(got.tail, tt.want.tail) {
+			t.Errorf("parseFlags(%v): expected %+v, got %+v", tt.args, tt.want, got)
+		}
+	}
+}
+
+func TestParseFlagsErrors(t *testing.T) {
+	// The flag package prints usage to stderr on errors.
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatalf("OpenFile failed: %v", err)
+	}
+	defer devNull.Close()
+	old := os.Stderr
+	os.Stderr = devNull
+	defer func() { os.Stderr = old }()
+
+	for _, args := range [][]string{{"-numb=seven"}, {"-wat"}} {
+		if _, err := parseFlags(args); err == nil {
+			t.Errorf("Expected an error for %v, got nil", args)
+		}
+	}
+}
@@ -9,48 +9,69 @@ package main
 // command-line flag parsing. We'll use this package to
 // implement our example command-line program.
 import (
-	"flag"
 	"fmt"
+	"os"
 )
 
-func main() {
+// options holds the parsed flags and any trailing
+// positional arguments.
+type options struct {
+	word string
+	numb int
+	fork bool
+	svar string
+	tail []string
+}
+
+// `parseFlags` declares the flags on its own `FlagSet`
+// rather than the global one in `flag`, so it can be
+// called more than once. The package level functions
+// like `flag.String` work the same way on the default
+// set used by `flag.Parse`.
+func parseFlags(args []string) (options, error) {
+	// TODO: Create fs := flag.NewFlagSet("flags", flag.ContinueOnError)
 
 	// Basic flag declarations are available for string,
 	// integer, and boolean options. Here we declare a
 	// string flag `word` with a default value `"foo"`
-	// and a short description. This `flag.String` function
+	// and a short description. This `fs.String` function
 	// returns a string pointer (not a string value);
 	// we'll see how to use this pointer below.
-
-	// TODO: Create wordPtr := flag.String("word", "foo", "a string")
+	// TODO: Create wordPtr := fs.String("word", "foo", "a string")
 
 	// This declares `numb` and `fork` flags, using a
 	// similar approach to the `word` flag.
-
-	// TODO: Create numbPtr := flag.Int("numb", 42, "an int")
-	// TODO: Create forkPtr := flag.Bool("fork", false, "a bool")
+	// TODO: Create numbPtr := fs.Int("numb", 42, "an int")
+	// TODO: Create forkPtr := fs.Bool("fork", false, "a bool")
 
 	// It's also possible to declare an option that uses an
 	// existing var declared elsewhere in the program.
 	// Note that we need to pass in a pointer to the flag
 	// declaration function.
-
 	// TODO: Create var svar string
-	// TODO: Create flag.StringVar(&svar, "svar", "bar", "a string var")
+	// TODO: Call fs.StringVar(&svar, "svar", "bar", "a string var")
 
-	// Once all flags are declared, call `flag.Parse()`
+	// Once all flags are declared, call `Parse`
 	// to execute the command-line parsing.
+	// TODO: Call fs.Parse(args), returning options{} and any error
+
+	// Note that we need to dereference the pointers with
+	// e.g. `*wordPtr` to get the actual option values.
+	// TODO: Return options with word, numb, fork, svar and tail: fs.Args()
+	return options{}, nil
+}
 
-	// TODO: Create flag.Parse()
+func main() {
+	opts, err := parseFlags(os.Args[1:])
+	if err != nil {
+		os.Exit(2)
+	}
 
 	// Here we'll just dump out the parsed options and
-	// any trailing positional arguments. Note that we
-	// need to dereference the pointers with e.g. `*wordPtr`
-	// to get the actual option values.
-
-	// TODO: Print word:", *wordPtr)
-	// TODO: Print numb:", *numbPtr)
-	// TODO: Print fork:", *forkPtr)
-	// TODO: Print svar:", svar)
-	// TODO: Print tail:", flag.Args())
-}
\ No newline at end of file
+	// any trailing positional arguments.
+	fmt.Println("word:", opts.word)
+	fmt.Println("numb:", opts.numb)
+	fmt.Println("fork:", opts.fork)
+	fmt.Println("svar:", opts.svar)
+	fmt.Println("tail:", opts.tail)
+}
@@ -0,0 +1,74 @@
+// Some command-line tools, like the `go` tool or `git`
+// have many *subcommands*, each with its own set of
+// flags. For example, `go build` and `go get` are two
+// different subcommands of the `go` tool.
+// The `flag` package lets us easily define simple
+// subcommands that have their own flags.
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+var errSubcommand = errors.New("expected 'foo' or 'bar' subcommands")
+
+// `run` dispatches `args`, the program arguments without
+// the program name, to a subcommand and writes its
+// report to `w`.
+func run(args []string, w io.Writer) error {
+
+	// We declare a subcommand using the `NewFlagSet`
+	// function, and proceed to define new flags specific
+	// for this subcommand.
+	fooCmd := flag.NewFlagSet("foo", flag.ContinueOnError)
+	fooEnable := fooCmd.Bool("enable", false, "enable")
+	fooName := fooCmd.String("name", "", "name")
+
+	// For a different subcommand we can define different
+	// supported flags.
+	barCmd := flag.NewFlagSet("bar", flag.ContinueOnError)
+	barLevel := barCmd.Int("level", 0, "level")
+
+	// The subcommand is expected as the first argument
+	// to the program.
+	if len(args) < 1 {
+		return errSubcommand
+	}
+
+	// Check which subcommand is invoked.
+	switch args[0] {
+
+	// For every subcommand, we parse its own flags and
+	// have access to trailing positional arguments.
+	case "foo":
+		if err := fooCmd.Parse(args[1:]); err != nil {
+			return err
+		}
+		fmt.Fprintln(w, "subcommand 'foo'")
+		fmt.Fprintln(w, "  enable:", *fooEnable)
+		fmt.Fprintln(w, "  name:", *fooName)
+		fmt.Fprintln(w, "  tail:", fooCmd.Args())
+	case "bar":
+		if err := barCmd.Parse(args[1:]); err != nil {
+			return err
+		}
+		fmt.Fprintln(w, "subcommand 'bar'")
+		fmt.Fprintln(w, "  level:", *barLevel)
+		fmt.Fprintln(w, "  tail:", barCmd.Args())
+	default:
+		return errSubcommand
+	}
+	return nil
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{
+			[]string{"foo", "-enable", "-name=joe", "a1", "a2"},
+			"subcommand 'foo'\n  enable: true\n  name: joe\n  tail: [a1 a2]\n",
+		},
+		{
+			[]string{"foo"},
+			"subcommand 'foo'\n  enable: false\n  name: \n  tail: []\n",
+		},
+		{
+			[]string{"bar", "-level", "8", "a1"},
+			"subcommand 'bar'\n  level: 8\n  tail: [a1]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		var out bytes.Buffer
+		if err := run(tt.args, &out); err != nil {
+			t.Fatalf("run(%v) failed: %v", tt.args, err)
+		}
+		if out.String() != tt.want {
+			t.Errorf("run(%v): expected %q, got %q", tt.args, tt.want, out.String())
+		}
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	for _, args := range [][]string{nil, {"baz"}} {
+		var out bytes.Buffer
+		if err := run(args, &out); !errors.Is(err, errSubcommand) {
+			t.Errorf("run(%v): expected %v, got %v", args, errSubcommand, err)
+		}
+	}
+
+	// Flags belong to their subcommand: `bar` has no
+	// `-enable` flag. The flag package prints usage to
+	// stderr on errors.
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatalf("OpenFile failed: %v", err)
+	}
+	defer devNull.Close()
+	old := os.Stderr
+	os.Stderr = devNull
+	defer func() { os.Stderr = old }()
+
+	var out bytes.Buffer
+	if err := run([]string{"bar", "-enable"}, &out); err == nil {
+		t.Error("Expected an error for bar -enable, got nil")
+	}
+}
@@ -8,40 +8,52 @@
 package main
 
 import (
-	"flag"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 )
 
-func main() {
+var errSubcommand = errors.New("expected 'foo' or 'bar' subcommands")
+
+// `run` dispatches `args`, the program arguments without
+// the program name, to a subcommand and writes its
+// report to `w`.
+func run(args []string, w io.Writer) error {
 
 	// We declare a subcommand using the `NewFlagSet`
 	// function, and proceed to define new flags specific
 	// for this subcommand.
-
-	// TODO: Create fooCmd := flag.NewFlagSet("foo", flag.ExitOnError)
+	// TODO: Create fooCmd := flag.NewFlagSet("foo", flag.ContinueOnError)
 	// TODO: Create fooEnable := fooCmd.Bool("enable", false, "enable")
 	// TODO: Create fooName := fooCmd.String("name", "", "name")
 
 	// For a different subcommand we can define different
 	// supported flags.
-
-	// TODO: Create barCmd := flag.NewFlagSet("bar", flag.ExitOnError)
+	// TODO: Create barCmd := flag.NewFlagSet("bar", flag.ContinueOnError)
 	// TODO: Create barLevel := barCmd.Int("level", 0, "level")
 
 	// The subcommand is expected as the first argument
 	// to the program.
-
-	// TODO: If len(os.Args) < 2, fmt.Println("expected 'foo' or 'bar' subcommands")
-	// TODO: os.Exit(1)
+	// TODO: If len(args) < 1, return errSubcommand
 
 	// Check which subcommand is invoked.
-
-	// TODO: switch os.Args[1] {
-	// If the case is "foo", parse the fooCmd flags, if the case is "bar", parse the barCmd flags.
-	// default print "expected 'foo' or 'bar' subcommands" and exit with 1
+	// TODO: switch args[0] {
 
 	// For every subcommand, we parse its own flags and
 	// have access to trailing positional arguments.
-	
-}
\ No newline at end of file
+
+	// TODO: case "foo": parse fooCmd with args[1:], returning any error, then write to w
+	// "subcommand 'foo'", "  enable:", *fooEnable, "  name:", *fooName and "  tail:", fooCmd.Args()
+	// TODO: case "bar": parse barCmd with args[1:], returning any error, then write to w
+	// "subcommand 'bar'", "  level:", *barLevel and "  tail:", barCmd.Args()
+	// TODO: default: return errSubcommand
+	return nil
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
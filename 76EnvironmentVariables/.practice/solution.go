@@ -0,0 +1,51 @@
+// [Environment variables](https://en.wikipedia.org/wiki/Environment_variable)
+// are a universal mechanism for [conveying configuration
+// information to Unix programs](https://www.12factor.net/config).
+// Let's look at how to set, get, and list environment variables.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// To set a key/value pair, use `os.Setenv`. To get a
+// value for a key, use `os.Getenv`. This will return
+// an empty string if the key isn't present in the
+// environment; `os.LookupEnv` also reports whether
+// the key was set at all.
+func setAndGet(key, value string) (string, error) {
+	if err := os.Setenv(key, value); err != nil {
+		return "", err
+	}
+	return os.Getenv(key), nil
+}
+
+// Use `os.Environ` to list all key/value pairs in the
+// environment. This returns a slice of strings in the
+// form `KEY=value`. You can `strings.SplitN` them to
+// get the key and value. Here we collect all the keys.
+func envKeys(environ []string) []string {
+	var keys []string
+	for _, e := range environ {
+		pair := strings.SplitN(e, "=", 2)
+		keys = append(keys, pair[0])
+	}
+	return keys
+}
+
+func main() {
+	foo, err := setAndGet("FOO", "1")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("FOO:", foo)
+	fmt.Println("BAR:", os.Getenv("BAR"))
+
+	fmt.Println()
+	for _, key := range envKeys(os.Environ()) {
+		fmt.Println(key)
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"os"
+	"slices"
+	"testing"
+)
+
+func TestSetAndGet(t *testing.T) {
+	// Setenv registers a cleanup that restores FOO after
+	// the test.
+	t.Setenv("FOO", "")
+
+	got, err := setAndGet("FOO", "1")
+	if err != nil {
+		t.Fatalf("setAndGet failed: %v", err)
+	}
+	if got != "1" {
+		t.Errorf("Expected %q, got %q", "1", got)
+	}
+	if v := os.Getenv("FOO"); v != "1" {
+		t.Errorf("Expected FOO=1 in the environment, got %q", v)
+	}
+
+	if _, err := setAndGet("", "1"); err == nil {
+		t.Error("Expected an error for an empty key, got nil")
+	}
+}
+
+func TestEnvKeys(t *testing.T) {
+	tests := []struct {
+		environ, want []string
+	}{
+		{[]string{"PATH=/usr/bin:/bin", "FOO=1"}, []string{"PATH", "FOO"}},
+		{[]string{"EQ=a=b", "EMPTY="}, []string{"EQ", "EMPTY"}},
+		{nil, nil},
+	}
+
+	for _, tt := range tests {
+		if got := envKeys(tt.environ); !slices.Equal(got, tt.want) {
+			t.Errorf("envKeys(%v): expected %v, got %v", tt.environ, tt.want, got)
+		}
+	}
+}
@@ -8,25 +8,38 @@ package main
 import (
 	"fmt"
 	"os"
-	"strings"
 )
 
-func main() {
+// To set a key/value pair, use `os.Setenv`. To get a
+// value for a key, use `os.Getenv`. This will return
+// an empty string if the key isn't present in the
+// environment; `os.LookupEnv` also reports whether
+// the key was set at all.
+func setAndGet(key, value string) (string, error) {
+	// TODO: Call os.Setenv(key, value), returning any error
+	// TODO: Return os.Getenv(key)
+	return "", nil
+}
 
-	// To set a key/value pair, use `os.Setenv`. To get a
-	// value for a key, use `os.Getenv`. This will return
-	// an empty string if the key isn't present in the
-	// environment.
+// Use `os.Environ` to list all key/value pairs in the
+// environment. This returns a slice of strings in the
+// form `KEY=value`. You can `strings.SplitN` them to
+// get the key and value. Here we collect all the keys.
+func envKeys(environ []string) []string {
+	// TODO: For each e in environ, use strings.SplitN(e, "=", 2) and collect the key
+	return nil
+}
 
-	// TODO: Create os.Setenv("FOO", "1")
-	// TODO: Create fmt.Println("FOO:", os.Getenv("FOO"))
-	// TODO: Create fmt.Println("BAR:", os.Getenv("BAR"))
+func main() {
+	foo, err := setAndGet("FOO", "1")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("FOO:", foo)
+	fmt.Println("BAR:", os.Getenv("BAR"))
 
-	// Use `os.Environ` to list all key/value pairs in the
-	// environment. This returns a slice of strings in the
-	// form `KEY=value`. You can `strings.SplitN` them to
-	// get the key and value. Here we print all the keys.
 	fmt.Println()
-	// TODO: iterate over os.Environ() with range and print the key
-	// Use strings.SplitN(e, "=", 2) to get the key and value
-}
\ No newline at end of file
+	for _, key := range envKeys(os.Environ()) {
+		fmt.Println(key)
+	}
+}
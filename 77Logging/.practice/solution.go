@@ -0,0 +1,339 @@
+// The Go standard library provides straightforward
+// tools for outputting logs from Go programs, with the
+// [log](https://pkg.go.dev/log) package for free-form
+// output and the [log/slog](https://pkg.go.dev/log/slog)
+// package for structured output.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Log lines start with the time they were written, which
+// changes on every run. maskTime replaces the times in s
+// with the layout they are written in, so that the
+// output of this program stays the same.
+var timestamp = regexp.MustCompile(`\d{4}/\d\d/\d\d \d\d:\d\d:\d\d(\.\d{6})?`)
+
+func maskTime(s string) string {
+	return timestamp.ReplaceAllStringFunc(s, func(t string) string {
+		if len(t) > 19 {
+			return "2006/01/02 15:04:05.000000"
+		}
+		return "2006/01/02 15:04:05"
+	})
+}
+
+// dropTime is a `ReplaceAttr` function for slog handlers,
+// called for every attribute they write. It drops the
+// time of records, for the same reason, by returning an
+// empty attribute. `groups` is empty for the attributes
+// at the top level, where the handler puts the time.
+func dropTime(groups []string, a slog.Attr) slog.Attr {
+	if a.Key == slog.TimeKey && len(groups) == 0 {
+		return slog.Attr{}
+	}
+	return a
+}
+
+// Secret is a string that must not appear in logs. slog
+// calls the `LogValue` method of values implementing
+// `slog.LogValuer` and logs what it returns instead.
+type Secret string
+
+func (Secret) LogValue() slog.Value {
+	return slog.StringValue("REDACTED")
+}
+
+// User logs as a group of its public fields: the
+// password is left out, instead of redacted.
+type User struct {
+	ID       int
+	Name     string
+	Password Secret
+}
+
+func (u User) LogValue() slog.Value {
+	return slog.GroupValue(slog.Int("id", u.ID), slog.String("name", u.Name))
+}
+
+// Entry is a record as a RingHandler keeps it, with the
+// attributes of its logger in their groups.
+type Entry struct {
+	Time    time.Time
+	Level   slog.Level
+	Message string
+	Attrs   []slog.Attr
+}
+
+// String formats e like the text handler, without the
+// time and with less quoting: the keys of attributes in
+// groups are prefixed with the group's name and a dot.
+func (e Entry) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s", e.Level, e.Message)
+	var write func(prefix string, attrs []slog.Attr)
+	write = func(prefix string, attrs []slog.Attr) {
+		for _, a := range attrs {
+			if a.Value.Kind() == slog.KindGroup {
+				write(prefix+a.Key+".", a.Value.Group())
+				continue
+			}
+			fmt.Fprintf(&b, " %s%s=%v", prefix, a.Key, a.Value)
+		}
+	}
+	write("", e.Attrs)
+	return b.String()
+}
+
+// ring holds the last entries logged. It's shared by a
+// RingHandler and the handlers derived from it with
+// `WithAttrs` and `WithGroup`, and locked because loggers
+// may be used from several goroutines.
+type ring struct {
+	mu      sync.Mutex
+	entries []Entry
+	next    int // where the next entry goes
+	full    bool
+}
+
+func (r *ring) add(e Entry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries[r.next] = e
+	r.next = (r.next + 1) % len(r.entries)
+	r.full = r.full || r.next == 0
+}
+
+// RingHandler is a `slog.Handler` that keeps the last
+// records logged in memory, e.g. to attach them to a
+// crash report or show them on a debug page.
+type RingHandler struct {
+	ring  *ring
+	level slog.Leveler
+
+	// goas are the groups and attributes of the logger, in
+	// the order they were added.
+	goas []groupOrAttrs
+}
+
+// groupOrAttrs is a group opened with `WithGroup`, or
+// attributes added with `WithAttrs`.
+type groupOrAttrs struct {
+	group string
+	attrs []slog.Attr
+}
+
+// NewRingHandler returns a handler that keeps the last
+// size records of level at least level, or Info if level
+// is nil.
+func NewRingHandler(size int, level slog.Leveler) *RingHandler {
+	if level == nil {
+		level = slog.LevelInfo
+	}
+	return &RingHandler{ring: &ring{entries: make([]Entry, size)}, level: level}
+}
+
+// Enabled is called before a record is made, so that
+// loggers don't do the work for records that would be
+// dropped.
+func (h *RingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.level.Level()
+}
+
+// WithAttrs and WithGroup return a new handler, leaving h
+// as it is. `slices.Clip` makes sure that handlers
+// derived from the same one don't append to the same
+// array.
+func (h *RingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	h2 := *h
+	h2.goas = append(slices.Clip(h.goas), groupOrAttrs{attrs: attrs})
+	return &h2
+}
+
+func (h *RingHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	h2 := *h
+	h2.goas = append(slices.Clip(h.goas), groupOrAttrs{group: name})
+	return &h2
+}
+
+// Handle keeps r. The attributes of the record go in the
+// last group opened, so they are nested from the inside
+// out, starting with them.
+func (h *RingHandler) Handle(ctx context.Context, r slog.Record) error {
+	attrs := make([]slog.Attr, 0, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+	attrs = normalize(attrs)
+	for i := len(h.goas) - 1; i >= 0; i-- {
+		g := h.goas[i]
+		if g.group == "" {
+			attrs = append(normalize(g.attrs), attrs...)
+		} else if len(attrs) > 0 {
+			attrs = []slog.Attr{slog.Group(g.group, attrsToAny(attrs)...)}
+		}
+	}
+	h.ring.add(Entry{Time: r.Time, Level: r.Level, Message: r.Message, Attrs: attrs})
+	return nil
+}
+
+// normalize applies the rules every handler follows:
+// values are resolved, so a LogValuer is replaced by its
+// value; empty attributes and groups are dropped; and the
+// attributes of groups without a key are inlined.
+func normalize(attrs []slog.Attr) []slog.Attr {
+	var out []slog.Attr
+	for _, a := range attrs {
+		a.Value = a.Value.Resolve()
+		if a.Equal(slog.Attr{}) {
+			continue
+		}
+		if a.Value.Kind() != slog.KindGroup {
+			out = append(out, a)
+			continue
+		}
+		group := normalize(a.Value.Group())
+		switch {
+		case len(group) == 0:
+		case a.Key == "":
+			out = append(out, group...)
+		default:
+			out = append(out, slog.Attr{Key: a.Key, Value: slog.GroupValue(group...)})
+		}
+	}
+	return out
+}
+
+func attrsToAny(attrs []slog.Attr) []any {
+	args := make([]any, len(attrs))
+	for i, a := range attrs {
+		args[i] = a
+	}
+	return args
+}
+
+// Entries returns the records kept, oldest first.
+func (h *RingHandler) Entries() []Entry {
+	r := h.ring
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.full {
+		return slices.Clone(r.entries[:r.next])
+	}
+	return slices.Concat(r.entries[r.next:], r.entries[:r.next])
+}
+
+func main() {
+	// Simply invoking functions like `Println` from the
+	// `log` package uses the _standard_ logger, which is
+	// already pre-configured for reasonable logging
+	// output to `os.Stderr`. It writes to a buffer here,
+	// to print its output with the times masked.
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	log.Println("standard logger")
+
+	// Loggers can be configured with _flags_ to set their
+	// output format. By default, the standard logger has
+	// the `log.Ldate` and `log.Ltime` flags set, and these
+	// are collected in `log.LstdFlags`. We can change its
+	// flags to emit time with microsecond accuracy, for
+	// example. `log.Lshortfile` would add the file name
+	// and line of the call.
+	log.SetFlags(log.LstdFlags | log.Lmicroseconds)
+	log.Println("with micro")
+
+	// It's also possible to create a custom logger and
+	// pass it around. When creating a new logger, we can
+	// set a _prefix_ to distinguish its output from other
+	// loggers.
+	mylog := log.New(&buf, "my:", log.LstdFlags)
+	mylog.Println("from mylog")
+
+	// We can set the prefix on existing loggers
+	// (including the standard one) with the `SetPrefix`
+	// method. With `log.Lmsgprefix`, the prefix goes
+	// right before the message instead of at the start
+	// of the line.
+	mylog.SetPrefix("ohmy: ")
+	mylog.SetFlags(log.LstdFlags | log.Lmsgprefix)
+	mylog.Println("from mylog")
+	fmt.Print(maskTime(buf.String()))
+
+	// Loggers can have custom output targets; any
+	// `io.Writer` works. Without flags, only the prefix
+	// and message are written.
+	buf.Reset()
+	buflog := log.New(&buf, "buf:", 0)
+	buflog.Println("hello")
+	fmt.Print("from buflog:", buf.String())
+
+	// The `slog` package provides _structured_ log output.
+	// Records have a level, a message and attributes,
+	// given as alternating keys and values or with
+	// functions like `slog.Int`. The text handler writes
+	// them as `key=value` pairs.
+	noTime := &slog.HandlerOptions{ReplaceAttr: dropTime}
+	text := slog.New(slog.NewTextHandler(os.Stdout, noTime))
+	text.Info("hi there")
+	text.Info("hello again", "key", "val", slog.Int("age", 25))
+
+	// The JSON handler writes one JSON object per line,
+	// for programs reading logs.
+	jsonLog := slog.New(slog.NewJSONHandler(os.Stdout, noTime))
+	jsonLog.Info("hi there")
+	jsonLog.Info("hello again", "key", "val", "age", 25)
+
+	// Groups nest attributes. `With` returns a logger
+	// adding attributes to every record, and `WithGroup`
+	// one putting the attributes that follow in a group.
+	jsonLog.Info("request", slog.Group("req", "method", "GET", "path", "/users"), "status", 200)
+	reqLog := jsonLog.With("request_id", "r-42").WithGroup("user")
+	reqLog.Info("login", "id", 7, "admin", false)
+
+	// Records below the handler's level are dropped.
+	// A `slog.LevelVar` changes the level while the
+	// program runs.
+	var level slog.LevelVar
+	leveled := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: &level, ReplaceAttr: dropTime}))
+	leveled.Debug("not shown")
+	level.Set(slog.LevelDebug)
+	leveled.Debug("shown")
+
+	// Values implementing `slog.LogValuer` choose how
+	// they're logged, which keeps secrets out of logs.
+	u := User{ID: 7, Name: "ada", Password: "hunter2"}
+	jsonLog.Info("signed up", "user", u, "token", Secret("s3cr3t"))
+
+	// A handler of our own keeps the last three records
+	// in memory.
+	ring := NewRingHandler(3, nil)
+	logger := slog.New(ring).With("svc", "api")
+	for i := range 5 {
+		logger.Info("tick", "n", i)
+	}
+	logger.WithGroup("db").Warn("slow query", "ms", 250, "user", u)
+	logger.Debug("dropped")
+	for _, e := range ring.Entries() {
+		fmt.Println(e)
+	}
+}
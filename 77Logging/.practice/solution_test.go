@@ -0,0 +1,238 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"log/slog"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"testing/slogtest"
+	"time"
+)
+
+// restoreStandardLogger undoes the changes main makes to
+// the standard logger at the end of the test.
+func restoreStandardLogger(t *testing.T) {
+	flags, prefix, w := log.Flags(), log.Prefix(), log.Writer()
+	t.Cleanup(func() {
+		log.SetFlags(flags)
+		log.SetPrefix(prefix)
+		log.SetOutput(w)
+	})
+}
+
+func TestLogFlags(t *testing.T) {
+	tests := []struct {
+		prefix string
+		flags  int
+		want   string
+	}{
+		{"", 0, `^hello\n$`},
+		{"p: ", 0, `^p: hello\n$`},
+		{"", log.LstdFlags, `^\d{4}/\d\d/\d\d \d\d:\d\d:\d\d hello\n$`},
+		{"", log.Ltime | log.Lmicroseconds, `^\d\d:\d\d:\d\d\.\d{6} hello\n$`},
+		{"p: ", log.Ldate, `^p: \d{4}/\d\d/\d\d hello\n$`},
+		{"p: ", log.Ldate | log.Lmsgprefix, `^\d{4}/\d\d/\d\d p: hello\n$`},
+		{"", log.Lshortfile, `^logging_test\.go:\d+: hello\n$`},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		l := log.New(&buf, tt.prefix, tt.flags)
+		l.Println("hello")
+		if !regexp.MustCompile(tt.want).MatchString(buf.String()) {
+			t.Errorf("Flags %d, prefix %q: expected %s, got %q", tt.flags, tt.prefix, tt.want, buf.String())
+		}
+	}
+}
+
+func TestMaskTime(t *testing.T) {
+	var buf bytes.Buffer
+	log.New(&buf, "x: ", log.LstdFlags|log.Lmicroseconds).Println("a")
+	log.New(&buf, "x: ", log.LstdFlags).Println("b")
+	want := "x: 2006/01/02 15:04:05.000000 a\nx: 2006/01/02 15:04:05 b\n"
+	if got := maskTime(buf.String()); got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+// decode parses the lines written by a JSON handler.
+func decode(t *testing.T, out string) []map[string]any {
+	t.Helper()
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\n"), "\n") {
+		var m map[string]any
+		if err := json.Unmarshal([]byte(line), &m); err != nil {
+			t.Fatalf("Expected a JSON object, got %q: %v", line, err)
+		}
+		records = append(records, m)
+	}
+	return records
+}
+
+func TestJSONHandler(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	logger.With("request_id", "r-1").WithGroup("req").Warn("slow", "ms", 250, slog.Group("client", "ip", "10.0.0.1"))
+
+	records := decode(t, buf.String())
+	if len(records) != 1 {
+		t.Fatalf("Expected 1 record, got %d", len(records))
+	}
+	r := records[0]
+	if r["level"] != "WARN" || r["msg"] != "slow" || r["request_id"] != "r-1" {
+		t.Errorf("Expected level, msg and request_id, got %v", r)
+	}
+	if _, err := time.Parse(time.RFC3339Nano, r["time"].(string)); err != nil {
+		t.Errorf("Expected an RFC 3339 time, got %v: %v", r["time"], err)
+	}
+	// JSON numbers decode as float64.
+	req, _ := r["req"].(map[string]any)
+	if req["ms"] != 250.0 {
+		t.Errorf("Expected req.ms to be 250, got %v", r["req"])
+	}
+	if client, _ := req["client"].(map[string]any); client["ip"] != "10.0.0.1" {
+		t.Errorf("Expected req.client.ip, got %v", r["req"])
+	}
+}
+
+func TestDropTime(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: dropTime}))
+	// Only the time of the record is dropped.
+	logger.Info("hi", slog.Group("g", slog.String("time", "noon")))
+
+	r := decode(t, buf.String())[0]
+	if _, ok := r["time"]; ok {
+		t.Errorf("Expected no time, got %v", r)
+	}
+	if g, _ := r["g"].(map[string]any); g["time"] != "noon" {
+		t.Errorf("Expected g.time to stay, got %v", r)
+	}
+}
+
+func TestRedaction(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	u := User{ID: 3, Name: "bob", Password: "hunter2"}
+	logger.Info("login", "user", u, "token", Secret("s3cr3t"))
+	logger.WithGroup("auth").With("key", Secret("k3y")).Info("refresh")
+
+	if strings.Contains(buf.String(), "hunter2") || strings.Contains(buf.String(), "s3cr3t") || strings.Contains(buf.String(), "k3y") {
+		t.Fatalf("Expected secrets to be redacted, got %s", buf.String())
+	}
+	records := decode(t, buf.String())
+	if records[0]["token"] != "REDACTED" {
+		t.Errorf("Expected the token to be REDACTED, got %v", records[0]["token"])
+	}
+	user, _ := records[0]["user"].(map[string]any)
+	if len(user) != 2 || user["id"] != 3.0 || user["name"] != "bob" {
+		t.Errorf("Expected the user's id and name only, got %v", records[0]["user"])
+	}
+	if auth, _ := records[1]["auth"].(map[string]any); auth["key"] != "REDACTED" {
+		t.Errorf("Expected auth.key to be REDACTED, got %v", records[1])
+	}
+}
+
+// entryMap turns an entry into the map slogtest expects:
+// groups become nested maps.
+func entryMap(e Entry) map[string]any {
+	m := map[string]any{slog.LevelKey: e.Level, slog.MessageKey: e.Message}
+	if !e.Time.IsZero() {
+		m[slog.TimeKey] = e.Time
+	}
+	var add func(m map[string]any, attrs []slog.Attr)
+	add = func(m map[string]any, attrs []slog.Attr) {
+		for _, a := range attrs {
+			if a.Value.Kind() == slog.KindGroup {
+				g := map[string]any{}
+				add(g, a.Value.Group())
+				m[a.Key] = g
+				continue
+			}
+			m[a.Key] = a.Value.Any()
+		}
+	}
+	add(m, e.Attrs)
+	return m
+}
+
+func TestRingHandlerConformance(t *testing.T) {
+	// slogtest checks the rules every handler must follow,
+	// with one record per subtest.
+	var h *RingHandler
+	slogtest.Run(t,
+		func(*testing.T) slog.Handler {
+			h = NewRingHandler(1, nil)
+			return h
+		},
+		func(t *testing.T) map[string]any {
+			entries := h.Entries()
+			if len(entries) != 1 {
+				t.Fatalf("Expected 1 entry, got %d", len(entries))
+			}
+			return entryMap(entries[0])
+		},
+	)
+}
+
+func TestRingHandler(t *testing.T) {
+	h := NewRingHandler(3, slog.LevelWarn)
+	logger := slog.New(h)
+	if len(h.Entries()) != 0 {
+		t.Errorf("Expected no entries, got %v", h.Entries())
+	}
+	logger.Info("dropped")
+	logger.Warn("one")
+	logger.Error("two")
+	if got := len(h.Entries()); got != 2 {
+		t.Fatalf("Expected 2 entries, got %d", got)
+	}
+
+	// Once full, the oldest entries are overwritten.
+	for _, msg := range []string{"three", "four", "five"} {
+		logger.Warn(msg)
+	}
+	var msgs []string
+	for _, e := range h.Entries() {
+		msgs = append(msgs, e.Message)
+	}
+	if strings.Join(msgs, " ") != "three four five" {
+		t.Errorf("Expected three four five, got %v", msgs)
+	}
+
+	// Derived handlers share the ring.
+	logger.With("a", 1).WithGroup("g").Warn("six", "b", 2)
+	if got := h.Entries()[2].String(); got != "WARN six a=1 g.b=2" {
+		t.Errorf("Expected %q, got %q", "WARN six a=1 g.b=2", got)
+	}
+}
+
+func TestRingHandlerConcurrent(t *testing.T) {
+	h := NewRingHandler(50, nil)
+	logger := slog.New(h)
+	var wg sync.WaitGroup
+	for g := range 10 {
+		l := logger.With("g", g)
+		wg.Go(func() {
+			for i := range 100 {
+				l.Info("msg", "i", i)
+			}
+		})
+	}
+	wg.Wait()
+	if got := len(h.Entries()); got != 50 {
+		t.Errorf("Expected 50 entries, got %d", got)
+	}
+}
+
+func TestEntryString(t *testing.T) {
+	h := NewRingHandler(1, nil)
+	slog.New(h).Info("hi", "s", "a b", slog.Group("g", "x", 1, slog.Group("h", "y", true)), "user", User{ID: 1, Name: "ada"})
+	want := "INFO hi s=a b g.x=1 g.h.y=true user.id=1 user.name=ada"
+	if got := h.Entries()[0].String(); got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
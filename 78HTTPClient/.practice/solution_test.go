@@ -0,0 +1,295 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFetch(t *testing.T) {
+	// `httptest.NewServer` serves a handler on a local
+	// port, so the test doesn't need the network.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for i := 1; i <= 7; i++ {
+			fmt.Fprintf(w, "line %d\n", i)
+		}
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		n    int
+		want []string
+	}{
+		{5, []string{"line 1", "line 2", "line 3", "line 4", "line 5"}},
+		{1, []string{"line 1"}},
+		{10, []string{"line 1", "line 2", "line 3", "line 4", "line 5", "line 6", "line 7"}},
+	}
+
+	for _, tt := range tests {
+		status, lines, err := fetch(srv.URL, tt.n)
+		if err != nil {
+			t.Fatalf("fetch failed: %v", err)
+		}
+		if status != "200 OK" {
+			t.Errorf("Expected status %q, got %q", "200 OK", status)
+		}
+		if !slices.Equal(lines, tt.want) {
+			t.Errorf("fetch(%d): expected %v, got %v", tt.n, tt.want, lines)
+		}
+	}
+}
+
+func TestFetchStatus(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	// A non-2xx status is not an error for `http.Get`.
+	status, _, err := fetch(srv.URL, 5)
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if status != "404 Not Found" {
+		t.Errorf("Expected status %q, got %q", "404 Not Found", status)
+	}
+}
+
+func TestFetchError(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+
+	if _, _, err := fetch(url, 5); err == nil {
+		t.Error("Expected an error fetching from a closed server, got nil")
+	}
+}
+
+// failing returns a server that fails the first n
+// requests with status, and counts the requests.
+func failing(t *testing.T, n int64, status int, requests *atomic.Int64) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= n {
+			http.Error(w, "failed", status)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		fmt.Fprintf(w, "ok %s\n", body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestNewClient(t *testing.T) {
+	c := NewClient("http://example.com/")
+	if c.BaseURL != "http://example.com" {
+		t.Errorf("Expected the trailing slash to be trimmed, got %q", c.BaseURL)
+	}
+	if c.HTTP == http.DefaultClient || c.HTTP.Timeout == 0 {
+		t.Errorf("Expected a client of its own with a timeout, got %+v", c.HTTP)
+	}
+	tr, ok := c.HTTP.Transport.(*http.Transport)
+	if !ok || tr == http.DefaultTransport {
+		t.Fatalf("Expected a transport of its own, got %T", c.HTTP.Transport)
+	}
+	if tr.ResponseHeaderTimeout == 0 || tr.TLSHandshakeTimeout == 0 || tr.MaxIdleConnsPerHost == 0 {
+		t.Errorf("Expected the transport's limits to be set, got %+v", tr)
+	}
+	// The clone keeps the default proxy settings.
+	if tr.Proxy == nil {
+		t.Error("Expected the transport to use the proxy of the environment")
+	}
+}
+
+func TestClientFetch(t *testing.T) {
+	site := newSite()
+	defer site.Close()
+	c := NewClient(site.URL)
+
+	status, lines, err := c.Fetch(context.Background(), "/", 3)
+	if err != nil {
+		t.Fatalf("Fetch failed: %v", err)
+	}
+	want := []string{"<!DOCTYPE html>", "<html>", "  <head>"}
+	if status != "200 OK" || !slices.Equal(lines, want) {
+		t.Errorf("Expected 200 OK %q, got %s %q", want, status, lines)
+	}
+
+	status, _, err = c.Fetch(context.Background(), "/missing", 3)
+	if err != nil || status != "404 Not Found" {
+		t.Errorf("Expected 404 Not Found, got %q, %v", status, err)
+	}
+}
+
+func TestRetries(t *testing.T) {
+	tests := []struct {
+		failures   int64
+		status     int
+		maxRetries int
+		wantStatus string
+		wantReqs   int64
+	}{
+		// 5xx responses are retried, up to MaxRetries times.
+		{2, http.StatusServiceUnavailable, 3, "200 OK", 3},
+		{3, http.StatusInternalServerError, 3, "200 OK", 4},
+		{4, http.StatusBadGateway, 3, "502 Bad Gateway", 4},
+		{1, http.StatusServiceUnavailable, 0, "503 Service Unavailable", 1},
+		// Other errors aren't.
+		{1, http.StatusBadRequest, 3, "400 Bad Request", 1},
+		{1, http.StatusTooManyRequests, 3, "429 Too Many Requests", 1},
+	}
+	for _, tt := range tests {
+		var requests atomic.Int64
+		srv := failing(t, tt.failures, tt.status, &requests)
+		c := NewClient(srv.URL)
+		c.MaxRetries = tt.maxRetries
+		c.Backoff = time.Millisecond
+
+		status, _, err := c.Fetch(context.Background(), "/", 1)
+		if err != nil {
+			t.Fatalf("Fetch failed: %v", err)
+		}
+		if status != tt.wantStatus || requests.Load() != tt.wantReqs {
+			t.Errorf("%d failures with %d: expected %s after %d requests, got %s after %d",
+				tt.failures, tt.status, tt.wantStatus, tt.wantReqs, status, requests.Load())
+		}
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	var times []time.Time
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		times = append(times, time.Now())
+		if len(times) <= 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	c.Backoff = 20 * time.Millisecond
+	if status, _, err := c.Fetch(context.Background(), "/", 1); err != nil || status != "200 OK" {
+		t.Fatalf("Expected 200 OK, got %q, %v", status, err)
+	}
+	// The waits double: 20ms, 40ms and 80ms.
+	for i := 1; i < len(times); i++ {
+		want := c.Backoff << (i - 1)
+		if got := times[i].Sub(times[i-1]); got < want {
+			t.Errorf("Expected retry %d after at least %v, got %v", i, want, got)
+		}
+	}
+}
+
+func TestPostJSON(t *testing.T) {
+	site := newSite()
+	defer site.Close()
+	c := NewClient(site.URL)
+
+	for i, title := range []string{"first", "second"} {
+		var got Note
+		if err := c.PostJSON(context.Background(), "/api/notes", Note{Title: title}, &got); err != nil {
+			t.Fatalf("PostJSON failed: %v", err)
+		}
+		if want := (Note{ID: i + 1, Title: title}); got != want {
+			t.Errorf("Expected %+v, got %+v", want, got)
+		}
+	}
+
+	err := c.PostJSON(context.Background(), "/api/notes", Note{}, &Note{})
+	var se *StatusError
+	if !errors.As(err, &se) || se.Status != "400 Bad Request" || se.Body != "a note needs a title" {
+		t.Errorf("Expected a StatusError, got %#v", err)
+	}
+	if err := c.PostJSON(context.Background(), "/api/notes", func() {}, &Note{}); err == nil {
+		t.Error("Expected an error for a value JSON can't encode")
+	}
+}
+
+func TestPostJSONRequest(t *testing.T) {
+	// The request is JSON, and is sent again in full when
+	// it's retried.
+	var requests atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Expected a JSON POST, got %s %q", r.Method, r.Header.Get("Content-Type"))
+		}
+		var n Note
+		if err := json.NewDecoder(r.Body).Decode(&n); err != nil || n.Title != "retry me" {
+			t.Errorf("Expected the note, got %+v, %v", n, err)
+		}
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(Note{ID: 9, Title: n.Title})
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	c.Backoff = time.Millisecond
+	var got Note
+	if err := c.PostJSON(context.Background(), "/", Note{Title: "retry me"}, &got); err != nil {
+		t.Fatalf("PostJSON failed: %v", err)
+	}
+	if got.ID != 9 || requests.Load() != 2 {
+		t.Errorf("Expected note 9 after 2 requests, got %+v after %d", got, requests.Load())
+	}
+}
+
+func TestCancel(t *testing.T) {
+	site := newSite()
+	defer site.Close()
+	c := NewClient(site.URL)
+
+	// A deadline aborts a request in flight.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, _, err := c.Fetch(ctx, "/slow", 1)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected DeadlineExceeded, got %v", err)
+	}
+	if d := time.Since(start); d > 500*time.Millisecond {
+		t.Errorf("Expected the request to be aborted, took %v", d)
+	}
+
+	// Cancelling stops the waits between retries too.
+	var requests atomic.Int64
+	srv := failing(t, 100, http.StatusServiceUnavailable, &requests)
+	c = NewClient(srv.URL)
+	c.Backoff = time.Hour
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	if _, _, err := c.Fetch(ctx, "/", 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected Canceled, got %v", err)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("Expected 1 request, got %d", requests.Load())
+	}
+
+	// A request isn't sent at all with a context that's
+	// already done.
+	if _, _, err := c.Fetch(ctx, "/", 1); !errors.Is(err, context.Canceled) || requests.Load() != 1 {
+		t.Errorf("Expected Canceled without a request, got %v after %d", err, requests.Load())
+	}
+}
+
+func TestClientTimeout(t *testing.T) {
+	site := newSite()
+	defer site.Close()
+	c := NewClient(site.URL)
+	c.HTTP.Timeout = 20 * time.Millisecond
+
+	_, _, err := c.Fetch(context.Background(), "/slow", 1)
+	var ne net.Error
+	if !errors.As(err, &ne) || !ne.Timeout() {
+		t.Errorf("Expected a timeout, got %v", err)
+	}
+}
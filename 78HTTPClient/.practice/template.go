@@ -1,39 +1,285 @@
 // The Go standard library comes with excellent support
 // for HTTP clients and servers in the `net/http`
 // package. In this example we'll use it to issue simple
-// HTTP requests.
+// HTTP requests, and then build a client for an API on
+// top of it, with timeouts, JSON bodies, retries and
+// cancellation.
 package main
 
 import (
 	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
 )
 
-func main() {
+// `fetch` issues a GET request to `url` and returns the
+// response status along with the first `n` lines of the
+// response body.
+func fetch(url string, n int) (string, []string, error) {
 
 	// Issue an HTTP GET request to a server. `http.Get` is a
 	// convenient shortcut around creating an `http.Client`
 	// object and calling its `Get` method; it uses the
 	// `http.DefaultClient` object which has useful default
 	// settings.
+	// TODO: Create resp, err := http.Get(url), returning any error
+	// TODO: Defer the closing of the response body with defer resp.Body.Close()
 
-	// TODO: Create resp, err := http.Get("https://gobyexample.com")
-	// TODO: Print err
-	
+	// Read the first `n` lines of the response body.
+	// TODO: Return resp.Status and readLines(resp.Body, n)
+	return "", nil, nil
+}
 
-	// TODO: Defer the closing of the response body with defer resp.Body.Close()
+// readLines returns the first n lines of r.
+func readLines(r io.Reader, n int) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for i := 0; i < n && scanner.Scan(); i++ {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
 
-	// Print the HTTP response status.
+// `http.DefaultClient` has no timeout: a server that
+// never answers blocks its caller forever. Real programs
+// make a client of their own. Client wraps one for an API
+// at `BaseURL`, and retries requests that fail with a
+// server error.
+type Client struct {
+	BaseURL string
+	HTTP    *http.Client
+
+	// MaxRetries is how many times a request is retried
+	// after a 5xx response. Backoff is the wait before the
+	// first retry, and doubles for every further one.
+	MaxRetries int
+	Backoff    time.Duration
+}
+
+// NewClient returns a client for the API at baseURL.
+// `Timeout` limits whole requests, including reading the
+// body. The transport is a clone of the default one, so
+// it keeps its proxy and HTTP/2 settings, with tighter
+// limits of its own. Clients, and their transports, are
+// safe for concurrent use and keep connections open for
+// reuse, so they should be made once and shared.
+func NewClient(baseURL string) *Client {
+	// TODO: Clone http.DefaultTransport.(*http.Transport),
+	// and set its MaxIdleConnsPerHost to 10 and its
+	// ResponseHeaderTimeout and TLSHandshakeTimeout to 5s.
+	// Use it in an &http.Client with a Timeout of 10s.
+	return &Client{
+		BaseURL:    strings.TrimSuffix(baseURL, "/"),
+		HTTP:       http.DefaultClient,
+		MaxRetries: 3,
+		Backoff:    100 * time.Millisecond,
+	}
+}
+
+// StatusError is the error of a request whose response
+// has a status other than 2xx.
+type StatusError struct {
+	Status string
+	Body   string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Status, e.Body)
+}
+
+// sleep waits for d, or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// do sends a request to path, with body if it isn't nil,
+// and retries it while the response is a server error.
+// The request is made again for every attempt, because
+// sending a request consumes its body. The caller must
+// close the body of the response returned, which is the
+// last one if every attempt failed.
+//
+// Only 5xx responses are retried: they mean the server
+// failed to handle the request, while errors may happen
+// after a POST was handled, and retrying it would then
+// do it twice.
+func (c *Client) do(ctx context.Context, method, path string, body []byte) (*http.Response, error) {
+	// TODO: For attempt from 0: make the request with
+	// http.NewRequestWithContext(ctx, method,
+	// c.BaseURL+path, ...), giving it a new
+	// bytes.NewReader(body) if body isn't nil, and set its
+	// "Content-Type" header to "application/json" then.
+	// Send it with c.HTTP.Do, and return the response and
+	// error unless there is no error, the status is 5xx and
+	// attempt < c.MaxRetries. Otherwise read the body to
+	// io.Discard and close it, sleep(ctx, backoff),
+	// returning its error, and double backoff, which starts
+	// at c.Backoff.
+	return c.HTTP.Get(c.BaseURL + path)
+}
+
+// Fetch is `fetch` for a path of the API, with the
+// client's timeouts and retries.
+func (c *Client) Fetch(ctx context.Context, path string, n int) (string, []string, error) {
+	resp, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	defer resp.Body.Close()
+	lines, err := readLines(resp.Body, n)
+	return resp.Status, lines, err
+}
+
+// PostJSON sends in as JSON to path, and decodes the
+// JSON response into out. Responses other than 2xx are
+// returned as a *StatusError.
+func (c *Client) PostJSON(ctx context.Context, path string, in, out any) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	// TODO: Send body with c.do(ctx, http.MethodPost, path,
+	// body), returning any error, and close the response
+	// body. For a status other than 2xx, return a
+	// &StatusError with resp.Status and the first 512 bytes
+	// of the body, trimmed. Otherwise decode the body into
+	// out with json.NewDecoder.
+	_ = body
+	return errors.New("not implemented")
+}
+
+// Note is what the API of the site stores.
+type Note struct {
+	ID    int    `json:"id,omitempty"`
+	Title string `json:"title"`
+}
 
-	// TODO: Print fmt.Println("Response status:", resp.Status)
+// home is the canned page the site serves at `/`.
+const home = `<!DOCTYPE html>
+<html>
+  <head>
+    <meta charset="utf-8">
+    <title>Go by Example</title>
+  </head>
+  <body>
+    <h1>Go by Example</h1>
+  </body>
+</html>
+`
+
+// newSite starts a local server standing in for a real
+// site, so this example works offline. `httptest`
+// servers listen on a free port of the loopback
+// interface, and their `URL` field says where.
+func newSite() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		io.WriteString(w, home)
+	})
+
+	// POST /api/notes stores a note, and returns it with
+	// its ID.
+	var notes atomic.Int64
+	mux.HandleFunc("POST /api/notes", func(w http.ResponseWriter, r *http.Request) {
+		var n Note
+		if err := json.NewDecoder(r.Body).Decode(&n); err != nil || n.Title == "" {
+			http.Error(w, "a note needs a title", http.StatusBadRequest)
+			return
+		}
+		n.ID = int(notes.Add(1))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(n)
+	})
+
+	// GET /flaky fails twice out of three.
+	var flaky atomic.Int64
+	mux.HandleFunc("GET /flaky", func(w http.ResponseWriter, r *http.Request) {
+		n := flaky.Add(1)
+		if n%3 != 0 {
+			http.Error(w, "try again", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintf(w, "served on request %d\n", n)
+	})
+
+	// GET /slow answers after a second, unless the client
+	// gives up first.
+	mux.HandleFunc("GET /slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(time.Second):
+			io.WriteString(w, "finally\n")
+		case <-r.Context().Done():
+		}
+	})
+	return httptest.NewServer(mux)
+}
+
+var baseURL = flag.String("url", "", "base URL of the site to fetch; a local stand-in if empty")
+
+func main() {
+	flag.Parse()
+	base := *baseURL
+	if base == "" {
+		site := newSite()
+		defer site.Close()
+		base = site.URL
+	}
+
+	status, lines, err := fetch(base, 5)
+	if err != nil {
+		panic(err)
+	}
+
+	// Print the HTTP response status.
+	fmt.Println("Response status:", status)
 
 	// Print the first 5 lines of the response body.
+	for _, line := range lines {
+		fmt.Println(line)
+	}
+
+	ctx := context.Background()
+	c := NewClient(base)
+	c.Backoff = 10 * time.Millisecond
+
+	// Post a note as JSON, and decode the one stored.
+	var note Note
+	if err := c.PostJSON(ctx, "/api/notes", Note{Title: "buy milk"}, &note); err != nil {
+		fmt.Println("post:", err)
+	} else {
+		fmt.Printf("posted: %+v\n", note)
+	}
+	err = c.PostJSON(ctx, "/api/notes", Note{}, &note)
+	var se *StatusError
+	if errors.As(err, &se) {
+		fmt.Println("post failed:", se.Status)
+	}
 
-	// TODO: Create scanner := bufio.NewScanner(resp.Body)
-	// Iterate over the scanner with for i := 0; scanner.Scan() && i < 5; i++ {
-	// TODO: Print fmt.Println(scanner.Text())
-	// TODO: Print err
+	// The 503 responses of /flaky are retried.
+	status, lines, err = c.Fetch(ctx, "/flaky", 1)
+	fmt.Println("flaky:", status, lines, err)
 
-	// Check for scanner.Err() and if it is not nil, panic with the error
-}
\ No newline at end of file
+	// Giving up on /slow after 50ms cancels the request.
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, _, err = c.Fetch(ctx, "/slow", 1)
+	fmt.Println("slow timed out:", errors.Is(err, context.DeadlineExceeded))
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestHello(t *testing.T) {
+	// `httptest.NewRecorder` records what a handler
+	// writes, so handlers can be tested without a server.
+	req := httptest.NewRequest(http.MethodGet, "/hello", nil)
+	rec := httptest.NewRecorder()
+	hello(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	if got := rec.Body.String(); got != "hello\n" {
+		t.Errorf("Expected %q, got %q", "hello\n", got)
+	}
+}
+
+func TestHeaders(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/headers", nil)
+	req.Header.Set("User-Agent", "curl/8.0")
+	req.Header.Add("Accept", "text/plain")
+	req.Header.Add("Accept", "application/json")
+	rec := httptest.NewRecorder()
+	headers(rec, req)
+
+	// Header iteration order is not specified, so compare
+	// sorted lines.
+	got := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	slices.Sort(got)
+	want := []string{
+		"Accept: application/json",
+		"Accept: text/plain",
+		"User-Agent: curl/8.0",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestRoutes(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hello", hello)
+	mux.HandleFunc("/headers", headers)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/hello")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+}
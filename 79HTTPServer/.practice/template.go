@@ -2,29 +2,32 @@
 // `net/http` package.
 package main
 
-import (
-	"fmt"
-	"net/http"
-)
+// TODO: Import the fmt package for writing the responses
+import "net/http"
 
 // A fundamental concept in `net/http` servers is
 // *handlers*. A handler is an object implementing the
 // `http.Handler` interface. A common way to write
 // a handler is by using the `http.HandlerFunc` adapter
 // on functions with the appropriate signature.
+func hello(w http.ResponseWriter, req *http.Request) {
 
-// TODO: Create function hello that takes a http.ResponseWriter and a http.Request
-// Functions serving as handlers take a
-// `http.ResponseWriter` and a `http.Request` as
-// arguments. The response writer is used to fill in the
-// HTTP response. Here our simple response is just
-// "hello".
-
-
-// TODO: Create function headers that takes a http.ResponseWriter and a http.Request
-// Inside the function, iterate over the request headers with range and print the name and value
+	// Functions serving as handlers take a
+	// `http.ResponseWriter` and a `http.Request` as
+	// arguments. The response writer is used to fill in the
+	// HTTP response. Here our simple response is just
+	// "hello\n".
+	// TODO: Write "hello\n" to w with fmt.Fprintf
+}
 
+func headers(w http.ResponseWriter, req *http.Request) {
 
+	// This handler does something a little more
+	// sophisticated by reading all the HTTP request
+	// headers and echoing them into the response body.
+	// TODO: Iterate over req.Header with range and, for each
+	// value h of each header name, write "%v: %v\n", name, h to w
+}
 
 func main() {
 
@@ -32,13 +35,11 @@ func main() {
 	// `http.HandleFunc` convenience function. It sets up
 	// the *default router* in the `net/http` package and
 	// takes a function as an argument.
-
-	// TODO: Create http.HandleFunc("/hello", hello)
-	// TODO: Create http.HandleFunc("/headers", headers)
+	http.HandleFunc("/hello", hello)
+	http.HandleFunc("/headers", headers)
 
 	// Finally, we call the `ListenAndServe` with the port
 	// and a handler. `nil` tells it to use the default
 	// router we've just set up.
-
-	// TODO: Create http.ListenAndServe(":8090", nil)
-}
\ No newline at end of file
+	http.ListenAndServe(":8090", nil)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+func TestHello(t *testing.T) {
+	old := delay
+	delay = 10 * time.Millisecond
+	defer func() { delay = old }()
+
+	req := httptest.NewRequest(http.MethodGet, "/hello", nil)
+	rec := httptest.NewRecorder()
+	out := practicetest.Capture(t, func() { hello(rec, req) })
+
+	if rec.Code != http.StatusOK || rec.Body.String() != "hello\n" {
+		t.Errorf("Expected 200 %q, got %d %q", "hello\n", rec.Code, rec.Body.String())
+	}
+	want := "server: hello handler started\nserver: hello handler ended\n"
+	if out != want {
+		t.Errorf("Expected %q, got %q", want, out)
+	}
+}
+
+func TestHelloCanceled(t *testing.T) {
+	// The handler must return as soon as the request's
+	// context is done, long before `delay` passes.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	req := httptest.NewRequest(http.MethodGet, "/hello", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+
+	start := time.Now()
+	out := practicetest.Capture(t, func() { hello(rec, req) })
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("Expected hello to stop on cancellation, took %v", elapsed)
+	}
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status %d, got %d", http.StatusInternalServerError, rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), context.DeadlineExceeded.Error()) {
+		t.Errorf("Expected the body to explain the error, got %q", rec.Body.String())
+	}
+	if !strings.Contains(out, "server: "+context.DeadlineExceeded.Error()) {
+		t.Errorf("Expected the server to log the error, got %q", out)
+	}
+}
@@ -7,40 +7,43 @@
 package main
 
 import (
-	"fmt"
 	"net/http"
 	"time"
 )
 
+// delay is how long the handler pretends to work before
+// replying.
+var delay = 10 * time.Second
+
 func hello(w http.ResponseWriter, req *http.Request) {
 
 	// A `context.Context` is created for each request by
 	// the `net/http` machinery, and is available with
 	// the `Context()` method.
-
 	// TODO: Create ctx := req.Context()
 	// TODO: Print "server: hello handler started"
-	// TODO: Defer "server: hello handler ended"
+	// TODO: Defer printing "server: hello handler ended"
 
 	// Wait for a few seconds before sending a reply to the
 	// client. This could simulate some work the server is
 	// doing. While working, keep an eye on the context's
 	// `Done()` channel for a signal that we should cancel
 	// the work and return as soon as possible.
+	// TODO: Use select to wait for time.After(delay) or ctx.Done()
+	// When time.After(delay) fires, write "hello\n" to w with fmt.Fprintf
 
-	// TODO: Use select to wait for 10 seconds or the context's Done() channel
-	// When context is <- time.After(10 * time.Second), print "hello"
+	// The context's `Err()` method returns an error
+	// that explains why the `Done()` channel was
+	// closed.
 
-	// When context is <- ctx.Done(), print "server: " and the context's Err()
-	// and set the status code to http.StatusInternalServerError and call http.Error(w, err.Error(), internalError)
-	
+	// When ctx.Done() is closed, print "server:" and ctx.Err()
+	// and call http.Error(w, err.Error(), http.StatusInternalServerError)
 }
 
 func main() {
 
 	// As before, we register our handler on the "/hello"
 	// route, and start serving.
-
-	// TODO: Create http.HandleFunc("/hello", hello)
-	// TODO: Create http.ListenAndServe(":8090", nil)
-}
\ No newline at end of file
+	http.HandleFunc("/hello", hello)
+	http.ListenAndServe(":8090", nil)
+}
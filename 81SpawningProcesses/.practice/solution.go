@@ -0,0 +1,115 @@
+// Sometimes our Go programs need to spawn other
+// processes.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+)
+
+// The `exec.Command` helper creates an object to
+// represent an external process. The `Output` method
+// runs the command, waits for it to finish and collects
+// its standard output.
+func output(name string, args ...string) (string, error) {
+	out, err := exec.Command(name, args...).Output()
+	return string(out), err
+}
+
+// `Output` and other methods of `Command` will return
+// `*exec.Error` if there was a problem executing the
+// command (e.g. wrong path), and `*exec.ExitError`
+// if the command ran but exited with a non-zero return
+// code. `describe` explains those two cases and returns
+// an empty string for any other error.
+func describe(err error) string {
+	var execErr *exec.Error
+	var exitErr *exec.ExitError
+	switch {
+	case errors.As(err, &execErr):
+		return fmt.Sprint("failed executing: ", err)
+	case errors.As(err, &exitErr):
+		return fmt.Sprint("command exit rc = ", exitErr.ExitCode())
+	}
+	return ""
+}
+
+// Next we'll look at a slightly more involved case
+// where we pipe data to the external process on its
+// `stdin` and collect the results from its `stdout`.
+func grep(pattern, input string) (string, error) {
+	grepCmd := exec.Command("grep", pattern)
+
+	// Here we explicitly grab input/output pipes, start
+	// the process, write some input to it, read the
+	// resulting output, and finally wait for the process
+	// to exit.
+	grepIn, err := grepCmd.StdinPipe()
+	if err != nil {
+		return "", err
+	}
+	grepOut, err := grepCmd.StdoutPipe()
+	if err != nil {
+		return "", err
+	}
+	if err := grepCmd.Start(); err != nil {
+		return "", err
+	}
+	io.WriteString(grepIn, input)
+	grepIn.Close()
+	grepBytes, err := io.ReadAll(grepOut)
+	if err != nil {
+		return "", err
+	}
+
+	// We only collect the `StdoutPipe` results, but you
+	// could collect the `StderrPipe` in exactly the same
+	// way.
+	return string(grepBytes), grepCmd.Wait()
+}
+
+func main() {
+
+	// We'll start with a simple command that takes no
+	// arguments or input and just prints something to
+	// stdout. If there were no errors, `dateOut` will
+	// hold the date info.
+	dateOut, err := output("date")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("> date")
+	fmt.Println(dateOut)
+
+	_, err = output("date", "-x")
+	if err != nil {
+		msg := describe(err)
+		if msg == "" {
+			panic(err)
+		}
+		fmt.Println(msg)
+	}
+
+	grepOut, err := grep("hello", "hello grep\ngoodbye grep")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("> grep hello")
+	fmt.Println(grepOut)
+
+	// Note that when spawning commands we need to
+	// provide an explicitly delineated command and
+	// argument array, vs. being able to just pass in one
+	// command-line string. If you want to spawn a full
+	// command with a string, you can use `bash`'s `-c`
+	// option:
+	lsOut, err := output("bash", "-c", "ls -a -l -h")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("> ls -a -l -h")
+	fmt.Println(lsOut)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestOutput(t *testing.T) {
+	got, err := output("echo", "hello", "world")
+	if err != nil {
+		t.Fatalf("output failed: %v", err)
+	}
+	if got != "hello world\n" {
+		t.Errorf("Expected %q, got %q", "hello world\n", got)
+	}
+
+	_, err = output("false")
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		t.Errorf("Expected an *exec.ExitError, got %v", err)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	_, err := output("definitely-not-a-command")
+	if got := describe(err); !strings.HasPrefix(got, "failed executing: ") {
+		t.Errorf("Expected a failed executing message, got %q", got)
+	}
+
+	_, err = output("sh", "-c", "exit 3")
+	if got := describe(err); got != "command exit rc = 3" {
+		t.Errorf("Expected %q, got %q", "command exit rc = 3", got)
+	}
+
+	if got := describe(errors.New("other")); got != "" {
+		t.Errorf("Expected an empty message for other errors, got %q", got)
+	}
+}
+
+func TestGrep(t *testing.T) {
+	got, err := grep("hello", "hello grep\ngoodbye grep")
+	if err != nil {
+		t.Fatalf("grep failed: %v", err)
+	}
+	if got != "hello grep\n" {
+		t.Errorf("Expected %q, got %q", "hello grep\n", got)
+	}
+
+	// grep exits with status 1 when nothing matches.
+	got, err = grep("missing", "hello grep\n")
+	if got != "" || describe(err) != "command exit rc = 1" {
+		t.Errorf("Expected no output and rc 1, got %q, %v", got, err)
+	}
+}
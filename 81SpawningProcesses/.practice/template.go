@@ -3,71 +3,83 @@
 
 package main
 
-import (
-	"errors"
-	"fmt"
-	"io"
-	"os/exec"
-)
-
-func main() {
-
-	// We'll start with a simple command that takes no
-	// arguments or input and just prints something to
-	// stdout. The `exec.Command` helper creates an object
-	// to represent this external process.
-
-	// TODO: Create dateCmd := exec.Command("date")
-
-	// The `Output` method runs the command, waits for it
-	// to finish and collects its standard output.
-	//  If there were no errors, `dateOut` will hold bytes
-	// with the date info.
-
-	// TODO: Create dateOut, err := dateCmd.Output()
-	// TODO: Print err
-	// TODO: Print dateOut
-	
-
-	// `Output` and other methods of `Command` will return
-	// `*exec.Error` if there was a problem executing the
-	// command (e.g. wrong path), and `*exec.ExitError`
-	// if the command ran but exited with a non-zero return
-	// code.
-
-
-	// TODO: Create _, err = exec.Command("date", "-x").Output()
-	
-	// TODO: Create if err != nil {
-	// Create var execErr *exec.Error and var exitErr *exec.ExitError
-	// With switch, check if err is an execErr or exitErr
-	// If it is, print the error
-	// If it is not, panic with the error
-
-
-	// Next we'll look at a slightly more involved case
-	// where we pipe data to the external process on its
-	// `stdin` and collect the results from its `stdout`.
-
-	// TODO: Create grepCmd := exec.Command("grep", "hello")
+// TODO: Import "errors", "io" and "os/exec" alongside "fmt"
+import "fmt"
+
+// The `exec.Command` helper creates an object to
+// represent an external process. The `Output` method
+// runs the command, waits for it to finish and collects
+// its standard output.
+func output(name string, args ...string) (string, error) {
+	// TODO: Create out, err := exec.Command(name, args...).Output()
+	// TODO: Return string(out) and err
+	return "", nil
+}
+
+// `Output` and other methods of `Command` will return
+// `*exec.Error` if there was a problem executing the
+// command (e.g. wrong path), and `*exec.ExitError`
+// if the command ran but exited with a non-zero return
+// code. `describe` explains those two cases and returns
+// an empty string for any other error.
+func describe(err error) string {
+	// TODO: Create var execErr *exec.Error and var exitErr *exec.ExitError
+	// TODO: With switch and errors.As, check if err is an execErr or exitErr
+	// For execErr return fmt.Sprint("failed executing: ", err)
+	// For exitErr return fmt.Sprint("command exit rc = ", exitErr.ExitCode())
+	return ""
+}
+
+// Next we'll look at a slightly more involved case
+// where we pipe data to the external process on its
+// `stdin` and collect the results from its `stdout`.
+func grep(pattern, input string) (string, error) {
+	// TODO: Create grepCmd := exec.Command("grep", pattern)
 
 	// Here we explicitly grab input/output pipes, start
 	// the process, write some input to it, read the
 	// resulting output, and finally wait for the process
 	// to exit.
+	// TODO: Create grepIn, err := grepCmd.StdinPipe() and grepOut, err := grepCmd.StdoutPipe()
+	// TODO: Start the process with grepCmd.Start()
+	// TODO: Write input to grepIn with io.WriteString, then close grepIn
+	// TODO: Create grepBytes, err := io.ReadAll(grepOut)
+
+	// We only collect the `StdoutPipe` results, but you
+	// could collect the `StderrPipe` in exactly the same
+	// way.
+	// TODO: Return string(grepBytes) and grepCmd.Wait()
+	return "", nil
+}
 
-	// TODO: Create grepIn, _ := grepCmd.StdinPipe()
-	// TODO: Create grepOut, _ := grepCmd.StdoutPipe()
-	// Start the process, write some input to it, read the resulting output, and finally wait for the process to exit.
-
-
-	// We omitted error checks in the above example, but
-	// you could use the usual `if err != nil` pattern for
-	// all of them. We also only collect the `StdoutPipe`
-	// results, but you could collect the `StderrPipe` in
-	// exactly the same way.
+func main() {
 
-	// TODO: Print "> grep hello" and the result of grepBytes
+	// We'll start with a simple command that takes no
+	// arguments or input and just prints something to
+	// stdout. If there were no errors, `dateOut` will
+	// hold the date info.
+	dateOut, err := output("date")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("> date")
+	fmt.Println(dateOut)
+
+	_, err = output("date", "-x")
+	if err != nil {
+		msg := describe(err)
+		if msg == "" {
+			panic(err)
+		}
+		fmt.Println(msg)
+	}
+
+	grepOut, err := grep("hello", "hello grep\ngoodbye grep")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("> grep hello")
+	fmt.Println(grepOut)
 
 	// Note that when spawning commands we need to
 	// provide an explicitly delineated command and
@@ -75,7 +87,10 @@ func main() {
 	// command-line string. If you want to spawn a full
 	// command with a string, you can use `bash`'s `-c`
 	// option:
-
-	// TODO: Create lsCmd := exec.Command("bash", "-c", "ls -a -l -h")
-	// Call lsCmd.Output() and print the result 
-}
\ No newline at end of file
+	lsOut, err := output("bash", "-c", "ls -a -l -h")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("> ls -a -l -h")
+	fmt.Println(lsOut)
+}
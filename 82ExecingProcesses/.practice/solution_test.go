@@ -0,0 +1,29 @@
+package main
+
+import (
+	"errors"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestCommand(t *testing.T) {
+	binary, args, err := command("ls", "-a", "-l", "-h")
+	if err != nil {
+		t.Fatalf("command failed: %v", err)
+	}
+	if !filepath.IsAbs(binary) || filepath.Base(binary) != "ls" {
+		t.Errorf("Expected an absolute path to ls, got %q", binary)
+	}
+	if want := []string{"ls", "-a", "-l", "-h"}; !slices.Equal(args, want) {
+		t.Errorf("Expected args %v, got %v", want, args)
+	}
+}
+
+func TestCommandNotFound(t *testing.T) {
+	_, _, err := command("definitely-not-a-command")
+	if !errors.Is(err, exec.ErrNotFound) {
+		t.Errorf("Expected %v, got %v", exec.ErrNotFound, err)
+	}
+}
@@ -12,39 +12,41 @@ package main
 
 import (
 	"os"
-	"os/exec"
 	"syscall"
 )
 
-func main() {
-
-	// For our example we'll exec `ls`. Go requires an
-	// absolute path to the binary we want to execute, so
-	// we'll use `exec.LookPath` to find it (probably
-	// `/bin/ls`).
-
-	// TODO: Create binary, lookErr := exec.LookPath("ls")
-	// TODO: Print lookErr
+// Go requires an absolute path to the binary we want to
+// execute, so we'll use `exec.LookPath` to find it.
+// `Exec` requires arguments in slice form (as opposed to
+// one big string). Note that the first argument should
+// be the program name.
+func command(name string, args ...string) (string, []string, error) {
+	// TODO: Create binary, err := exec.LookPath(name), returning any error
+	// TODO: Return binary and the arguments with name first: append([]string{name}, args...)
+	return "", nil, nil
+}
 
-	// `Exec` requires arguments in slice form (as
-	// opposed to one big string). We'll give `ls` a few
-	// common arguments. Note that the first argument should
-	// be the program name.
+func main() {
 
-	// TODO: Create args := []string{"ls", "-a", "-l", "-h"}
+	// For our example we'll exec `ls` with a few common
+	// arguments. The binary is probably `/bin/ls`.
+	binary, args, lookErr := command("ls", "-a", "-l", "-h")
+	if lookErr != nil {
+		panic(lookErr)
+	}
 
 	// `Exec` also needs a set of [environment variables](environment-variables)
 	// to use. Here we just provide our current
 	// environment.
-
-	// TODO: Create env := os.Environ()
+	env := os.Environ()
 
 	// Here's the actual `syscall.Exec` call. If this call is
 	// successful, the execution of our process will end
 	// here and be replaced by the `/bin/ls -a -l -h`
 	// process. If there is an error we'll get a return
 	// value.
-
-	// TODO: Create execErr := syscall.Exec(binary, args, env)
-	// TODO: Print execErr
-}
\ No newline at end of file
+	execErr := syscall.Exec(binary, args, env)
+	if execErr != nil {
+		panic(execErr)
+	}
+}
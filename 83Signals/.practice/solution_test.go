@@ -0,0 +1,60 @@
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+func TestAwait(t *testing.T) {
+	sigs := make(chan os.Signal, 1)
+
+	out := practicetest.Capture(t, func() {
+		done := await(sigs)
+		sigs <- syscall.SIGINT
+
+		select {
+		case ok := <-done:
+			if !ok {
+				t.Error("Expected true on done, got false")
+			}
+		case <-time.After(time.Second):
+			t.Error("Expected done after a signal, timed out")
+		}
+	})
+
+	if want := "\ninterrupt\n"; out != want {
+		t.Errorf("Expected %q, got %q", want, out)
+	}
+}
+
+func TestNotify(t *testing.T) {
+	sigs := notify()
+	if sigs == nil {
+		t.Fatal("Expected a channel, got nil")
+	}
+	defer signal.Stop(sigs)
+
+	if cap(sigs) != 1 {
+		t.Errorf("Expected a buffered channel of size 1, got %d", cap(sigs))
+	}
+
+	// With `SIGTERM` registered, sending it to ourselves
+	// delivers it on the channel instead of ending the
+	// test binary.
+	if err := syscall.Kill(os.Getpid(), syscall.SIGTERM); err != nil {
+		t.Fatalf("Kill failed: %v", err)
+	}
+	select {
+	case sig := <-sigs:
+		if sig != syscall.SIGTERM {
+			t.Errorf("Expected %v, got %v", syscall.SIGTERM, sig)
+		}
+	case <-time.After(time.Second):
+		t.Error("Expected SIGTERM on the channel, timed out")
+	}
+}
@@ -10,47 +10,48 @@ package main
 import (
 	"fmt"
 	"os"
-	"os/signal"
-	"syscall"
 )
 
-func main() {
-
-	// Go signal notification works by sending `os.Signal`
-	// values on a channel. We'll create a channel to
-	// receive these notifications. Note that this channel
-	// should be buffered.
-
+// Go signal notification works by sending `os.Signal`
+// values on a channel. We'll create a channel to
+// receive these notifications. Note that this channel
+// should be buffered. `signal.Notify` registers the
+// given channel to receive notifications of the
+// specified signals.
+func notify() chan os.Signal {
 	// TODO: Create sigs channel of os.Signal with buffer size 1
-
-
-	// `signal.Notify` registers the given channel to
-	// receive notifications of the specified signals.
-
 	// TODO: Call signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
-
-	// We could receive from `sigs` here in the main
-	// function, but let's see how this could also be
-	// done in a separate goroutine, to demonstrate
-	// a more realistic scenario of graceful shutdown.
-
+	// TODO: Return sigs
+	return nil
+}
+
+// We could receive from `sigs` in the main function,
+// but let's see how this could also be done in a
+// separate goroutine, to demonstrate a more realistic
+// scenario of graceful shutdown.
+func await(sigs <-chan os.Signal) <-chan bool {
 	// TODO: Create done channel of bool with buffer size 1
+	var done chan bool
 
 	go func() {
 		// This goroutine executes a blocking receive for
 		// signals. When it gets one it'll print it out
 		// and then notify the program that it can finish.
-
 		// TODO: Create sig := <-sigs
-		// TODO: Print sig
+		// TODO: Print an empty line, then sig
 		// TODO: Send true to done channel
 	}()
 
+	return done
+}
+
+func main() {
+	done := await(notify())
+
 	// The program will wait here until it gets the
 	// expected signal (as indicated by the goroutine
 	// above sending a value on `done`) and then exit.
-
-	// TODO: Print "awaiting signal"
-	// TODO: Receive from done channel
-	// TODO: Print "exiting"
-}
\ No newline at end of file
+	fmt.Println("awaiting signal")
+	<-done
+	fmt.Println("exiting")
+}
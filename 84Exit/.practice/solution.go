@@ -0,0 +1,28 @@
+// Use `os.Exit` to immediately exit with a given
+// status.
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// `defer`s will _not_ be run when using `os.Exit`, so
+// the `fmt.Println` in `exit` will never be called.
+func exit(code int) {
+	defer fmt.Println("!")
+
+	os.Exit(code)
+}
+
+func main() {
+
+	// Exit with status 3.
+	exit(3)
+}
+
+// Note that unlike e.g. C, Go does not use an integer
+// return value from `main` to indicate exit status. If
+// you'd like to exit with a non-zero status you should
+// use `os.Exit`.
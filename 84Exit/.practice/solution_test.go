@@ -0,0 +1,32 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"testing"
+)
+
+// `os.Exit` ends the test binary too, so the test runs
+// itself again in a subprocess that calls `exit`.
+func TestExit(t *testing.T) {
+	if os.Getenv("EXIT_CHILD") == "1" {
+		exit(3)
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestExit$")
+	cmd.Env = append(os.Environ(), "EXIT_CHILD=1")
+	out, err := cmd.Output()
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		t.Fatalf("Expected the process to exit with an error, got %v", err)
+	}
+	if code := exitErr.ExitCode(); code != 3 {
+		t.Errorf("Expected exit status 3, got %d", code)
+	}
+	if len(out) != 0 {
+		t.Errorf("Expected deferred calls not to run, got output %q", out)
+	}
+}
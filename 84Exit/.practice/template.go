@@ -3,24 +3,23 @@
 
 package main
 
-import (
-	"fmt"
-	"os"
-)
+// TODO: Import "fmt" and "os"
 
-func main() {
+// `defer`s will _not_ be run when using `os.Exit`, so
+// the `fmt.Println` in `exit` will never be called.
+func exit(code int) {
+	// TODO: Defer fmt.Println("!")
 
-	// `defer`s will _not_ be run when using `os.Exit`, so
-	// this `fmt.Println` will never be called.
+	// TODO: Call os.Exit(code)
+}
 
-	// TODO: Defer fmt.Println("!")
+func main() {
 
 	// Exit with status 3.
-
-	// TODO: Create os.Exit(3)
+	exit(3)
 }
 
 // Note that unlike e.g. C, Go does not use an integer
 // return value from `main` to indicate exit status. If
 // you'd like to exit with a non-zero status you should
-// use `os.Exit`.
\ No newline at end of file
+// use `os.Exit`.
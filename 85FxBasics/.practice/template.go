@@ -4,11 +4,8 @@
 
 package main
 
-import (
-	"fmt"
-
-	"go.uber.org/fx"
-)
+// TODO: Import "go.uber.org/fx" alongside "fmt"
+import "fmt"
 
 // A simple greeter service that will be injected
 type Greeter struct {
@@ -4,10 +4,9 @@
 
 package main
 
+// TODO: Import "context" and "time" once the hooks are written
 import (
-	"context"
 	"fmt"
-	"time"
 
 	"go.uber.org/fx"
 	"go.uber.org/zap"
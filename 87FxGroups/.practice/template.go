@@ -4,10 +4,10 @@
 
 package main
 
+// TODO: Import "go.uber.org/fx" alongside "fmt" and "go.uber.org/zap"
 import (
 	"fmt"
 
-	"go.uber.org/fx"
 	"go.uber.org/zap"
 )
 
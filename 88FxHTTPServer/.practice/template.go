@@ -6,15 +6,14 @@
 
 package main
 
+// TODO: Import "context", "net" and "go.uber.org/fx/fxevent" once the
+// server and its lifecycle hooks are written
 import (
-	"context"
 	"fmt"
 	"io"
-	"net"
 	"net/http"
 
 	"go.uber.org/fx"
-	"go.uber.org/fx/fxevent"
 	"go.uber.org/zap"
 )
 
@@ -5,7 +5,6 @@ import (
 	"log"
 	"time"
 
-	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -21,7 +20,7 @@ type User struct {
 
 // ConnectDB establishes a connection to the SQLite database
 func ConnectDB() (*gorm.DB, error) {
-	db, err := gorm.Open(sqlite.Open("test.db"), &gorm.Config{})
+	db, err := gorm.Open(openSQLite("test.db"), &gorm.Config{})
 	if err != nil {
 		return nil, err
 	}
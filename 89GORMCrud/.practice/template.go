@@ -19,7 +19,8 @@ type User struct {
 // ConnectDB establishes a connection to the SQLite database
 func ConnectDB() (*gorm.DB, error) {
 	// TODO: Implement database connection
-	// Hint: Use gorm.Open with sqlite.Open("test.db")
+	// Hint: Use gorm.Open with openSQLite("test.db")
+	// openSQLite, in sqlite_cgo.go and sqlite_purego.go, picks the SQLite driver
 	// Don't forget to auto-migrate the User model
 	return nil, nil
 }
@@ -5,7 +5,6 @@ import (
 	"log"
 	"time"
 
-	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -40,7 +39,7 @@ type Tag struct {
 
 // ConnectDB establishes a connection to the SQLite database and auto-migrates the models
 func ConnectDB() (*gorm.DB, error) {
-	db, err := gorm.Open(sqlite.Open("blog.db"), &gorm.Config{})
+	db, err := gorm.Open(openSQLite("blog.db"), &gorm.Config{})
 	if err != nil {
 		return nil, err
 	}
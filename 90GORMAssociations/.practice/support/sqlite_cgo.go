@@ -0,0 +1,15 @@
+//go:build cgo && !puresqlite
+
+package main
+
+import (
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// openSQLite opens a SQLite database file with gorm.io/driver/sqlite,
+// which wraps the cgo driver github.com/mattn/go-sqlite3. Build with
+// CGO_ENABLED=0 or -tags puresqlite to use the pure-Go driver instead.
+func openSQLite(dsn string) gorm.Dialector {
+	return sqlite.Open(dsn)
+}
@@ -38,7 +38,8 @@ type Tag struct {
 // ConnectDB establishes a connection to the SQLite database and auto-migrates the models
 func ConnectDB() (*gorm.DB, error) {
 	// TODO: Implement database connection with auto-migration
-	// Hint: Use gorm.Open with sqlite.Open("blog.db")
+	// Hint: Use gorm.Open with openSQLite("blog.db")
+	// openSQLite, in sqlite_cgo.go and sqlite_purego.go, picks the SQLite driver
 	// Don't forget to auto-migrate all three models: User, Post, Tag
 	return nil, nil
 }
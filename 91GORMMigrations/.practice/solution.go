@@ -6,7 +6,6 @@ import (
 	"log"
 	"time"
 
-	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -44,7 +43,7 @@ type Category struct {
 
 // ConnectDB establishes a connection to the SQLite database
 func ConnectDB() (*gorm.DB, error) {
-	db, err := gorm.Open(sqlite.Open("ecommerce.db"), &gorm.Config{})
+	db, err := gorm.Open(openSQLite("ecommerce.db"), &gorm.Config{})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,15 @@
+//go:build !cgo || puresqlite
+
+package main
+
+import (
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+// openSQLite opens a SQLite database file with github.com/glebarez/sqlite,
+// a pure-Go driver built on modernc.org/sqlite. It is used when cgo is
+// disabled or with -tags puresqlite, and needs no C toolchain.
+func openSQLite(dsn string) gorm.Dialector {
+	return sqlite.Open(dsn)
+}
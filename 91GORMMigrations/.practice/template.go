@@ -41,7 +41,8 @@ type Category struct {
 // ConnectDB establishes a connection to the SQLite database
 func ConnectDB() (*gorm.DB, error) {
 	// TODO: Implement database connection
-	// Hint: Use gorm.Open with sqlite.Open("ecommerce.db")
+	// Hint: Use gorm.Open with openSQLite("ecommerce.db")
+	// openSQLite, in sqlite_cgo.go and sqlite_purego.go, picks the SQLite driver
 	// Don't auto-migrate models here - migrations will be handled separately
 	return nil, nil
 }
@@ -5,7 +5,6 @@ import (
 	"log"
 	"time"
 
-	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -60,7 +59,7 @@ type PostWithLikeCount struct {
 
 // ConnectDB establishes a connection to the SQLite database with auto-migration
 func ConnectDB() (*gorm.DB, error) {
-	db, err := gorm.Open(sqlite.Open("social.db"), &gorm.Config{})
+	db, err := gorm.Open(openSQLite("social.db"), &gorm.Config{})
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"time"
 
 	"gorm.io/gorm"
@@ -46,7 +47,8 @@ type Like struct {
 // ConnectDB establishes a connection to the SQLite database with auto-migration
 func ConnectDB() (*gorm.DB, error) {
 	// TODO: Implement database connection with auto-migration
-	// Hint: Use gorm.Open with sqlite.Open("social.db")
+	// Hint: Use gorm.Open with openSQLite("social.db")
+	// openSQLite, in sqlite_cgo.go and sqlite_purego.go, picks the SQLite driver
 	// Auto-migrate all three models: User, Post, Like
 	return nil, nil
 }
@@ -115,6 +117,66 @@ func GetUserRecommendations(db *gorm.DB, userID uint, limit int) ([]User, error)
 	return nil, nil
 }
 
+func seedTestData(db *gorm.DB) {
+	// Check if data already exists
+	var count int64
+	db.Model(&User{}).Count(&count)
+	if count > 0 {
+		fmt.Println("Test data already exists")
+		return
+	}
+	
+	// Create users
+	users := []User{
+		{Username: "alice", Email: "alice@example.com", Age: 28, Country: "USA"},
+		{Username: "bob", Email: "bob@example.com", Age: 35, Country: "UK"},
+		{Username: "charlie", Email: "charlie@example.com", Age: 22, Country: "Canada"},
+		{Username: "diana", Email: "diana@example.com", Age: 30, Country: "USA"},
+		{Username: "eve", Email: "eve@example.com", Age: 26, Country: "UK"},
+	}
+	
+	for i := range users {
+		db.Create(&users[i])
+	}
+	
+	// Create posts
+	posts := []Post{
+		{Title: "Introduction to Go", Content: "Go is a great programming language...", UserID: 1, Category: "Technology", ViewCount: 150},
+		{Title: "Advanced Go Techniques", Content: "Learn advanced Go programming...", UserID: 1, Category: "Technology", ViewCount: 200},
+		{Title: "Web Development with Go", Content: "Building web apps in Go...", UserID: 2, Category: "Technology", ViewCount: 180},
+		{Title: "Travel Tips for Europe", Content: "Best places to visit in Europe...", UserID: 2, Category: "Travel", ViewCount: 120},
+		{Title: "Cooking 101", Content: "Basic cooking techniques...", UserID: 3, Category: "Lifestyle", ViewCount: 90},
+		{Title: "Go Concurrency Patterns", Content: "Mastering goroutines and channels...", UserID: 1, Category: "Technology", ViewCount: 250},
+		{Title: "Database Design", Content: "Principles of good database design...", UserID: 4, Category: "Technology", ViewCount: 160},
+		{Title: "Fitness Guide", Content: "Stay fit and healthy...", UserID: 5, Category: "Health", ViewCount: 110},
+	}
+	
+	for i := range posts {
+		db.Create(&posts[i])
+	}
+	
+	// Create likes
+	likes := []Like{
+		{UserID: 2, PostID: 1},
+		{UserID: 3, PostID: 1},
+		{UserID: 4, PostID: 1},
+		{UserID: 5, PostID: 1},
+		{UserID: 1, PostID: 3},
+		{UserID: 3, PostID: 3},
+		{UserID: 2, PostID: 6},
+		{UserID: 4, PostID: 6},
+		{UserID: 5, PostID: 6},
+		{UserID: 1, PostID: 4},
+		{UserID: 3, PostID: 7},
+	}
+	
+	for i := range likes {
+		db.Create(&likes[i])
+	}
+	
+	fmt.Println("Test data seeded successfully")
+}
+
 func main() {
 	// TODO: Uncomment and complete this section when you're ready to test
 	/*
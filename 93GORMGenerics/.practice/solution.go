@@ -6,7 +6,6 @@ import (
 	"log"
 	"time"
 
-	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -48,7 +47,7 @@ type Post struct {
 
 // ConnectDB establishes a connection to the SQLite database and auto-migrates models
 func ConnectDB() (*gorm.DB, error) {
-	db, err := gorm.Open(sqlite.Open("generics.db"), &gorm.Config{})
+	db, err := gorm.Open(openSQLite("generics.db"), &gorm.Config{})
 	if err != nil {
 		return nil, err
 	}
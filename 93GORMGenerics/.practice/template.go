@@ -45,7 +45,8 @@ type Post struct {
 // ConnectDB establishes a connection to the SQLite database and auto-migrates models
 func ConnectDB() (*gorm.DB, error) {
 	// TODO: Connect to SQLite database and auto-migrate all models
-	// Hint: Use gorm.Open(sqlite.Open("generics.db"), &gorm.Config{})
+	// Hint: Use gorm.Open(openSQLite("generics.db"), &gorm.Config{})
+	// openSQLite, in sqlite_cgo.go and sqlite_purego.go, picks the SQLite driver
 	// Then call db.AutoMigrate(&User{}, &Company{}, &Post{})
 	return nil, nil
 }
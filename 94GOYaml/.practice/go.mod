@@ -1,5 +1,5 @@
 module github.com/orsenthil/practicego/94GOYaml/.practice
 
-go 1.25.0
+go 1.25
 
 require gopkg.in/yaml.v3 v3.0.1
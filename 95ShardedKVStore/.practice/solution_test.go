@@ -0,0 +1,327 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+// newStore returns a store that is shut down when the
+// test ends. Its sweeps are an hour apart: on a simulated
+// clock, every sweep moves the time forward too.
+func newStore(t *testing.T, n int) *Store {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := NewStore(ctx, n, time.Hour)
+	t.Cleanup(func() {
+		cancel()
+		s.Wait()
+	})
+	return s
+}
+
+func TestGetSetDelete(t *testing.T) {
+	s := newStore(t, 4)
+
+	if _, ok, err := s.Get("a"); ok || err != nil {
+		t.Errorf("Expected a missing key, got %v, %v", ok, err)
+	}
+	if err := s.Set("a", 1, 0); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if v, ok, _ := s.Get("a"); !ok || v != 1 {
+		t.Errorf("Expected 1, got %d, %v", v, ok)
+	}
+	s.Set("a", 2, 0)
+	if v, _, _ := s.Get("a"); v != 2 {
+		t.Errorf("Expected overwritten value 2, got %d", v)
+	}
+
+	if ok, _ := s.Delete("a"); !ok {
+		t.Error("Expected Delete to find a")
+	}
+	if ok, _ := s.Delete("a"); ok {
+		t.Error("Expected the second Delete to find nothing")
+	}
+	if _, ok, _ := s.Get("a"); ok {
+		t.Error("Expected a to be gone after Delete")
+	}
+}
+
+func TestCompareAndSwap(t *testing.T) {
+	s := newStore(t, 4)
+
+	tests := []struct {
+		old, val int
+		want     bool
+		after    int
+	}{
+		{1, 2, true, 2},
+		{1, 3, false, 2},
+		{2, 3, true, 3},
+	}
+	s.Set("a", 1, 0)
+	for _, tt := range tests {
+		ok, err := s.CompareAndSwap("a", tt.old, tt.val)
+		if err != nil || ok != tt.want {
+			t.Errorf("CompareAndSwap(%d, %d): expected %v, got %v, %v", tt.old, tt.val, tt.want, ok, err)
+		}
+		if v, _, _ := s.Get("a"); v != tt.after {
+			t.Errorf("Expected %d after CompareAndSwap(%d, %d), got %d", tt.after, tt.old, tt.val, v)
+		}
+	}
+
+	if ok, _ := s.CompareAndSwap("missing", 0, 1); ok {
+		t.Error("Expected CompareAndSwap to fail on a missing key")
+	}
+}
+
+func TestCompareAndSwapCounter(t *testing.T) {
+	s := newStore(t, 4)
+	s.Set("n", 0, 0)
+
+	// increment retries until its swap succeeds; giving up
+	// after many failures keeps a broken store from
+	// spinning forever.
+	increment := func() bool {
+		for range 10000 {
+			v, _, _ := s.Get("n")
+			if ok, _ := s.CompareAndSwap("n", v, v+1); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Concurrent increments must not lose any update.
+	const workers, perWorker = 10, 100
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Go(func() {
+			for range perWorker {
+				if !increment() {
+					t.Error("Expected CompareAndSwap to succeed eventually")
+					return
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	if v, _, _ := s.Get("n"); v != workers*perWorker {
+		t.Errorf("Expected %d, got %d", workers*perWorker, v)
+	}
+}
+
+func TestTTL(t *testing.T) {
+	practicetest.Simulate(t, &clock, 1000)
+	s := newStore(t, 4)
+
+	s.Set("session", 42, time.Minute)
+	s.Set("forever", 1, 0)
+	s.Set("swapped", 1, time.Minute)
+	s.CompareAndSwap("swapped", 1, 2)
+
+	clock.Sleep(59 * time.Second)
+	if v, ok, _ := s.Get("session"); !ok || v != 42 {
+		t.Errorf("Expected session to live for a minute, got %d, %v", v, ok)
+	}
+
+	clock.Sleep(time.Second)
+	for _, key := range []string{"session", "swapped"} {
+		if _, ok, _ := s.Get(key); ok {
+			t.Errorf("Expected %s to expire after a minute", key)
+		}
+	}
+	if ok, _ := s.Delete("session"); ok {
+		t.Error("Expected Delete to ignore an expired entry")
+	}
+	if ok, _ := s.CompareAndSwap("swapped", 2, 3); ok {
+		t.Error("Expected CompareAndSwap to ignore an expired entry")
+	}
+	if _, ok, _ := s.Get("forever"); !ok {
+		t.Error("Expected an entry without ttl not to expire")
+	}
+
+	// Setting the key again starts a new life.
+	s.Set("session", 7, time.Minute)
+	if v, ok, _ := s.Get("session"); !ok || v != 7 {
+		t.Errorf("Expected a new session, got %d, %v", v, ok)
+	}
+}
+
+func TestShardFor(t *testing.T) {
+	s := newStore(t, 8)
+
+	seen := make(map[*shard]int)
+	for i := range 1000 {
+		key := fmt.Sprint("key", i)
+		sh := s.shardFor(key)
+		if s.shardFor(key) != sh {
+			t.Fatalf("Expected %s to map to the same shard every time", key)
+		}
+		seen[sh]++
+	}
+	if len(seen) != 8 {
+		t.Errorf("Expected keys on all 8 shards, got %d", len(seen))
+	}
+	for _, n := range seen {
+		if n < 50 {
+			t.Errorf("Expected keys spread over the shards, got %v", seen)
+			break
+		}
+	}
+}
+
+func TestNewStoreLimits(t *testing.T) {
+	practicetest.Simulate(t, &clock, 1000)
+	for _, n := range []int{0, -1} {
+		ctx, cancel := context.WithCancel(context.Background())
+		s := NewStore(ctx, n, 0)
+
+		if len(s.shards) != 1 {
+			t.Errorf("Expected 1 shard for n = %d, got %d", n, len(s.shards))
+		}
+		s.Set("a", 1, time.Minute)
+		if v, ok, err := s.Get("a"); !ok || v != 1 || err != nil {
+			t.Errorf("Expected 1, got %d, %v, %v", v, ok, err)
+		}
+		// Without sweeps, reads still hide expired entries.
+		clock.Sleep(time.Minute)
+		if _, ok, _ := s.Get("a"); ok {
+			t.Error("Expected a to expire without sweeps")
+		}
+		cancel()
+		s.Wait()
+	}
+}
+
+func TestConcurrentOps(t *testing.T) {
+	s := newStore(t, 4)
+
+	// Each writer owns its keys, so with -race this also
+	// proves every shard map is only touched by its owner.
+	const writers, perWriter = 10, 100
+	var wg sync.WaitGroup
+	for w := range writers {
+		wg.Go(func() {
+			for i := range perWriter {
+				key := fmt.Sprint(w, "-", i%10)
+				s.Set(key, i, 0)
+				s.Get(key)
+			}
+		})
+	}
+	wg.Wait()
+
+	for w := range writers {
+		for k := range 10 {
+			key := fmt.Sprint(w, "-", k)
+			if v, _, _ := s.Get(key); v != perWriter-10+k {
+				t.Errorf("Expected %s to be %d, got %d", key, perWriter-10+k, v)
+			}
+		}
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := NewStore(ctx, 4, time.Second)
+	s.Set("a", 1, 0)
+
+	cancel()
+	done := make(chan struct{})
+	go func() {
+		s.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the shard owners to stop after cancel")
+	}
+
+	if _, _, err := s.Get("a"); !errors.Is(err, ErrClosed) {
+		t.Errorf("Expected ErrClosed from Get, got %v", err)
+	}
+	if err := s.Set("a", 2, 0); !errors.Is(err, ErrClosed) {
+		t.Errorf("Expected ErrClosed from Set, got %v", err)
+	}
+	if _, err := s.Delete("a"); !errors.Is(err, ErrClosed) {
+		t.Errorf("Expected ErrClosed from Delete, got %v", err)
+	}
+	if _, err := s.CompareAndSwap("a", 1, 2); !errors.Is(err, ErrClosed) {
+		t.Errorf("Expected ErrClosed from CompareAndSwap, got %v", err)
+	}
+}
+
+// Container is the mutex-guarded map of the mutexes
+// example, with the operations the benchmarks need.
+type Container struct {
+	mu       sync.Mutex
+	counters map[string]int
+}
+
+func (c *Container) get(name string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.counters[name]
+}
+
+func (c *Container) set(name string, val int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counters[name] = val
+}
+
+// benchKeys are the keys both benchmarks read and write,
+// nine reads for every write.
+var benchKeys = func() []string {
+	keys := make([]string, 1024)
+	for i := range keys {
+		keys[i] = fmt.Sprint("key", i)
+	}
+	return keys
+}()
+
+func BenchmarkStore(b *testing.B) {
+	for _, n := range []int{1, 4, 16} {
+		b.Run(fmt.Sprint("shards=", n), func(b *testing.B) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			s := NewStore(ctx, n, time.Minute)
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					key := benchKeys[i%len(benchKeys)]
+					if i%10 == 0 {
+						s.Set(key, i, 0)
+					} else {
+						s.Get(key)
+					}
+					i++
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkContainer(b *testing.B) {
+	c := &Container{counters: make(map[string]int)}
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			key := benchKeys[i%len(benchKeys)]
+			if i%10 == 0 {
+				c.set(key, i)
+			} else {
+				c.get(key)
+			}
+			i++
+		}
+	})
+}
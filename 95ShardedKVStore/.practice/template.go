@@ -0,0 +1,266 @@
+// In [stateful goroutines](stateful-goroutines) a single
+// goroutine owned a map and served `reads` and `writes`
+// over channels. Services use the same pattern at scale,
+// with a few additions: the state is split into shards,
+// each owned by its own goroutine, so that operations on
+// different keys don't queue behind each other; entries
+// can be deleted, swapped atomically and expire after a
+// time to live; and the owners stop when the service
+// shuts down. Run `go test -bench .` to compare the
+// store with the mutex-guarded `Container` of
+// [mutexes](mutexes).
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit"
+)
+
+// clock works like the time package: clock.Now is
+// time.Now and clock.Sleep is time.Sleep. `practice
+// check` replaces it with a simulated clock, so entries
+// expire at the same point on every run.
+var clock = practicekit.ClockFromEnv()
+
+// ErrClosed is returned by the operations of a store
+// whose context is done.
+var ErrClosed = errors.New("store closed")
+
+// As in stateful goroutines, each operation is a message
+// carrying its arguments and a channel for the reply.
+// The reply channels are buffered so that the owner never
+// blocks on a caller.
+type readOp struct {
+	key  string
+	resp chan readResult
+}
+type readResult struct {
+	val int
+	ok  bool
+}
+type writeOp struct {
+	key  string
+	val  int
+	ttl  time.Duration
+	resp chan bool
+}
+type deleteOp struct {
+	key  string
+	resp chan bool
+}
+type casOp struct {
+	key      string
+	old, val int
+	resp     chan bool
+}
+
+// entry is a value with an optional expiry time; the
+// zero `expires` means it never expires.
+type entry struct {
+	val     int
+	expires time.Time
+}
+
+func (e entry) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+// shard holds the channels of one owner goroutine.
+type shard struct {
+	reads   chan readOp
+	writes  chan writeOp
+	deletes chan deleteOp
+	cases   chan casOp
+}
+
+func newShard() *shard {
+	return &shard{
+		reads:   make(chan readOp),
+		writes:  make(chan writeOp),
+		deletes: make(chan deleteOp),
+		cases:   make(chan casOp),
+	}
+}
+
+// serve owns the shard's state until ctx is done. Reads
+// treat expired entries as missing, and every `sweep`
+// tick removes them so that keys nobody reads again
+// don't stay in memory.
+func (sh *shard) serve(ctx context.Context, c practicekit.Clock, sweep <-chan time.Time) {
+	// TODO: Create state := make(map[string]entry)
+	for {
+		select {
+		case read := <-sh.reads:
+			// TODO: Send readResult{val, ok} for the key,
+			// treating (and deleting) an expired entry as
+			// missing
+			read.resp <- readResult{}
+		case write := <-sh.writes:
+			// TODO: Store entry{val: write.val}, with expires
+			// set to c.Now().Add(write.ttl) when ttl > 0
+			write.resp <- true
+		case del := <-sh.deletes:
+			// TODO: Remove the key and send whether a live
+			// entry was there
+			del.resp <- false
+		case cas := <-sh.cases:
+			// TODO: Replace the value of a live entry equal
+			// to cas.old with cas.val, keeping its expiry,
+			// and send whether it did
+			cas.resp <- false
+		case <-sweep:
+			// TODO: Delete every expired entry
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Store is a key-value store split into shards. The
+// shard of a key is picked by its hash, so the same key
+// always goes to the same owner.
+type Store struct {
+	ctx    context.Context
+	shards []*shard
+	wg     sync.WaitGroup
+}
+
+// NewStore starts n shard owners that run until ctx is
+// done. Expired entries are swept every `sweepEvery`. A
+// store has at least one shard, and a `sweepEvery` of
+// zero or less turns sweeping off: expired entries are
+// then only removed when they're read.
+func NewStore(ctx context.Context, n int, sweepEvery time.Duration) *Store {
+	s := &Store{ctx: ctx}
+	c := clock
+	for range max(n, 1) {
+		sh := newShard()
+		s.shards = append(s.shards, sh)
+		s.wg.Go(func() {
+			// A nil channel never delivers, so the
+			// owner never sweeps.
+			var sweep <-chan time.Time
+			if sweepEvery > 0 {
+				ticker := c.NewTicker(sweepEvery)
+				defer ticker.Stop()
+				sweep = ticker.C
+			}
+			sh.serve(ctx, c, sweep)
+		})
+	}
+	return s
+}
+
+// shardFor hashes key with FNV-1a to pick its shard.
+func (s *Store) shardFor(key string) *shard {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	// TODO: Return the shard at index h.Sum32() modulo the
+	// number of shards
+	return s.shards[0]
+}
+
+// send delivers op on ops unless the store is closed.
+// Once an owner has received an op it always replies, so
+// callers can then wait on the reply channel alone.
+func send[T any](ctx context.Context, ops chan<- T, op T) error {
+	select {
+	case ops <- op:
+		return nil
+	case <-ctx.Done():
+		return ErrClosed
+	}
+}
+
+// Get returns the value of key and whether it is present.
+func (s *Store) Get(key string) (int, bool, error) {
+	op := readOp{key: key, resp: make(chan readResult, 1)}
+	if err := send(s.ctx, s.shardFor(key).reads, op); err != nil {
+		return 0, false, err
+	}
+	res := <-op.resp
+	return res.val, res.ok, nil
+}
+
+// Set stores val under key. A positive ttl makes the
+// entry expire after that long; zero keeps it forever.
+func (s *Store) Set(key string, val int, ttl time.Duration) error {
+	op := writeOp{key: key, val: val, ttl: ttl, resp: make(chan bool, 1)}
+	if err := send(s.ctx, s.shardFor(key).writes, op); err != nil {
+		return err
+	}
+	<-op.resp
+	return nil
+}
+
+// Delete removes key and reports whether it was present.
+func (s *Store) Delete(key string) (bool, error) {
+	op := deleteOp{key: key, resp: make(chan bool, 1)}
+	if err := send(s.ctx, s.shardFor(key).deletes, op); err != nil {
+		return false, err
+	}
+	return <-op.resp, nil
+}
+
+// CompareAndSwap sets key to val if its current value is
+// old, and reports whether it did. Because one goroutine
+// owns the key, no other operation can run between the
+// comparison and the swap.
+func (s *Store) CompareAndSwap(key string, old, val int) (bool, error) {
+	op := casOp{key: key, old: old, val: val, resp: make(chan bool, 1)}
+	if err := send(s.ctx, s.shardFor(key).cases, op); err != nil {
+		return false, err
+	}
+	return <-op.resp, nil
+}
+
+// Wait blocks until every shard owner has stopped, which
+// happens once the store's context is done.
+func (s *Store) Wait() {
+	s.wg.Wait()
+}
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	store := NewStore(ctx, 4, time.Second)
+
+	store.Set("a", 1, 0)
+	v, _, _ := store.Get("a")
+	fmt.Println("a =", v)
+
+	// Only the first swap finds the value it expects.
+	ok, _ := store.CompareAndSwap("a", 1, 2)
+	fmt.Println("swap 1 -> 2:", ok)
+	ok, _ = store.CompareAndSwap("a", 1, 3)
+	fmt.Println("swap 1 -> 3:", ok)
+	v, _, _ = store.Get("a")
+	fmt.Println("a =", v)
+
+	ok, _ = store.Delete("a")
+	fmt.Println("delete a:", ok)
+	_, ok, _ = store.Get("a")
+	fmt.Println("a present:", ok)
+
+	// An entry with a time to live disappears once it
+	// has passed.
+	store.Set("session", 42, 100*time.Millisecond)
+	_, ok, _ = store.Get("session")
+	fmt.Println("session present:", ok)
+	clock.Sleep(150 * time.Millisecond)
+	_, ok, _ = store.Get("session")
+	fmt.Println("session present after 150ms:", ok)
+
+	// Cancelling the context shuts the owners down;
+	// later operations fail with ErrClosed.
+	cancel()
+	store.Wait()
+	_, _, err := store.Get("a")
+	fmt.Println("after shutdown:", err)
+}
@@ -0,0 +1,229 @@
+// The [worker pool](worker-pools) example runs jobs that
+// can't fail, can't be cancelled and return bare values.
+// Real jobs call services that time out and return
+// errors. Here the pool takes a `context.Context`, jobs
+// return a `Result` and an `error`, failed jobs are
+// retried with backoff up to a limit, and the first job
+// that fails for good cancels all the others, like
+// `errgroup` does.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Job is a unit of work. Its `ID` is carried over to the
+// result, so results can be matched with their jobs
+// whatever order the workers finish them in.
+type Job struct {
+	ID int
+	N  int
+}
+
+// Result is the outcome of a job: the value its work
+// function returned and how many attempts it took.
+type Result struct {
+	JobID    int
+	Value    int
+	Attempts int
+}
+
+// Work does a job. It should give up when ctx is done.
+type Work func(ctx context.Context, job Job) (int, error)
+
+// permanentError marks an error that retrying won't fix.
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps err so that the pool doesn't retry it.
+func Permanent(err error) error {
+	return permanentError{err}
+}
+
+// isPermanent reports whether err, or an error it wraps,
+// was marked with Permanent.
+func isPermanent(err error) bool {
+	var p permanentError
+	return errors.As(err, &p)
+}
+
+// Pool runs jobs on a fixed number of workers.
+type Pool struct {
+	Workers int
+
+	// MaxAttempts is how many times a job is tried,
+	// counting the first attempt; less than 1 means 1.
+	MaxAttempts int
+
+	// Backoff is the wait before the second attempt. It
+	// doubles after every further failure.
+	Backoff time.Duration
+}
+
+// sleep waits for d, or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	}
+}
+
+// attempt runs job until it succeeds, fails permanently,
+// runs out of attempts or ctx is done.
+func (p Pool) attempt(ctx context.Context, job Job, work Work) (Result, error) {
+	backoff := p.Backoff
+	for n := 1; ; n++ {
+		v, err := work(ctx, job)
+		if err == nil {
+			return Result{JobID: job.ID, Value: v, Attempts: n}, nil
+		}
+		if ctx.Err() != nil {
+			// The pool was cancelled; another error, or
+			// the caller, caused it.
+			return Result{}, context.Cause(ctx)
+		}
+		if isPermanent(err) || n >= p.MaxAttempts {
+			return Result{}, fmt.Errorf("job %d: attempt %d: %w", job.ID, n, err)
+		}
+		if err := sleep(ctx, backoff); err != nil {
+			return Result{}, err
+		}
+		backoff *= 2
+	}
+}
+
+// Run does every job and returns their results in the
+// order of `jobs`. The first job that fails for good
+// cancels the context of all the others, and Run returns
+// its error with the results finished so far. Run
+// returns only after all its goroutines have stopped.
+func (p Pool) Run(ctx context.Context, jobs []Job, work Work) ([]Result, error) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	// The first error wins; later ones are most likely
+	// caused by the cancellation.
+	var once sync.Once
+	var firstErr error
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel(err)
+		})
+	}
+
+	// The feeder stops sending once the pool is
+	// cancelled, and always closes `queue` so that the
+	// workers' range loops end.
+	queue := make(chan Job)
+	go func() {
+		defer close(queue)
+		for _, job := range jobs {
+			select {
+			case queue <- job:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// `results` has room for every job, so workers never
+	// block on it.
+	results := make(chan Result, len(jobs))
+	var wg sync.WaitGroup
+	for range max(p.Workers, 1) {
+		wg.Go(func() {
+			for job := range queue {
+				if ctx.Err() != nil {
+					continue
+				}
+				res, err := p.attempt(ctx, job, work)
+				if err != nil {
+					fail(err)
+					continue
+				}
+				results <- res
+			}
+		})
+	}
+	wg.Wait()
+	close(results)
+
+	index := make(map[int]int, len(jobs))
+	for i, job := range jobs {
+		index[job.ID] = i
+	}
+	done := make([]*Result, len(jobs))
+	for res := range results {
+		done[index[res.JobID]] = &res
+	}
+	var out []Result
+	for _, res := range done {
+		if res != nil {
+			out = append(out, *res)
+		}
+	}
+
+	if firstErr == nil && ctx.Err() != nil {
+		// The caller's context ended the run.
+		firstErr = context.Cause(ctx)
+	}
+	return out, firstErr
+}
+
+// errTemporary is the error of an attempt worth retrying.
+var errTemporary = errors.New("temporary failure")
+
+func main() {
+	pool := Pool{Workers: 3, MaxAttempts: 3, Backoff: 10 * time.Millisecond}
+	jobs := []Job{{1, 10}, {2, 20}, {3, 30}, {4, 40}, {5, 50}}
+
+	// Job 3 fails its first attempt, and is retried.
+	var mu sync.Mutex
+	tries := make(map[int]int)
+	flaky := func(ctx context.Context, job Job) (int, error) {
+		mu.Lock()
+		tries[job.ID]++
+		n := tries[job.ID]
+		mu.Unlock()
+		if job.ID == 3 && n == 1 {
+			fmt.Println("job", job.ID, "failed attempt", n)
+			return 0, errTemporary
+		}
+		fmt.Println("job", job.ID, "done")
+		return job.N * 2, nil
+	}
+	results, err := pool.Run(context.Background(), jobs, flaky)
+	for _, res := range results {
+		fmt.Printf("result of job %d: %d after %d attempt(s)\n", res.JobID, res.Value, res.Attempts)
+	}
+	fmt.Println("error:", err)
+
+	// A permanent failure of job 2 cancels the jobs still
+	// running or waiting; which ones had finished depends
+	// on the scheduler, so we only print the error.
+	fatal := func(ctx context.Context, job Job) (int, error) {
+		if job.ID == 2 {
+			return 0, Permanent(errors.New("invalid input"))
+		}
+		if err := sleep(ctx, time.Second); err != nil {
+			return 0, err
+		}
+		return job.N * 2, nil
+	}
+	_, err = pool.Run(context.Background(), jobs, fatal)
+	fmt.Println("error:", err)
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+func makeJobs(n int) []Job {
+	jobs := make([]Job, n)
+	for i := range jobs {
+		jobs[i] = Job{ID: 100 + i, N: i}
+	}
+	return jobs
+}
+
+func double(ctx context.Context, job Job) (int, error) {
+	return job.N * 2, nil
+}
+
+func TestRunKeepsJobIDs(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	jobs := makeJobs(50)
+
+	results, err := Pool{Workers: 4, MaxAttempts: 1}.Run(context.Background(), jobs, double)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if len(results) != len(jobs) {
+		t.Fatalf("Expected %d results, got %d", len(jobs), len(results))
+	}
+	for i, res := range results {
+		if res.JobID != jobs[i].ID || res.Value != jobs[i].N*2 || res.Attempts != 1 {
+			t.Errorf("Expected result %d for job %d, got %+v", i, jobs[i].ID, res)
+		}
+	}
+}
+
+func TestRetries(t *testing.T) {
+	practicetest.CheckLeaks(t)
+
+	tests := []struct {
+		name        string
+		failures    int
+		maxAttempts int
+		attempts    int
+		wantErr     bool
+	}{
+		{"first try", 0, 3, 1, false},
+		{"retried", 2, 3, 3, false},
+		{"out of attempts", 3, 3, 3, true},
+		{"no retries", 1, 0, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			work := func(ctx context.Context, job Job) (int, error) {
+				if int(calls.Add(1)) <= tt.failures {
+					return 0, errTemporary
+				}
+				return 7, nil
+			}
+			pool := Pool{Workers: 2, MaxAttempts: tt.maxAttempts, Backoff: time.Millisecond}
+			results, err := pool.Run(context.Background(), []Job{{ID: 1}}, work)
+
+			if int(calls.Load()) != tt.attempts {
+				t.Errorf("Expected %d attempts, got %d", tt.attempts, calls.Load())
+			}
+			if tt.wantErr {
+				if !errors.Is(err, errTemporary) || len(results) != 0 {
+					t.Errorf("Expected errTemporary and no results, got %v, %v", results, err)
+				}
+				return
+			}
+			if err != nil || len(results) != 1 || results[0].Attempts != tt.attempts {
+				t.Errorf("Expected one result after %d attempts, got %+v, %v", tt.attempts, results, err)
+			}
+		})
+	}
+}
+
+func TestBackoffDoubles(t *testing.T) {
+	var mu sync.Mutex
+	var times []time.Time
+	work := func(ctx context.Context, job Job) (int, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		times = append(times, time.Now())
+		return 0, errTemporary
+	}
+	pool := Pool{Workers: 1, MaxAttempts: 4, Backoff: 20 * time.Millisecond}
+	pool.Run(context.Background(), []Job{{ID: 1}}, work)
+
+	if len(times) != 4 {
+		t.Fatalf("Expected 4 attempts, got %d", len(times))
+	}
+	for i, want := range []time.Duration{20, 40, 80} {
+		want *= time.Millisecond
+		if got := times[i+1].Sub(times[i]); got < want {
+			t.Errorf("Expected at least %v before attempt %d, got %v", want, i+2, got)
+		}
+	}
+}
+
+func TestPermanentErrorCancelsPool(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	errInvalid := errors.New("invalid")
+
+	var calls, cancelled atomic.Int32
+	work := func(ctx context.Context, job Job) (int, error) {
+		calls.Add(1)
+		if job.ID == 100 {
+			return 0, Permanent(errInvalid)
+		}
+		// The other jobs only finish when cancelled.
+		<-ctx.Done()
+		cancelled.Add(1)
+		return 0, ctx.Err()
+	}
+	pool := Pool{Workers: 3, MaxAttempts: 5, Backoff: time.Millisecond}
+	results, err := pool.Run(context.Background(), makeJobs(20), work)
+
+	if !errors.Is(err, errInvalid) {
+		t.Fatalf("Expected the permanent error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "job 100: attempt 1:") {
+		t.Errorf("Expected the error to name the job and attempt, got %q", err)
+	}
+	if len(results) != 0 {
+		t.Errorf("Expected no results, got %v", results)
+	}
+	// Only the jobs already running when job 100 failed
+	// were started, and none of them was retried.
+	if n := calls.Load(); n > 3 {
+		t.Errorf("Expected at most 3 jobs to start, got %d", n)
+	}
+	if calls.Load() != cancelled.Load()+1 {
+		t.Errorf("Expected every other started job to be cancelled, got %d calls and %d cancelled", calls.Load(), cancelled.Load())
+	}
+}
+
+func TestPartialResults(t *testing.T) {
+	practicetest.CheckLeaks(t)
+
+	// One worker runs the jobs in order, so the results
+	// of the jobs before the failing one are kept.
+	work := func(ctx context.Context, job Job) (int, error) {
+		if job.ID == 103 {
+			return 0, Permanent(errors.New("invalid"))
+		}
+		return job.N * 2, nil
+	}
+	results, err := Pool{Workers: 1}.Run(context.Background(), makeJobs(10), work)
+
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	var ids []int
+	for _, res := range results {
+		ids = append(ids, res.JobID)
+	}
+	if want := []int{100, 101, 102}; !slices.Equal(ids, want) {
+		t.Errorf("Expected results for %v, got %v", want, ids)
+	}
+}
+
+func TestCallerCancels(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	started := make(chan struct{}, 20)
+	work := func(ctx context.Context, job Job) (int, error) {
+		started <- struct{}{}
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+	go func() {
+		<-started
+		cancel()
+	}()
+	pool := Pool{Workers: 2, MaxAttempts: 3, Backoff: time.Hour}
+	_, err := pool.Run(ctx, makeJobs(20), work)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestCancelDuringBackoff(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	work := func(ctx context.Context, job Job) (int, error) {
+		return 0, errTemporary
+	}
+	// An hour of backoff must not keep Run from returning
+	// when the context ends.
+	start := time.Now()
+	pool := Pool{Workers: 1, MaxAttempts: 3, Backoff: time.Hour}
+	_, err := pool.Run(ctx, []Job{{ID: 1}}, work)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("Expected Run to return at the deadline, took %v", d)
+	}
+}
+
+func TestNoJobs(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	results, err := Pool{Workers: 3}.Run(context.Background(), nil, double)
+	if err != nil || len(results) != 0 {
+		t.Errorf("Expected no results and no error, got %v, %v", results, err)
+	}
+}
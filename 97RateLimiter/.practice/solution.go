@@ -0,0 +1,385 @@
+// The [rate limiting](rate-limiting) example limits
+// requests with a ticker and a buffered channel, inline
+// in `main`. Here the same ideas become reusable
+// limiters: a _token bucket_, which allows bursts, and a
+// _sliding window_, which allows a number of events per
+// period. Both can be asked whether an event is allowed
+// now, reserve a future slot or wait for one, and a
+// keyed limiter gives every client of an HTTP server its
+// own limit.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit"
+)
+
+// clock works like the time package. `practice check`
+// replaces it with a simulated clock, so the requests are
+// printed with the same times on every run. The limiters
+// take their clock as an argument, so tests can pass
+// them a simulated one too.
+var clock = practicekit.ClockFromEnv()
+
+// Limiter decides when events may happen.
+type Limiter interface {
+	// Allow reports whether an event may happen now,
+	// and if so counts it.
+	Allow() bool
+	// Reserve counts an event at the earliest time it
+	// may happen, which may be in the future.
+	Reserve() *Reservation
+	// Wait blocks until an event may happen, or fails if
+	// ctx ends first.
+	Wait(ctx context.Context) error
+}
+
+// Reservation is a slot for an event that a Limiter has
+// already counted.
+type Reservation struct {
+	clock  practicekit.Clock
+	at     time.Time
+	cancel func()
+}
+
+// Delay returns how long to wait before acting on the
+// reservation.
+func (r *Reservation) Delay() time.Duration {
+	return max(r.at.Sub(r.clock.Now()), 0)
+}
+
+// Cancel gives the slot back to the limiter, for when the
+// event won't happen after all.
+func (r *Reservation) Cancel() {
+	r.cancel()
+}
+
+// ErrWouldExceedDeadline is returned by Wait when the
+// event couldn't happen before the context's deadline.
+var ErrWouldExceedDeadline = errors.New("rate: wait would exceed context deadline")
+
+// reserver is the part that differs between the
+// algorithms: reserve counts an event at the earliest
+// time it may happen, unless that is more than maxWait
+// after now.
+type reserver interface {
+	reserve(now time.Time, maxWait time.Duration) (*Reservation, bool)
+}
+
+// limiter implements Limiter on top of a reserver.
+type limiter struct {
+	clock practicekit.Clock
+	r     reserver
+}
+
+func (l limiter) Allow() bool {
+	_, ok := l.r.reserve(l.clock.Now(), 0)
+	return ok
+}
+
+func (l limiter) Reserve() *Reservation {
+	r, _ := l.r.reserve(l.clock.Now(), math.MaxInt64)
+	return r
+}
+
+func (l limiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	// Don't take a slot that the deadline won't let us
+	// use. Deadlines are in real time, whatever the clock.
+	maxWait := time.Duration(math.MaxInt64)
+	if deadline, ok := ctx.Deadline(); ok {
+		maxWait = time.Until(deadline)
+	}
+	r, ok := l.r.reserve(l.clock.Now(), maxWait)
+	if !ok {
+		return ErrWouldExceedDeadline
+	}
+	delay := r.Delay()
+	if delay == 0 {
+		return nil
+	}
+	t := l.clock.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		r.Cancel()
+		return ctx.Err()
+	}
+}
+
+// tokenBucket holds up to `burst` tokens and gains one
+// every `every`. Each event takes a token; when there is
+// none left, the next event has to wait for one. Tokens
+// may go negative, which is how reservations of future
+// tokens are counted.
+type tokenBucket struct {
+	clock practicekit.Clock
+	every time.Duration
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket returns a limiter that allows an event
+// every `every` on average, and bursts of up to `burst`
+// events. It starts full. A burst below one is raised to
+// one, and an `every` of zero or less to a nanosecond,
+// which is as good as no limit.
+func NewTokenBucket(c practicekit.Clock, every time.Duration, burst int) Limiter {
+	burst = max(burst, 1)
+	b := &tokenBucket{
+		clock:  c,
+		every:  max(every, time.Nanosecond),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   c.Now(),
+	}
+	return limiter{c, b}
+}
+
+// advance adds the tokens gained since the last update.
+func (b *tokenBucket) advance(now time.Time) {
+	if now.After(b.last) {
+		gained := float64(now.Sub(b.last)) / float64(b.every)
+		b.tokens = min(b.tokens+gained, b.burst)
+		b.last = now
+	}
+}
+
+func (b *tokenBucket) reserve(now time.Time, maxWait time.Duration) (*Reservation, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.advance(now)
+
+	var wait time.Duration
+	if b.tokens < 1 {
+		wait = time.Duration((1 - b.tokens) * float64(b.every))
+	}
+	if wait > maxWait {
+		return nil, false
+	}
+	b.tokens--
+	r := &Reservation{clock: b.clock, at: now.Add(wait)}
+	// Once its time has come the token has been used, as
+	// the tokens gained since make up for it.
+	r.cancel = func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		now := b.clock.Now()
+		if !r.at.After(now) {
+			return
+		}
+		b.advance(now)
+		b.tokens = min(b.tokens+1, b.burst)
+	}
+	return r, true
+}
+
+// slidingWindow keeps the times of the events of the last
+// `window`, including reserved ones, in order. An event
+// may happen once the `limit`-th latest of them is a
+// whole window old.
+type slidingWindow struct {
+	clock  practicekit.Clock
+	limit  int
+	window time.Duration
+
+	mu     sync.Mutex
+	events []time.Time
+}
+
+// NewSlidingWindow returns a limiter that allows `limit`
+// events in any period of length `window`. A limit below
+// one is raised to one.
+func NewSlidingWindow(c practicekit.Clock, limit int, window time.Duration) Limiter {
+	return limiter{c, &slidingWindow{clock: c, limit: max(limit, 1), window: window}}
+}
+
+func (w *slidingWindow) reserve(now time.Time, maxWait time.Duration) (*Reservation, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// Forget the events that left the window.
+	i := 0
+	for i < len(w.events) && !w.events[i].Add(w.window).After(now) {
+		i++
+	}
+	w.events = w.events[i:]
+
+	at := now
+	if len(w.events) >= w.limit {
+		at = w.events[len(w.events)-w.limit].Add(w.window)
+	}
+	if at.Sub(now) > maxWait {
+		return nil, false
+	}
+	w.events = append(w.events, at)
+	r := &Reservation{clock: w.clock, at: at}
+	// Reservations made after this one keep their slots,
+	// so only cancelling the latest makes room for an
+	// earlier event.
+	r.cancel = func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		for i, t := range w.events {
+			if t.Equal(at) {
+				w.events = append(w.events[:i], w.events[i+1:]...)
+				return
+			}
+		}
+	}
+	return r, true
+}
+
+// Keyed gives every key, e.g. every client, a limiter of
+// its own, created on first use.
+type Keyed struct {
+	clock      practicekit.Clock
+	newLimiter func() Limiter
+
+	mu       sync.Mutex
+	limiters map[string]*keyedLimiter
+}
+
+type keyedLimiter struct {
+	Limiter
+	lastUsed time.Time
+}
+
+// NewKeyed returns a Keyed that creates limiters with
+// newLimiter.
+func NewKeyed(c practicekit.Clock, newLimiter func() Limiter) *Keyed {
+	return &Keyed{clock: c, newLimiter: newLimiter, limiters: make(map[string]*keyedLimiter)}
+}
+
+// Get returns the limiter of key.
+func (k *Keyed) Get(key string) Limiter {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	l, ok := k.limiters[key]
+	if !ok {
+		l = &keyedLimiter{Limiter: k.newLimiter()}
+		k.limiters[key] = l
+	}
+	l.lastUsed = k.clock.Now()
+	return l.Limiter
+}
+
+// Prune forgets the limiters unused for `idle`, so that
+// clients that went away don't use memory forever. It
+// returns how many are left. Idle should be long enough
+// for a limiter to have recovered, or clients get a fresh
+// limit after waiting that long.
+func (k *Keyed) Prune(idle time.Duration) int {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	now := k.clock.Now()
+	for key, l := range k.limiters {
+		if now.Sub(l.lastUsed) >= idle {
+			delete(k.limiters, key)
+		}
+	}
+	return len(k.limiters)
+}
+
+// clientIP keys requests by the IP address they come
+// from.
+func clientIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
+
+// Middleware limits the requests to next per key. A
+// request over the limit gets `429 Too Many Requests`,
+// with a `Retry-After` header saying in how many seconds
+// it would be allowed.
+func Middleware(k *Keyed, key func(*http.Request) string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r := k.Get(key(req)).Reserve()
+		if delay := r.Delay(); delay > 0 {
+			r.Cancel()
+			seconds := int(math.Ceil(delay.Seconds()))
+			w.Header().Set("Retry-After", strconv.Itoa(seconds))
+			http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// hello is the handler of the [HTTP server](http-server)
+// example.
+func hello(w http.ResponseWriter, req *http.Request) {
+	fmt.Fprintf(w, "hello\n")
+}
+
+func main() {
+	ctx := context.Background()
+
+	// As in the rate limiting example, we serve one
+	// request every 200 milliseconds, this time with
+	// a token bucket that holds a single token.
+	limiter := NewTokenBucket(clock, 200*time.Millisecond, 1)
+	for req := 1; req <= 5; req++ {
+		limiter.Wait(ctx)
+		fmt.Println("request", req, clock.Now())
+	}
+
+	// A bucket of 3 tokens lets the first 3 requests
+	// through at once.
+	bursty := NewTokenBucket(clock, 200*time.Millisecond, 3)
+	for req := 1; req <= 5; req++ {
+		bursty.Wait(ctx)
+		fmt.Println("request", req, clock.Now())
+	}
+
+	// A sliding window of 3 events per second refuses the
+	// 4th event until the first one is a second old.
+	window := NewSlidingWindow(clock, 3, time.Second)
+	var allowed []bool
+	for range 4 {
+		allowed = append(allowed, window.Allow())
+	}
+	fmt.Println("allowed:", allowed)
+	r := window.Reserve()
+	fmt.Println("next event in", r.Delay())
+	r.Cancel()
+
+	// Every client of the server gets 2 requests, and
+	// then one more per second.
+	clients := NewKeyed(clock, func() Limiter {
+		return NewTokenBucket(clock, time.Second, 2)
+	})
+	handler := Middleware(clients, clientIP, http.HandlerFunc(hello))
+	for _, addr := range []string{"10.0.0.1:5000", "10.0.0.1:5001", "10.0.0.2:5000", "10.0.0.1:5002"} {
+		req := httptest.NewRequest(http.MethodGet, "/hello", nil)
+		req.RemoteAddr = addr
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if after := rec.Header().Get("Retry-After"); after != "" {
+			fmt.Println(addr, rec.Code, "retry after", after+"s")
+		} else {
+			fmt.Println(addr, rec.Code)
+		}
+	}
+}
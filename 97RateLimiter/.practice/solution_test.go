@@ -0,0 +1,331 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit"
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+// simulated returns a clock that runs 1000 times faster
+// than real time. Its Sleep moves the time forward
+// exactly, so the limiters see precise durations.
+func simulated() *practicekit.Simulated {
+	return practicekit.NewSimulated(practicetest.Start, 1000)
+}
+
+// allows calls Allow n times and returns the results.
+func allows(l Limiter, n int) []bool {
+	var got []bool
+	for range n {
+		got = append(got, l.Allow())
+	}
+	return got
+}
+
+func TestTokenBucketAllow(t *testing.T) {
+	c := simulated()
+	l := NewTokenBucket(c, time.Second, 3)
+
+	// The bucket starts full, then refills one token per
+	// second, never above the burst.
+	steps := []struct {
+		sleep time.Duration
+		want  []bool
+	}{
+		{0, []bool{true, true, true, false}},
+		{time.Second, []bool{true, false}},
+		{500 * time.Millisecond, []bool{false}},
+		{500 * time.Millisecond, []bool{true, false}},
+		{time.Minute, []bool{true, true, true, false}},
+	}
+	for i, step := range steps {
+		c.Sleep(step.sleep)
+		if got := allows(l, len(step.want)); !slices.Equal(got, step.want) {
+			t.Errorf("Step %d: expected %v, got %v", i, step.want, got)
+		}
+	}
+}
+
+func TestTokenBucketReserve(t *testing.T) {
+	l := NewTokenBucket(simulated(), 100*time.Millisecond, 1)
+
+	// Each reservation takes the next token, even one that
+	// doesn't exist yet.
+	for i, want := range []time.Duration{0, 100, 200} {
+		want *= time.Millisecond
+		if got := l.Reserve().Delay(); got != want {
+			t.Errorf("Reservation %d: expected a delay of %v, got %v", i, want, got)
+		}
+	}
+
+	// Cancelling gives the token back.
+	r := l.Reserve()
+	r.Cancel()
+	if got := l.Reserve().Delay(); got != 300*time.Millisecond {
+		t.Errorf("Expected the cancelled slot to be reused, got a delay of %v", got)
+	}
+	if l.Allow() {
+		t.Error("Expected Allow to fail while tokens are reserved")
+	}
+}
+
+func TestTokenBucketLimits(t *testing.T) {
+	for _, burst := range []int{0, -1} {
+		l := NewTokenBucket(simulated(), time.Second, burst)
+		if got, want := allows(l, 2), []bool{true, false}; !slices.Equal(got, want) {
+			t.Errorf("Burst %d: expected %v, got %v", burst, want, got)
+		}
+	}
+
+	// Without a period between events, the bucket refills
+	// at once.
+	for _, every := range []time.Duration{0, -time.Second} {
+		c := simulated()
+		l := NewTokenBucket(c, every, 1)
+		l.Allow()
+		c.Sleep(time.Millisecond)
+		if got, want := allows(l, 1), []bool{true}; !slices.Equal(got, want) {
+			t.Errorf("Every %v: expected %v, got %v", every, want, got)
+		}
+		if d := l.Reserve().Delay(); d > time.Nanosecond {
+			t.Errorf("Every %v: expected no delay, got %v", every, d)
+		}
+	}
+}
+
+func TestTokenBucketCancelAfterDue(t *testing.T) {
+	c := simulated()
+	l := NewTokenBucket(c, 100*time.Millisecond, 2)
+
+	// A reservation whose time has passed was used, so
+	// cancelling it must not give its token back: only the
+	// one gained in the meantime is left.
+	l.Reserve()
+	r := l.Reserve()
+	c.Sleep(100 * time.Millisecond)
+	r.Cancel()
+	if got, want := allows(l, 2), []bool{true, false}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestSlidingWindowAllow(t *testing.T) {
+	c := simulated()
+	l := NewSlidingWindow(c, 3, time.Second)
+
+	if got, want := allows(l, 4), []bool{true, true, true, false}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	c.Sleep(500 * time.Millisecond)
+	if l.Allow() {
+		t.Error("Expected the window to be full after 500ms")
+	}
+	// The first events leave the window after exactly a
+	// second.
+	c.Sleep(500 * time.Millisecond)
+	if got, want := allows(l, 4), []bool{true, true, true, false}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v after a second, got %v", want, got)
+	}
+}
+
+func TestSlidingWindowReserve(t *testing.T) {
+	c := simulated()
+	l := NewSlidingWindow(c, 2, time.Second)
+
+	l.Allow()
+	c.Sleep(300 * time.Millisecond)
+	l.Allow()
+
+	// The window is full: the next event has to wait for
+	// the first one to leave, and the one after that for
+	// the second.
+	if got := l.Reserve().Delay(); got != 700*time.Millisecond {
+		t.Errorf("Expected a delay of 700ms, got %v", got)
+	}
+	second := l.Reserve()
+	if got := second.Delay(); got != time.Second {
+		t.Errorf("Expected a delay of 1s, got %v", got)
+	}
+
+	second.Cancel()
+	if got := l.Reserve().Delay(); got != time.Second {
+		t.Errorf("Expected the cancelled slot to be reused, got a delay of %v", got)
+	}
+}
+
+func TestSlidingWindowLimitBelowOne(t *testing.T) {
+	for _, limit := range []int{0, -1} {
+		l := NewSlidingWindow(simulated(), limit, time.Second)
+		if got, want := allows(l, 2), []bool{true, false}; !slices.Equal(got, want) {
+			t.Errorf("Limit %d: expected %v, got %v", limit, want, got)
+		}
+	}
+}
+
+func TestWait(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		new  func(c practicekit.Clock) Limiter
+	}{
+		{"token bucket", func(c practicekit.Clock) Limiter { return NewTokenBucket(c, 100*time.Millisecond, 1) }},
+		{"sliding window", func(c practicekit.Clock) Limiter { return NewSlidingWindow(c, 1, 100*time.Millisecond) }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c := simulated()
+			l := tt.new(c)
+
+			// Each Wait returns exactly when the next event
+			// is allowed.
+			for i := range 3 {
+				if err := l.Wait(context.Background()); err != nil {
+					t.Fatalf("Wait failed: %v", err)
+				}
+				if want := practicetest.Start.Add(time.Duration(i) * 100 * time.Millisecond); !c.Now().Equal(want) {
+					t.Errorf("Expected Wait %d to return at %v, got %v", i, want, c.Now())
+				}
+			}
+		})
+	}
+}
+
+func TestWaitDeadline(t *testing.T) {
+	l := NewTokenBucket(practicekit.System(), time.Hour, 1)
+	l.Allow()
+
+	// A wait that would outlast the deadline fails at once
+	// and doesn't take the token.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	begin := time.Now()
+	if err := l.Wait(ctx); !errors.Is(err, ErrWouldExceedDeadline) {
+		t.Errorf("Expected ErrWouldExceedDeadline, got %v", err)
+	}
+	if d := time.Since(begin); d > time.Second {
+		t.Errorf("Expected Wait to fail at once, took %v", d)
+	}
+	if d := l.Reserve().Delay(); d > time.Hour {
+		t.Errorf("Expected the token to be left for the next event, got a delay of %v", d)
+	}
+}
+
+func TestWaitCancelled(t *testing.T) {
+	l := NewTokenBucket(practicekit.System(), time.Hour, 1)
+	l.Allow()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	if err := l.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	// The cancelled wait gave its reservation back.
+	if d := l.Reserve().Delay(); d > time.Hour {
+		t.Errorf("Expected a delay under an hour, got %v", d)
+	}
+}
+
+func TestConcurrentAllow(t *testing.T) {
+	l := NewTokenBucket(practicekit.System(), time.Hour, 100)
+
+	var allowed atomic.Int32
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Go(func() {
+			for range 20 {
+				if l.Allow() {
+					allowed.Add(1)
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	if n := allowed.Load(); n != 100 {
+		t.Errorf("Expected exactly 100 events allowed, got %d", n)
+	}
+}
+
+func TestKeyed(t *testing.T) {
+	c := simulated()
+	k := NewKeyed(c, func() Limiter { return NewTokenBucket(c, time.Second, 1) })
+
+	if !k.Get("a").Allow() || k.Get("a").Allow() {
+		t.Error("Expected a to get exactly one event")
+	}
+	if !k.Get("b").Allow() {
+		t.Error("Expected b to have a limit of its own")
+	}
+
+	c.Sleep(30 * time.Second)
+	k.Get("b")
+	c.Sleep(30 * time.Second)
+	if n := k.Prune(time.Minute); n != 1 {
+		t.Errorf("Expected only b to be kept, got %d limiters", n)
+	}
+	if !k.Get("a").Allow() {
+		t.Error("Expected a fresh limiter for a after pruning")
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	c := simulated()
+	k := NewKeyed(c, func() Limiter { return NewTokenBucket(c, 2*time.Second, 1) })
+	handler := Middleware(k, clientIP, http.HandlerFunc(hello))
+
+	get := func(addr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/hello", nil)
+		req.RemoteAddr = addr
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := get("10.0.0.1:1000"); rec.Code != http.StatusOK || rec.Body.String() != "hello\n" {
+		t.Errorf("Expected the first request to reach hello, got %d %q", rec.Code, rec.Body.String())
+	}
+	// Another port is the same client.
+	rec := get("10.0.0.1:1001")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Errorf("Expected status %d, got %d", http.StatusTooManyRequests, rec.Code)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "2" {
+		t.Errorf("Expected Retry-After 2, got %q", got)
+	}
+	if rec := get("10.0.0.2:1000"); rec.Code != http.StatusOK {
+		t.Errorf("Expected another client to be allowed, got %d", rec.Code)
+	}
+
+	// Refused requests don't use up the limit.
+	c.Sleep(2 * time.Second)
+	if rec := get("10.0.0.1:1002"); rec.Code != http.StatusOK {
+		t.Errorf("Expected the client to be allowed after Retry-After, got %d", rec.Code)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		addr, want string
+	}{
+		{"10.0.0.1:5000", "10.0.0.1"},
+		{"[::1]:5000", "::1"},
+		{"pipe", "pipe"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = tt.addr
+		if got := clientIP(req); got != tt.want {
+			t.Errorf("clientIP(%q): expected %q, got %q", tt.addr, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit/practicetest"
+)
+
+// collect receives everything from in until it's closed.
+// It fails the test instead of hanging if in is never
+// closed.
+func collect[T any](t *testing.T, in <-chan T) []T {
+	t.Helper()
+	var got []T
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case v, ok := <-in:
+			if !ok {
+				return got
+			}
+			got = append(got, v)
+		case <-timeout:
+			t.Fatalf("Expected the channel to be closed, got %v so far", got)
+		}
+	}
+}
+
+// count returns a slice of 1..n.
+func count(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i + 1
+	}
+	return s
+}
+
+func TestSource(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	ctx := context.Background()
+
+	if got := collect(t, Source(ctx, 1, 2, 3)); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", got)
+	}
+	if got := collect(t, Source[int](ctx)); len(got) != 0 {
+		t.Errorf("Expected an empty source to be closed at once, got %v", got)
+	}
+}
+
+func TestMapFilter(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	ctx := context.Background()
+
+	odd := Filter(ctx, Source(ctx, count(6)...), func(n int) bool { return n%2 == 1 })
+	got := collect(t, Map(ctx, odd, strconv.Itoa))
+	if want := []string{"1", "3", "5"}; !slices.Equal(got, want) {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		n, size int
+		want    [][]int
+	}{
+		{6, 3, [][]int{{1, 2, 3}, {4, 5, 6}}},
+		{5, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{2, 5, [][]int{{1, 2}}},
+		{0, 3, nil},
+	}
+	for _, tt := range tests {
+		got := collect(t, Batch(ctx, Source(ctx, count(tt.n)...), tt.size))
+		if !slices.EqualFunc(got, tt.want, slices.Equal) {
+			t.Errorf("Batch of %d by %d: expected %v, got %v", tt.n, tt.size, tt.want, got)
+		}
+	}
+}
+
+func TestFanOutMerge(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	ctx := context.Background()
+
+	branches := FanOut(ctx, Source(ctx, count(100)...), 4)
+	if len(branches) != 4 {
+		t.Fatalf("Expected 4 branches, got %d", len(branches))
+	}
+	var doubled []<-chan int
+	for _, b := range branches {
+		doubled = append(doubled, Map(ctx, b, func(n int) int { return n * 2 }))
+	}
+
+	// Every value goes through exactly one branch.
+	got := collect(t, Merge(ctx, doubled...))
+	slices.Sort(got)
+	want := make([]int, 100)
+	for i := range want {
+		want[i] = (i + 1) * 2
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Expected each value once, got %v", got)
+	}
+}
+
+func TestMergeNothing(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	if got := collect(t, Merge[int](context.Background())); len(got) != 0 {
+		t.Errorf("Expected merging nothing to be closed at once, got %v", got)
+	}
+}
+
+func TestEarlyTermination(t *testing.T) {
+	// Every kind of stage is left blocked on a send when
+	// the consumer stops reading; cancelling must unblock
+	// them all.
+	stages := map[string]func(ctx context.Context) <-chan int{
+		"source": func(ctx context.Context) <-chan int {
+			return Source(ctx, count(1000)...)
+		},
+		"map": func(ctx context.Context) <-chan int {
+			return Map(ctx, Source(ctx, count(1000)...), func(n int) int { return n })
+		},
+		"filter": func(ctx context.Context) <-chan int {
+			return Filter(ctx, Source(ctx, count(1000)...), func(n int) bool { return true })
+		},
+		"fan out and merge": func(ctx context.Context) <-chan int {
+			return Merge(ctx, FanOut(ctx, Source(ctx, count(1000)...), 4)...)
+		},
+		"batch": func(ctx context.Context) <-chan int {
+			return Map(ctx, Batch(ctx, Source(ctx, count(1000)...), 3), func(b []int) int { return b[0] })
+		},
+	}
+	for name, stage := range stages {
+		t.Run(name, func(t *testing.T) {
+			practicetest.CheckLeaks(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			out := stage(ctx)
+			<-out
+			<-out
+			cancel()
+		})
+	}
+}
+
+func TestCancelledBeforeStart(t *testing.T) {
+	practicetest.CheckLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// A cancelled pipeline closes its output even though
+	// its source never ran out. A select with both cases
+	// ready picks one at random, so a few values may still
+	// get through.
+	out := Batch(ctx, Map(ctx, Source(ctx, count(1000)...), func(n int) int { return n }), 10)
+	if got := collect(t, out); len(got) == 100 {
+		t.Error("Expected the cancelled pipeline to stop early")
+	}
+}
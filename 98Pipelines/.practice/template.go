@@ -0,0 +1,158 @@
+// The channel examples each show one primitive:
+// [directions](channel-directions), [closing](closing-channels)
+// and [ranging](range-over-channels). Put together, they
+// make _pipelines_: stages connected by channels, where
+// each stage is a goroutine that receives values from
+// upstream, does something with them and sends the
+// results downstream. Here we write the stages once, as
+// generic functions, and stop a whole pipeline early with
+// a `context.Context`.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Every stage follows the same rules. It returns a
+// receive-only channel, so only the stage itself can
+// send on or close it. It closes that channel when its
+// input is exhausted, so the end of the data flows
+// down the pipeline. And it stops as soon as `ctx` is
+// done, even in the middle of a send, so that a consumer
+// that stops reading doesn't leave goroutines blocked
+// forever upstream.
+
+// Source sends `items` on the returned channel.
+func Source[T any](ctx context.Context, items ...T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for _, item := range items {
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Map sends `f(v)` for every `v` received from `in`.
+func Map[In, Out any](ctx context.Context, in <-chan In, f func(In) Out) <-chan Out {
+	out := make(chan Out)
+	go func() {
+		defer close(out)
+		// TODO: For every v received from in, send f(v) on
+		// out, like Source sends its items; return when
+		// ctx is done
+	}()
+	return out
+}
+
+// Filter passes on the values of `in` for which `keep`
+// returns true.
+func Filter[T any](ctx context.Context, in <-chan T, keep func(T) bool) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		// TODO: Like Map, but only send the values for
+		// which keep(v) is true
+	}()
+	return out
+}
+
+// FanOut spreads the values of `in` over `n` channels.
+// Each value goes to exactly one of them, whichever is
+// ready first, so slow consumers get fewer values. All
+// of them are closed once `in` is.
+func FanOut[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	outs := make([]<-chan T, n)
+	for i := range outs {
+		// The outputs are simply n stages reading the same
+		// channel; receiving from a channel is safe from
+		// any number of goroutines.
+		outs[i] = Map(ctx, in, func(v T) T { return v })
+	}
+	return outs
+}
+
+// Merge sends the values of all `ins` on one channel,
+// and closes it once all of them are closed.
+func Merge[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	// TODO: For each in of ins, start a goroutine with
+	// wg.Go that sends every value of in on out, and
+	// returns when ctx is done
+
+	// Only the last sender may close `out`, so a separate
+	// goroutine closes it once every sender is done.
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// Batch groups the values of `in` into slices of `size`.
+// The last batch holds what's left, and may be shorter.
+func Batch[T any](ctx context.Context, in <-chan T, size int) <-chan []T {
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+		// TODO: Append the values of in to a batch, and
+		// send the batch on out (then start a new one)
+		// whenever it holds size values. Send what's left
+		// at the end if it isn't empty. Return when ctx is
+		// done
+	}()
+	return out
+}
+
+func main() {
+	ctx := context.Background()
+
+	// A straight pipeline keeps the order of its values:
+	// the squares of the even numbers, in batches of 3.
+	numbers := Source(ctx, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	even := Filter(ctx, numbers, func(n int) bool { return n%2 == 0 })
+	squares := Map(ctx, even, func(n int) int { return n * n })
+	for batch := range Batch(ctx, squares, 3) {
+		fmt.Println(batch)
+	}
+
+	// Fanning out runs a slow stage on several goroutines,
+	// and merging collects their results. The order of the
+	// results then depends on the scheduler, so we sort
+	// them.
+	words := Source(ctx, "fan", "out", "and", "merge", "back", "in")
+	var upper []<-chan string
+	for _, branch := range FanOut(ctx, words, 3) {
+		upper = append(upper, Map(ctx, branch, strings.ToUpper))
+	}
+	var results []string
+	for w := range Merge(ctx, upper...) {
+		results = append(results, w)
+	}
+	slices.Sort(results)
+	fmt.Println(results)
+
+	// A consumer that only needs the first values cancels
+	// the context, which stops every stage upstream.
+	ctx, cancel := context.WithCancel(ctx)
+	var first []int
+	for n := range Map(ctx, Source(ctx, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10), func(n int) int { return n * 10 }) {
+		first = append(first, n)
+		if len(first) == 3 {
+			break
+		}
+	}
+	cancel()
+	fmt.Println(first)
+}
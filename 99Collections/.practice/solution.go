@@ -0,0 +1,482 @@
+// The [generics](generics) example defines a `List[T]`
+// with `Push` and `AllElements`, and
+// [range over iterators](range-over-iterators) gives it
+// an `All` iterator. Here that list grows into a small
+// container library: a doubly-linked list, a set, a map
+// that keeps its keys in order and a heap, all generic
+// and all with iterators, so they work with `for range`
+// and with the `slices` and `maps` packages.
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"maps"
+	"math/rand/v2"
+	"slices"
+)
+
+// List is a doubly-linked list. Like `container/list`, it
+// is a ring around a sentinel element, `root`, so that
+// inserting and removing never has to special-case the
+// ends. The zero value is an empty list ready to use.
+type List[T any] struct {
+	root Element[T]
+	len  int
+}
+
+// Element is an element of a List. Keeping a pointer to
+// one lets us remove it or insert next to it in constant
+// time.
+type Element[T any] struct {
+	next, prev *Element[T]
+	list       *List[T]
+	Value      T
+}
+
+// Next returns the element after e, or nil at the end.
+func (e *Element[T]) Next() *Element[T] {
+	if n := e.next; e.list != nil && n != &e.list.root {
+		return n
+	}
+	return nil
+}
+
+// Prev returns the element before e, or nil at the front.
+func (e *Element[T]) Prev() *Element[T] {
+	if p := e.prev; e.list != nil && p != &e.list.root {
+		return p
+	}
+	return nil
+}
+
+// lazyInit links the sentinel to itself on first use.
+func (l *List[T]) lazyInit() {
+	if l.root.next == nil {
+		l.root.next = &l.root
+		l.root.prev = &l.root
+	}
+}
+
+// Len returns the number of elements.
+func (l *List[T]) Len() int { return l.len }
+
+// Front returns the first element, or nil if the list is
+// empty.
+func (l *List[T]) Front() *Element[T] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+// Back returns the last element, or nil if the list is
+// empty.
+func (l *List[T]) Back() *Element[T] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+// insert links a new element holding v after at.
+func (l *List[T]) insert(v T, at *Element[T]) *Element[T] {
+	e := &Element[T]{Value: v, list: l, prev: at, next: at.next}
+	at.next.prev = e
+	at.next = e
+	l.len++
+	return e
+}
+
+// Push adds v at the back of the list, as in the
+// generics example.
+func (l *List[T]) Push(v T) *Element[T] {
+	l.lazyInit()
+	return l.insert(v, l.root.prev)
+}
+
+// PushFront adds v at the front of the list.
+func (l *List[T]) PushFront(v T) *Element[T] {
+	l.lazyInit()
+	return l.insert(v, &l.root)
+}
+
+// InsertAfter adds v right after mark, which must be an
+// element of l.
+func (l *List[T]) InsertAfter(v T, mark *Element[T]) *Element[T] {
+	if mark.list != l {
+		panic("collections: InsertAfter with an element of another list")
+	}
+	return l.insert(v, mark)
+}
+
+// Remove unlinks e from l and returns its value. Removing
+// an element that is no longer in l does nothing.
+func (l *List[T]) Remove(e *Element[T]) T {
+	if e.list == l {
+		e.prev.next = e.next
+		e.next.prev = e.prev
+		// Clear the links so that the element can't reach
+		// the list anymore.
+		e.next, e.prev, e.list = nil, nil, nil
+		l.len--
+	}
+	return e.Value
+}
+
+// All iterates over the values from front to back.
+func (l *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward iterates over the values from back to front.
+func (l *List[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Back(); e != nil; e = e.Prev() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Set is a set of comparable values, a map with empty
+// values. The zero value is an empty set ready to use.
+type Set[T comparable] struct {
+	m map[T]struct{}
+}
+
+// SetOf returns a set holding vals.
+func SetOf[T comparable](vals ...T) *Set[T] {
+	s := &Set[T]{}
+	for _, v := range vals {
+		s.Add(v)
+	}
+	return s
+}
+
+// Add adds v to the set and reports whether it was new.
+func (s *Set[T]) Add(v T) bool {
+	if s.m == nil {
+		s.m = make(map[T]struct{})
+	}
+	if _, ok := s.m[v]; ok {
+		return false
+	}
+	s.m[v] = struct{}{}
+	return true
+}
+
+// Remove removes v and reports whether it was there.
+func (s *Set[T]) Remove(v T) bool {
+	if _, ok := s.m[v]; !ok {
+		return false
+	}
+	delete(s.m, v)
+	return true
+}
+
+// Contains reports whether v is in the set.
+func (s *Set[T]) Contains(v T) bool {
+	_, ok := s.m[v]
+	return ok
+}
+
+// Len returns the number of values in the set.
+func (s *Set[T]) Len() int { return len(s.m) }
+
+// All iterates over the values in no particular order.
+func (s *Set[T]) All() iter.Seq[T] {
+	return maps.Keys(s.m)
+}
+
+// Union returns a new set with the values of s and t.
+func (s *Set[T]) Union(t *Set[T]) *Set[T] {
+	u := SetOf(slices.Collect(s.All())...)
+	for v := range t.All() {
+		u.Add(v)
+	}
+	return u
+}
+
+// Intersection returns a new set with the values that are
+// in both s and t.
+func (s *Set[T]) Intersection(t *Set[T]) *Set[T] {
+	u := &Set[T]{}
+	for v := range s.All() {
+		if t.Contains(v) {
+			u.Add(v)
+		}
+	}
+	return u
+}
+
+// Map is a map that keeps its keys in order, built as a
+// [skip list](https://en.wikipedia.org/wiki/Skip_list): a
+// sorted linked list where every node also gets, with
+// probability 1/4 per level, links that skip over the
+// nodes below. Searching follows the highest links first,
+// so it takes O(log n) steps on average. The zero value is
+// an empty map ready to use.
+type Map[K cmp.Ordered, V any] struct {
+	head *node[K, V]
+	len  int
+}
+
+// maxLevel bounds the levels of the skip list; 4^16
+// nodes are more than enough.
+const maxLevel = 16
+
+type node[K cmp.Ordered, V any] struct {
+	key  K
+	val  V
+	next []*node[K, V]
+}
+
+// randomLevel returns the number of levels of a new node:
+// 1, then one more with probability 1/4 each time.
+func randomLevel() int {
+	level := 1
+	for level < maxLevel && rand.IntN(4) == 0 {
+		level++
+	}
+	return level
+}
+
+// search returns, for every level, the last node whose
+// key is less than key. Keys are compared with cmp.Less
+// and cmp.Compare rather than < and ==, which order NaN
+// before every other float, so that a NaN key can be
+// found again. The map must have its head.
+func (m *Map[K, V]) search(key K) [maxLevel]*node[K, V] {
+	var prev [maxLevel]*node[K, V]
+	n := m.head
+	for level := maxLevel - 1; level >= 0; level-- {
+		for n.next[level] != nil && cmp.Less(n.next[level].key, key) {
+			n = n.next[level]
+		}
+		prev[level] = n
+	}
+	return prev
+}
+
+// Get returns the value of key and whether it is present.
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	var zero V
+	if m.head == nil {
+		return zero, false
+	}
+	prev := m.search(key)
+	if n := prev[0].next[0]; n != nil && cmp.Compare(n.key, key) == 0 {
+		return n.val, true
+	}
+	return zero, false
+}
+
+// Set sets the value of key.
+func (m *Map[K, V]) Set(key K, val V) {
+	if m.head == nil {
+		m.head = &node[K, V]{next: make([]*node[K, V], maxLevel)}
+	}
+	prev := m.search(key)
+	if n := prev[0].next[0]; n != nil && cmp.Compare(n.key, key) == 0 {
+		n.val = val
+		return
+	}
+	n := &node[K, V]{key: key, val: val, next: make([]*node[K, V], randomLevel())}
+	for level := range n.next {
+		n.next[level] = prev[level].next[level]
+		prev[level].next[level] = n
+	}
+	m.len++
+}
+
+// Delete removes key and reports whether it was present.
+func (m *Map[K, V]) Delete(key K) bool {
+	if m.head == nil {
+		return false
+	}
+	prev := m.search(key)
+	n := prev[0].next[0]
+	if n == nil || cmp.Compare(n.key, key) != 0 {
+		return false
+	}
+	for level := range n.next {
+		prev[level].next[level] = n.next[level]
+	}
+	m.len--
+	return true
+}
+
+// Len returns the number of keys.
+func (m *Map[K, V]) Len() int { return m.len }
+
+// All iterates over the keys and values in key order.
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if m.head == nil {
+			return
+		}
+		for n := m.head.next[0]; n != nil; n = n.next[0] {
+			if !yield(n.key, n.val) {
+				return
+			}
+		}
+	}
+}
+
+// Keys iterates over the keys in order.
+func (m *Map[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values iterates over the values in key order.
+func (m *Map[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Heap is a binary heap: a slice where every value is
+// less than or equal to its children, at 2i+1 and 2i+2,
+// by the heap's `less` function. Its first value is
+// therefore the least.
+type Heap[T any] struct {
+	less func(a, b T) bool
+	data []T
+}
+
+// NewHeap returns an empty heap ordered by less.
+func NewHeap[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{less: less}
+}
+
+// Len returns the number of values in the heap.
+func (h *Heap[T]) Len() int { return len(h.data) }
+
+// Push adds v, moving it up past its greater parents.
+func (h *Heap[T]) Push(v T) {
+	h.data = append(h.data, v)
+	i := len(h.data) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.data[i], h.data[parent]) {
+			break
+		}
+		h.data[i], h.data[parent] = h.data[parent], h.data[i]
+		i = parent
+	}
+}
+
+// Peek returns the least value without removing it.
+func (h *Heap[T]) Peek() (T, bool) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.data[0], true
+}
+
+// Pop removes and returns the least value. The last value
+// takes its place and moves down past its lesser
+// children.
+func (h *Heap[T]) Pop() (T, bool) {
+	top, ok := h.Peek()
+	if !ok {
+		return top, false
+	}
+	last := len(h.data) - 1
+	h.data[0] = h.data[last]
+	// Clear the old last slot, so that the slice's backing
+	// array doesn't keep what it points to alive.
+	var zero T
+	h.data[last] = zero
+	h.data = h.data[:last]
+
+	i := 0
+	for {
+		least := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(h.data) && h.less(h.data[child], h.data[least]) {
+				least = child
+			}
+		}
+		if least == i {
+			return top, true
+		}
+		h.data[i], h.data[least] = h.data[least], h.data[i]
+		i = least
+	}
+}
+
+// All iterates over the values in heap order, which is
+// not sorted; only the first value is the least.
+func (h *Heap[T]) All() iter.Seq[T] {
+	return slices.Values(h.data)
+}
+
+// Drain pops the values in order, least first, for as
+// long as the loop runs.
+func (h *Heap[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for h.Len() > 0 {
+			v, _ := h.Pop()
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func main() {
+	var lst List[int]
+	lst.Push(10)
+	e := lst.Push(13)
+	lst.Push(23)
+	lst.PushFront(1)
+	lst.InsertAfter(15, e)
+	lst.Remove(e)
+	fmt.Println("list:", slices.Collect(lst.All()))
+	fmt.Println("backward:", slices.Collect(lst.Backward()))
+
+	// Set iteration order is random, so we sort.
+	a := SetOf("go", "rust", "zig")
+	b := SetOf("go", "c")
+	fmt.Println("union:", slices.Sorted(a.Union(b).All()))
+	fmt.Println("intersection:", slices.Sorted(a.Intersection(b).All()))
+
+	var m Map[string, int]
+	for i, k := range []string{"pear", "apple", "fig", "banana"} {
+		m.Set(k, i)
+	}
+	m.Delete("fig")
+	for k, v := range m.All() {
+		fmt.Println(k, v)
+	}
+
+	// A heap with a reversed `less` pops the greatest
+	// value first.
+	h := NewHeap(func(a, b int) bool { return a > b })
+	for _, v := range []int{5, 1, 8, 3, 9} {
+		h.Push(v)
+	}
+	fmt.Println("heap:", slices.Collect(h.Drain()))
+}
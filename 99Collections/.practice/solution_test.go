@@ -0,0 +1,380 @@
+package main
+
+import (
+	"cmp"
+	"maps"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// checkList fails the test if lst doesn't hold want, in
+// both directions.
+func checkList(t *testing.T, lst *List[int], want []int) {
+	t.Helper()
+	if lst.Len() != len(want) {
+		t.Errorf("Expected Len %d, got %d", len(want), lst.Len())
+	}
+	if got := slices.Collect(lst.All()); !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	backward := slices.Clone(want)
+	slices.Reverse(backward)
+	if got := slices.Collect(lst.Backward()); !slices.Equal(got, backward) {
+		t.Errorf("Expected %v backward, got %v", backward, got)
+	}
+}
+
+func TestList(t *testing.T) {
+	var lst List[int]
+	checkList(t, &lst, nil)
+	if lst.Front() != nil || lst.Back() != nil {
+		t.Error("Expected no front or back in an empty list")
+	}
+
+	a := lst.Push(2)
+	b := lst.Push(4)
+	lst.PushFront(1)
+	lst.InsertAfter(3, a)
+	lst.InsertAfter(5, b)
+	checkList(t, &lst, []int{1, 2, 3, 4, 5})
+
+	if v := lst.Remove(b); v != 4 {
+		t.Errorf("Expected Remove to return 4, got %d", v)
+	}
+	checkList(t, &lst, []int{1, 2, 3, 5})
+
+	// Removing twice, or from another list, does nothing.
+	lst.Remove(b)
+	var other List[int]
+	other.Remove(a)
+	checkList(t, &lst, []int{1, 2, 3, 5})
+
+	lst.Remove(lst.Front())
+	lst.Remove(lst.Back())
+	checkList(t, &lst, []int{2, 3})
+}
+
+func TestListBreak(t *testing.T) {
+	var lst List[int]
+	for i := range 5 {
+		lst.Push(i)
+	}
+
+	// Breaking out of the loop must stop the iterators.
+	for name, seq := range map[string]func() []int{
+		"All": func() []int {
+			var seen []int
+			for v := range lst.All() {
+				seen = append(seen, v)
+				if v == 1 {
+					break
+				}
+			}
+			return seen
+		},
+		"Backward": func() []int {
+			var seen []int
+			for v := range lst.Backward() {
+				seen = append(seen, v)
+				if v == 3 {
+					break
+				}
+			}
+			return seen
+		},
+	} {
+		if got := seq(); len(got) != 2 {
+			t.Errorf("%s: expected iteration to stop after 2 values, got %v", name, got)
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	var s Set[string]
+	if s.Contains("a") || s.Remove("a") || s.Len() != 0 {
+		t.Error("Expected the zero Set to be empty")
+	}
+	if !s.Add("a") || s.Add("a") {
+		t.Error("Expected Add to report only new values")
+	}
+	s.Add("b")
+	if !s.Contains("a") || s.Len() != 2 {
+		t.Errorf("Expected {a b}, got %v", slices.Sorted(s.All()))
+	}
+	if !s.Remove("a") || s.Contains("a") {
+		t.Error("Expected a to be removed")
+	}
+
+	x, y := SetOf(1, 2, 3), SetOf(2, 3, 4)
+	if got, want := slices.Sorted(x.Union(y).All()), []int{1, 2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("Expected union %v, got %v", want, got)
+	}
+	if got, want := slices.Sorted(x.Intersection(y).All()), []int{2, 3}; !slices.Equal(got, want) {
+		t.Errorf("Expected intersection %v, got %v", want, got)
+	}
+	if x.Len() != 3 || y.Len() != 3 {
+		t.Error("Expected Union and Intersection to leave their operands alone")
+	}
+}
+
+func TestMap(t *testing.T) {
+	var m Map[string, int]
+	if _, ok := m.Get("a"); ok || m.Len() != 0 {
+		t.Error("Expected the zero Map to be empty")
+	}
+	if m.Delete("a") || m.head != nil {
+		t.Error("Expected reading the zero Map to leave it unchanged")
+	}
+
+	for i, k := range []string{"d", "b", "a", "c", "b"} {
+		m.Set(k, i)
+	}
+	if got, want := slices.Collect(m.Keys()), []string{"a", "b", "c", "d"}; !slices.Equal(got, want) {
+		t.Errorf("Expected keys %v, got %v", want, got)
+	}
+	if got, want := slices.Collect(m.Values()), []int{2, 4, 3, 0}; !slices.Equal(got, want) {
+		t.Errorf("Expected values %v, got %v", want, got)
+	}
+	if v, ok := m.Get("b"); !ok || v != 4 {
+		t.Errorf("Expected b to be 4, got %d, %v", v, ok)
+	}
+
+	if !m.Delete("b") || m.Delete("b") || m.Delete("z") {
+		t.Error("Expected Delete to report only present keys")
+	}
+	if _, ok := m.Get("b"); ok || m.Len() != 3 {
+		t.Error("Expected b to be deleted")
+	}
+
+	// The iterators work with the maps package too.
+	if got, want := maps.Collect(m.All()), map[string]int{"a": 2, "c": 3, "d": 0}; !maps.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestMapLarge(t *testing.T) {
+	// Enough keys to use several levels of the skip list.
+	var m Map[int, int]
+	keys := rand.Perm(10000)
+	for _, k := range keys {
+		m.Set(k, -k)
+	}
+	for _, k := range keys[:5000] {
+		m.Delete(k)
+	}
+	want := slices.Sorted(slices.Values(keys[5000:]))
+	if got := slices.Collect(m.Keys()); !slices.Equal(got, want) {
+		t.Errorf("Expected %d sorted keys, got %d", len(want), len(got))
+	}
+	for _, k := range want {
+		if v, ok := m.Get(k); !ok || v != -k {
+			t.Fatalf("Expected %d for key %d, got %d, %v", -k, k, v, ok)
+		}
+	}
+}
+
+func TestMapNaN(t *testing.T) {
+	// NaN isn't equal to itself, but the map orders it
+	// before every other float and finds it again.
+	var m Map[float64, string]
+	nan := math.NaN()
+	m.Set(1, "one")
+	m.Set(nan, "nan")
+	m.Set(nan, "NaN")
+	m.Set(math.Inf(-1), "-inf")
+
+	if v, ok := m.Get(nan); !ok || v != "NaN" || m.Len() != 3 {
+		t.Errorf("Expected one NaN key holding NaN, got %q, %v with %d keys", v, ok, m.Len())
+	}
+	if got, want := slices.Collect(m.Values()), []string{"NaN", "-inf", "one"}; !slices.Equal(got, want) {
+		t.Errorf("Expected values %v, got %v", want, got)
+	}
+	if !m.Delete(nan) || m.Len() != 2 {
+		t.Error("Expected NaN to be deleted")
+	}
+}
+
+func TestHeap(t *testing.T) {
+	h := NewHeap(cmp.Less[int])
+	if _, ok := h.Pop(); ok {
+		t.Error("Expected Pop on an empty heap to fail")
+	}
+	if _, ok := h.Peek(); ok {
+		t.Error("Expected Peek on an empty heap to fail")
+	}
+
+	for _, v := range []int{5, 3, 8, 1, 9, 1, 7} {
+		h.Push(v)
+	}
+	if v, _ := h.Peek(); v != 1 {
+		t.Errorf("Expected Peek to return 1, got %d", v)
+	}
+	if got, want := slices.Sorted(h.All()), []int{1, 1, 3, 5, 7, 8, 9}; !slices.Equal(got, want) {
+		t.Errorf("Expected All to hold %v, got %v", want, got)
+	}
+
+	// Stopping Drain early leaves the rest in the heap.
+	var got []int
+	for v := range h.Drain() {
+		got = append(got, v)
+		if len(got) == 3 {
+			break
+		}
+	}
+	if want := []int{1, 1, 3}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if got, want := slices.Collect(h.Drain()), []int{5, 7, 8, 9}; !slices.Equal(got, want) {
+		t.Errorf("Expected the rest to be %v, got %v", want, got)
+	}
+}
+
+func TestHeapPopClearsSlot(t *testing.T) {
+	h := NewHeap(func(a, b *int) bool { return *a < *b })
+	for i := range 3 {
+		h.Push(&i)
+	}
+	h.Pop()
+	// The popped slot is past the end of the slice but
+	// still in its backing array.
+	if p := h.data[:3][2]; p != nil {
+		t.Errorf("Expected Pop to clear the slot it freed, got %d", *p)
+	}
+}
+
+// The fuzz tests run random operations on a collection
+// and on a simple model of it, a slice or a built-in map,
+// and check that both agree. `go test` runs them on the
+// seed corpus only; `go test -fuzz FuzzMap` keeps
+// generating new inputs.
+
+func FuzzList(f *testing.F) {
+	f.Add([]byte{0, 1, 2, 3, 4, 5, 6, 7})
+	f.Add([]byte{1, 1, 3, 0, 2, 2, 2, 3})
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		var lst List[int]
+		var elems []*Element[int]
+		var model []int
+		for i, op := range ops {
+			pos := int(op>>2) % (len(elems) + 1)
+			switch op % 4 {
+			case 0:
+				elems = append(elems, lst.Push(i))
+				model = append(model, i)
+			case 1:
+				elems = slices.Insert(elems, 0, lst.PushFront(i))
+				model = slices.Insert(model, 0, i)
+			case 2:
+				if pos == len(elems) {
+					continue
+				}
+				elems = slices.Insert(elems, pos+1, lst.InsertAfter(i, elems[pos]))
+				model = slices.Insert(model, pos+1, i)
+			case 3:
+				if pos == len(elems) {
+					continue
+				}
+				if v := lst.Remove(elems[pos]); v != model[pos] {
+					t.Fatalf("Expected Remove to return %d, got %d", model[pos], v)
+				}
+				elems = slices.Delete(elems, pos, pos+1)
+				model = slices.Delete(model, pos, pos+1)
+			}
+		}
+		checkList(t, &lst, model)
+	})
+}
+
+func FuzzSet(f *testing.F) {
+	f.Add([]byte{1, 2, 3, 129, 2})
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		// The top bit says whether to add or remove.
+		var s Set[byte]
+		model := map[byte]bool{}
+		for _, op := range ops {
+			v := op & 0x7f
+			if op&0x80 == 0 {
+				if got := s.Add(v); got != !model[v] {
+					t.Fatalf("Add(%d): expected %v, got %v", v, !model[v], got)
+				}
+				model[v] = true
+			} else {
+				if got := s.Remove(v); got != model[v] {
+					t.Fatalf("Remove(%d): expected %v, got %v", v, model[v], got)
+				}
+				delete(model, v)
+			}
+		}
+		want := slices.Sorted(maps.Keys(model))
+		if got := slices.Sorted(s.All()); !slices.Equal(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+}
+
+func FuzzMap(f *testing.F) {
+	f.Add([]byte{10, 20, 30, 10, 148, 20})
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		var m Map[byte, int]
+		model := map[byte]int{}
+		for i, op := range ops {
+			k := op & 0x7f
+			if op&0x80 == 0 {
+				m.Set(k, i)
+				model[k] = i
+			} else {
+				_, ok := model[k]
+				if got := m.Delete(k); got != ok {
+					t.Fatalf("Delete(%d): expected %v, got %v", k, ok, got)
+				}
+				delete(model, k)
+			}
+		}
+		if m.Len() != len(model) {
+			t.Errorf("Expected Len %d, got %d", len(model), m.Len())
+		}
+		keys := slices.Collect(m.Keys())
+		if want := slices.Sorted(maps.Keys(model)); !slices.Equal(keys, want) {
+			t.Errorf("Expected keys %v, got %v", want, keys)
+		}
+		for k, v := range m.All() {
+			if model[k] != v {
+				t.Errorf("Expected %d for key %d, got %d", model[k], k, v)
+			}
+		}
+	})
+}
+
+func FuzzHeap(f *testing.F) {
+	f.Add([]byte{5, 3, 8, 0, 1, 9, 0, 0})
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		// A zero pops; anything else is pushed.
+		h := NewHeap(cmp.Less[byte])
+		var model []byte
+		for _, op := range ops {
+			if op != 0 {
+				h.Push(op)
+				model = append(model, op)
+				continue
+			}
+			v, ok := h.Pop()
+			if ok != (len(model) > 0) {
+				t.Fatalf("Expected Pop to report %v, got %v", len(model) > 0, ok)
+			}
+			if !ok {
+				continue
+			}
+			least := slices.Min(model)
+			if v != least {
+				t.Fatalf("Expected Pop to return %d, got %d", least, v)
+			}
+			model = slices.Delete(model, slices.Index(model, least), slices.Index(model, least)+1)
+		}
+		slices.Sort(model)
+		if got := slices.Collect(h.Drain()); !slices.Equal(got, model) {
+			t.Errorf("Expected to drain %v, got %v", model, got)
+		}
+	})
+}
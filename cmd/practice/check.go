@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/orsenthil/practicego/internal/grader"
+	"github.com/orsenthil/practicego/internal/progress"
+)
+
+func runCheck(args []string) int {
+	fs := flag.NewFlagSet("check", flag.ExitOnError)
+	root := rootFlag(fs)
+	state := stateFlag(fs)
+	update := fs.Bool("update", false, "overwrite expected_output.txt with the current output")
+	verbose := fs.Bool("v", false, "print the program output of failing modules")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: practice check [flags] [module ...]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	all, err := loadModules(*root)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	modules, err := selectModules(all, fs.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+
+	ctx := context.Background()
+
+	if *update {
+		code := 0
+		for _, m := range modules {
+			if m.Metadata.Check.Skip != "" {
+				continue
+			}
+			if err := grader.UpdateGolden(ctx, m); err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", m.Name, err)
+				code = 1
+				continue
+			}
+			fmt.Printf("updated %s\n", m.GoldenFile())
+		}
+		return code
+	}
+
+	// Results are recorded in the progress file. A file that can't be
+	// read only costs the record, not the check.
+	path, err := statePath(*root, *state)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	prog, err := progress.Load(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice: progress not recorded:", err)
+	}
+
+	counts := make(map[grader.Status]int)
+	for _, m := range modules {
+		res := grader.Check(ctx, m)
+		counts[res.Status]++
+		if prog != nil {
+			record(prog, res)
+		}
+
+		line := fmt.Sprintf("%s  %s", res.Status, m.Name)
+		if res.Reason != "" {
+			line += " (" + firstLine(res.Reason) + ")"
+		}
+		fmt.Println(line)
+
+		if res.Status != grader.Fail {
+			continue
+		}
+		if rest := restLines(res.Reason); rest != "" {
+			fmt.Print(indent(rest))
+		}
+		if res.Diff != "" {
+			fmt.Print(indent("--- expected\n+++ actual\n" + res.Diff))
+		}
+		if *verbose && res.Output.Stdout != "" {
+			fmt.Print(indent("output:\n" + res.Output.Stdout))
+		}
+	}
+
+	fmt.Printf("\n%d passed, %d failed, %d skipped\n",
+		counts[grader.Pass], counts[grader.Fail], counts[grader.Skip])
+	if prog != nil {
+		if err := prog.Save(path); err != nil {
+			fmt.Fprintln(os.Stderr, "practice: progress not recorded:", err)
+		}
+	}
+	if counts[grader.Fail] > 0 {
+		return 1
+	}
+	return 0
+}
+
+func firstLine(s string) string {
+	first, _, _ := strings.Cut(s, "\n")
+	return first
+}
+
+func restLines(s string) string {
+	_, rest, _ := strings.Cut(s, "\n")
+	return rest
+}
+
+func indent(s string) string {
+	s = strings.TrimSuffix(s, "\n")
+	return "    " + strings.ReplaceAll(s, "\n", "\n    ") + "\n"
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/orsenthil/practicego/internal/curriculum"
+	"github.com/orsenthil/practicego/internal/hints"
+	"github.com/orsenthil/practicego/internal/progress"
+)
+
+func runHint(args []string) int {
+	fs := flag.NewFlagSet("hint", flag.ExitOnError)
+	root := rootFlag(fs)
+	state := stateFlag(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: practice hint [flags] module [todo]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() < 1 || fs.NArg() > 2 {
+		fs.Usage()
+		return 2
+	}
+
+	all, err := loadModules(*root)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	m, err := curriculum.Find(all, fs.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	list, err := hints.Load(m.HintsFile())
+	if errors.Is(err, os.ErrNotExist) {
+		fmt.Fprintf(os.Stderr, "practice: %s has no hints yet\n", m.Name)
+		return 1
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	path, err := statePath(*root, *state)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	prog, err := progress.Load(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+
+	if fs.NArg() == 1 {
+		if err := printHints(prog.Get(m.Name), m, list); err != nil {
+			fmt.Fprintln(os.Stderr, "practice:", err)
+			return 1
+		}
+		return 0
+	}
+
+	h, err := hints.Find(list, fs.Arg(1))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "practice: %s: %v; run 'practice hint %s' to list them\n", m.Name, err, m.Metadata.Key)
+		return 1
+	}
+	shown := prog.Get(m.Name).Hints[h.ID]
+	level := min(shown+1, hints.Levels)
+	for n := 1; n <= level; n++ {
+		name, text := h.Level(n)
+		fmt.Printf("%s, hint %d/%d (%s):\n", h.ID, n, hints.Levels, name)
+		for _, line := range strings.Split(text, "\n") {
+			fmt.Printf("  %s\n", line)
+		}
+	}
+	if shown >= hints.Levels {
+		fmt.Printf("\nAll %d hints for %s are shown; compare with .practice/solution.go.\n", hints.Levels, h.ID)
+	}
+
+	prog.Hint(m.Name, h.ID, level)
+	if err := prog.Save(path); err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	return 0
+}
+
+// printHints lists the TODO ids of the module with the number of hint
+// levels already revealed for each.
+func printHints(e progress.Entry, m curriculum.Module, list []hints.Hint) error {
+	fmt.Printf("Hints for %s:\n", m.Name)
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, h := range list {
+		fmt.Fprintf(tw, "  %s\t%d/%d shown\n", h.ID, e.Hints[h.ID], hints.Levels)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("\nRun 'practice hint %s <todo>' to see the next hint.\n", m.Metadata.Key)
+	return nil
+}
@@ -0,0 +1,126 @@
+// Command practice helps work through the practice modules: it grades a
+// module's generated workspace file against its golden output and keeps
+// a record of the learner's progress.
+//
+// Usage:
+//
+//	practice check [-root dir] [-state file] [-update] [module ...]
+//	practice status [-root dir] [-state file] [-test] [module ...]
+//	practice review [-root dir] [-state file] module ...
+//	practice hint [-root dir] [-state file] module [todo]
+//	practice watch [-root dir] [-state file] [-interval d] module
+//	practice vendor [-root dir] [-workspace=false] [module ...]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/orsenthil/practicego/internal/curriculum"
+	"github.com/orsenthil/practicego/internal/progress"
+)
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage: practice <command> [arguments]
+
+Commands:
+  check   run modules and compare their output with expected_output.txt
+  status  show progress through the modules, grouped by topic
+  review  mark passing modules as reviewed
+  hint    show the next hint for a TODO of a module
+  watch   re-check a module every time its files are saved
+  vendor  copy module dependencies into vendor/ for offline builds
+
+Run 'practice <command> -h' for the flags of a command.`)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var code int
+	switch os.Args[1] {
+	case "check":
+		code = runCheck(os.Args[2:])
+	case "status":
+		code = runStatus(os.Args[2:])
+	case "review":
+		code = runReview(os.Args[2:])
+	case "hint":
+		code = runHint(os.Args[2:])
+	case "watch":
+		code = runWatch(os.Args[2:])
+	case "vendor":
+		code = runVendor(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		usage()
+	default:
+		fmt.Fprintf(os.Stderr, "practice: unknown command %q\n", os.Args[1])
+		usage()
+		code = 2
+	}
+	os.Exit(code)
+}
+
+// rootFlag registers the -root flag shared by every subcommand.
+func rootFlag(fs *flag.FlagSet) *string {
+	return fs.String("root", "", "repository root (default: found from the current directory)")
+}
+
+// stateFlag registers the -state flag shared by the subcommands that
+// read or record progress.
+func stateFlag(fs *flag.FlagSet) *string {
+	return fs.String("state", "", "progress file (default: "+progress.FileName+" in the repository root)")
+}
+
+// resolveRoot returns root, or the repository containing the current
+// directory when root is empty.
+func resolveRoot(root string) (string, error) {
+	if root != "" {
+		return root, nil
+	}
+	return curriculum.FindRoot(".")
+}
+
+// loadModules discovers the modules under root, or under the repository
+// containing the current directory when root is empty.
+func loadModules(root string) ([]curriculum.Module, error) {
+	root, err := resolveRoot(root)
+	if err != nil {
+		return nil, err
+	}
+	return curriculum.Discover(root)
+}
+
+// statePath returns the progress file to use: state when set,
+// otherwise the default file in the repository root.
+func statePath(root, state string) (string, error) {
+	if state != "" {
+		return state, nil
+	}
+	root, err := resolveRoot(root)
+	if err != nil {
+		return "", err
+	}
+	return progress.Path(root), nil
+}
+
+// selectModules returns the modules named in args, or all of them when
+// args is empty.
+func selectModules(all []curriculum.Module, args []string) ([]curriculum.Module, error) {
+	if len(args) == 0 {
+		return all, nil
+	}
+	var selected []curriculum.Module
+	for _, name := range args {
+		m, err := curriculum.Find(all, name)
+		if err != nil {
+			return nil, err
+		}
+		selected = append(selected, m)
+	}
+	return selected, nil
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/orsenthil/practicego/internal/curriculum"
+	"github.com/orsenthil/practicego/internal/grader"
+	"github.com/orsenthil/practicego/internal/progress"
+)
+
+func runStatus(args []string) int {
+	fs := flag.NewFlagSet("status", flag.ExitOnError)
+	root := rootFlag(fs)
+	state := stateFlag(fs)
+	test := fs.Bool("test", false, "run the checks of generated modules first and record the results")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: practice status [flags] [module ...]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	all, err := loadModules(*root)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	modules, err := selectModules(all, fs.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	path, err := statePath(*root, *state)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	prog, err := progress.Load(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+
+	if *test {
+		ctx := context.Background()
+		for _, m := range modules {
+			if !m.Generated() {
+				continue
+			}
+			record(prog, grader.Check(ctx, m))
+		}
+		if err := prog.Save(path); err != nil {
+			fmt.Fprintln(os.Stderr, "practice:", err)
+			return 1
+		}
+	}
+
+	if err := printStatus(prog, modules); err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	return 0
+}
+
+func runReview(args []string) int {
+	fs := flag.NewFlagSet("review", flag.ExitOnError)
+	root := rootFlag(fs)
+	state := stateFlag(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: practice review [flags] module ...")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	all, err := loadModules(*root)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	modules, err := selectModules(all, fs.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	path, err := statePath(*root, *state)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	prog, err := progress.Load(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+
+	code := 0
+	for _, m := range modules {
+		if err := prog.Review(m.Name, time.Now()); err != nil {
+			fmt.Fprintln(os.Stderr, "practice:", err)
+			code = 1
+			continue
+		}
+		fmt.Printf("reviewed %s\n", m.Name)
+	}
+	if err := prog.Save(path); err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	return code
+}
+
+// record stores a check result in the progress file. Skipped modules
+// say nothing about the learner's work, and neither does a failure of
+// a workspace that is still the untouched template.
+func record(prog *progress.Progress, res grader.Result) {
+	switch res.Status {
+	case grader.Pass:
+		prog.Record(res.Module.Name, true, time.Now())
+	case grader.Fail:
+		if s, err := progress.Observe(res.Module); err == nil && s == progress.InProgress {
+			prog.Record(res.Module.Name, false, time.Now())
+		}
+	}
+}
+
+// printStatus prints one table per topic with the state of each
+// module, followed by the overall totals.
+func printStatus(prog *progress.Progress, modules []curriculum.Module) error {
+	type group struct {
+		name    string
+		first   int
+		last    int
+		modules []curriculum.Module
+	}
+	var groups []*group
+	byName := make(map[string]*group)
+	for _, m := range modules {
+		name := m.Topic()
+		g, ok := byName[name]
+		if !ok {
+			g = &group{name: name, first: m.Number}
+			byName[name] = g
+			groups = append(groups, g)
+		}
+		g.last = m.Number
+		g.modules = append(g.modules, m)
+	}
+
+	fmt.Printf("Progress for %s\n", prog.Learner)
+
+	var passing, reviewed int
+	for _, g := range groups {
+		entries := make([]progress.Entry, len(g.modules))
+		groupPassing := 0
+		for i, m := range g.modules {
+			e, err := prog.Current(m)
+			if err != nil {
+				return err
+			}
+			entries[i] = e
+			if e.State.AtLeast(progress.Passing) {
+				groupPassing++
+			}
+			if e.State == progress.Reviewed {
+				reviewed++
+			}
+		}
+		passing += groupPassing
+
+		fmt.Printf("\n%s (%02d-%02d): %d/%d passing\n", g.name, g.first, g.last, groupPassing, len(g.modules))
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for i, m := range g.modules {
+			updated := ""
+			if !entries[i].Updated.IsZero() {
+				updated = entries[i].Updated.Local().Format("2006-01-02 15:04")
+			}
+			hinted := ""
+			switch n := entries[i].HintsUsed(); {
+			case n == 1:
+				hinted = "1 hint"
+			case n > 1:
+				hinted = fmt.Sprintf("%d hints", n)
+			}
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\n", m.Name, entries[i].State, updated, hinted)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("\n%d/%d passing, %d reviewed\n", passing, len(modules), reviewed)
+	return nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/orsenthil/practicego/internal/grader"
+)
+
+func runVendor(args []string) int {
+	fs := flag.NewFlagSet("vendor", flag.ExitOnError)
+	root := rootFlag(fs)
+	workspace := fs.Bool("workspace", true, "also vendor the go.work workspace, for `go run` inside it")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: practice vendor [flags] [module ...]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	dir, err := resolveRoot(*root)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	all, err := loadModules(dir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	modules, err := selectModules(all, fs.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+
+	ctx := context.Background()
+	code := 0
+	for _, m := range modules {
+		// Modules using only the standard library have nothing to
+		// vendor.
+		if !m.HasDependencies() {
+			continue
+		}
+		if err := grader.Vendor(ctx, m); err != nil {
+			fmt.Fprintln(os.Stderr, "practice:", err)
+			code = 1
+			continue
+		}
+		fmt.Printf("vendored %s\n", m.Name)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "go.work")); *workspace && err == nil {
+		if err := grader.VendorWorkspace(ctx, dir, all); err != nil {
+			fmt.Fprintln(os.Stderr, "practice:", err)
+			return 1
+		}
+		fmt.Println("vendored go.work")
+	}
+	return code
+}
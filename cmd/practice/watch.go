@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/orsenthil/practicego/internal/curriculum"
+	"github.com/orsenthil/practicego/internal/grader"
+	"github.com/orsenthil/practicego/internal/progress"
+	"github.com/orsenthil/practicego/internal/watch"
+)
+
+func runWatch(args []string) int {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+	root := rootFlag(fs)
+	state := stateFlag(fs)
+	interval := fs.Duration("interval", watch.DefaultInterval, "how often to look for changes")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: practice watch [flags] module")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+
+	all, err := loadModules(*root)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	m, err := curriculum.Find(all, fs.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+	if !m.Generated() {
+		fmt.Fprintf(os.Stderr, "practice: %s is not generated; run python3 setup_go_practice.py\n", m.Name)
+		return 1
+	}
+	path, err := statePath(*root, *state)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	changes, err := watch.Changes(ctx, m.Dir, *interval)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice:", err)
+		return 1
+	}
+
+	fmt.Printf("Watching %s; press Ctrl-C to stop.\n", m.Name)
+	for {
+		res := grader.Check(ctx, m)
+		if ctx.Err() != nil {
+			// Interrupted while checking: the result means nothing.
+			return 0
+		}
+		printSummary(res)
+		saveResult(path, res)
+
+		if _, ok := <-changes; !ok {
+			return 0
+		}
+	}
+}
+
+// printSummary prints a one or two line, colored summary of a check.
+func printSummary(res grader.Result) {
+	status := res.Status.String()
+	if os.Getenv("NO_COLOR") == "" && isTerminal(os.Stdout) {
+		switch res.Status {
+		case grader.Pass:
+			status = "\033[32m" + status + "\033[0m"
+		case grader.Fail:
+			status = "\033[31m" + status + "\033[0m"
+		}
+	}
+	line := fmt.Sprintf("%s  %s  %s", time.Now().Format("15:04:05"), status, res.Module.Name)
+	if res.Status == grader.Skip {
+		line += " (" + res.Reason + ")"
+	}
+	fmt.Println(line)
+	if first := res.FirstFailure(); first != "" {
+		fmt.Print(indent(first))
+	}
+}
+
+// saveResult records the check in the progress file. Like check, it
+// only warns when the file can't be read or written.
+func saveResult(path string, res grader.Result) {
+	prog, err := progress.Load(path)
+	if err == nil {
+		record(prog, res)
+		err = prog.Save(path)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "practice: progress not recorded:", err)
+	}
+}
+
+// isTerminal reports whether f is a terminal rather than a file or a
+// pipe.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
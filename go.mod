@@ -0,0 +1,5 @@
+module github.com/orsenthil/practicego
+
+go 1.25
+
+require gopkg.in/yaml.v3 v3.0.1
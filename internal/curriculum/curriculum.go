@@ -0,0 +1,310 @@
+// Package curriculum discovers the numbered practice modules in the
+// repository and loads the metadata stored in their .practice
+// directories.
+package curriculum
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PracticeDirName is the directory, inside every module, that holds the
+// template sources. It is preserved by `setup_go_practice.py --clean`.
+const PracticeDirName = ".practice"
+
+// Module is a single numbered lesson such as 09Slices.
+type Module struct {
+	Name     string // directory name, e.g. "09Slices"
+	Dir      string // absolute path to the module directory
+	Number   int    // numeric prefix, e.g. 9
+	Metadata Metadata
+}
+
+// Metadata mirrors .practice/metadata.json.
+type Metadata struct {
+	Key         string `json:"key"`
+	DisplayName string `json:"display_name"`
+	Check       Check  `json:"check"`
+}
+
+// Check holds the optional settings used by `practice check` to run a
+// module and compare its output against the golden file.
+type Check struct {
+	// Skip, when non-empty, is the reason the module can't be graded
+	// by comparing output (servers, programs waiting for signals...).
+	Skip string `json:"skip,omitempty"`
+
+	Args     []string          `json:"args,omitempty"`
+	Stdin    string            `json:"stdin,omitempty"`
+	Env      map[string]string `json:"env,omitempty"`
+	Files    map[string]string `json:"files,omitempty"`
+	ExitCode int               `json:"exit_code,omitempty"`
+	Timeout  string            `json:"timeout,omitempty"`
+
+	// Normalize lists the named rules applied to both the expected
+	// and the actual output before comparing them.
+	Normalize []string `json:"normalize,omitempty"`
+
+	// Replace holds module specific regexp rewrites, applied before
+	// the named rules.
+	Replace []Replacement `json:"replace,omitempty"`
+
+	// TestTags lists extra build tag sets, each a comma-separated
+	// list, to run the companion tests with after the default build.
+	TestTags []string `json:"test_tags,omitempty"`
+
+	// Unordered compares the output as a multiset of lines, for
+	// programs whose goroutines print in a nondeterministic order.
+	Unordered bool `json:"unordered,omitempty"`
+}
+
+// Replacement rewrites every match of Pattern with With.
+type Replacement struct {
+	Pattern string `json:"pattern"`
+	With    string `json:"with"`
+}
+
+// Topic is a named range of module numbers, used to group modules in
+// reports. A Last of 0 leaves the range open, so that it also holds
+// the modules added after it.
+type Topic struct {
+	Name        string
+	First, Last int
+}
+
+// Topics lists the topic ranges in curriculum order.
+var Topics = []Topic{
+	{"Basics", 1, 27},
+	{"Concurrency", 28, 45},
+	{"Standard library", 46, 84},
+	{"Fx", 85, 88},
+	{"GORM", 89, 93},
+	{"Configuration", 94, 94},
+	{"Patterns", 95, 0},
+}
+
+// OtherTopic groups the modules that fall outside every range in
+// Topics.
+const OtherTopic = "Other"
+
+// Topic returns the name of the topic range the module belongs to.
+func (m Module) Topic() string {
+	for _, t := range Topics {
+		if m.Number >= t.First && (t.Last == 0 || m.Number <= t.Last) {
+			return t.Name
+		}
+	}
+	return OtherTopic
+}
+
+// TemplateFile returns the path to the module's template.go.
+func (m Module) TemplateFile() string {
+	return filepath.Join(m.PracticeDir(), "template.go")
+}
+
+// PracticeDir returns the path to the module's .practice directory.
+func (m Module) PracticeDir() string {
+	return filepath.Join(m.Dir, PracticeDirName)
+}
+
+// PackageName returns the name used for the generated workspace file.
+func (m Module) PackageName() string {
+	return PackageName(m.Metadata.Key)
+}
+
+// WorkspaceFile returns the path of the generated practice file, e.g.
+// 09Slices/slices.go.
+func (m Module) WorkspaceFile() string {
+	return filepath.Join(m.Dir, m.PackageName()+".go")
+}
+
+// TestFile returns the path of the generated companion tests, e.g.
+// 09Slices/slices_test.go. They are copied from .practice/solution_test.go.
+func (m Module) TestFile() string {
+	return filepath.Join(m.Dir, m.PackageName()+"_test.go")
+}
+
+// HasTests reports whether the generated module has companion tests.
+func (m Module) HasTests() bool {
+	_, err := os.Stat(m.TestFile())
+	return err == nil
+}
+
+// GoldenFile returns the path of the expected output for the module.
+func (m Module) GoldenFile() string {
+	return filepath.Join(m.PracticeDir(), "expected_output.txt")
+}
+
+// HintsFile returns the path of the module's progressive hints, kept
+// next to its template.
+func (m Module) HintsFile() string {
+	return filepath.Join(m.PracticeDir(), "hints.yaml")
+}
+
+// Generated reports whether setup_go_practice.py has created the
+// workspace file and go.mod for the module.
+func (m Module) Generated() bool {
+	for _, p := range []string{m.WorkspaceFile(), filepath.Join(m.Dir, "go.mod")} {
+		if _, err := os.Stat(p); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// RootModulePath is the module path of the repository. Modules that
+// import practicekit require it, replaced by the local directory.
+const RootModulePath = "github.com/orsenthil/practicego"
+
+// HasDependencies reports whether the module requires third-party
+// modules, which it declares in .practice/go.mod. The root module
+// doesn't count: it is replaced by a local directory, so there is
+// nothing to download or vendor for it.
+func (m Module) HasDependencies() bool {
+	data, err := os.ReadFile(filepath.Join(m.PracticeDir(), "go.mod"))
+	if err != nil {
+		return false
+	}
+	inBlock := false
+	for line := range strings.Lines(string(data)) {
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 0 || strings.HasPrefix(fields[0], "//"):
+			continue
+		case inBlock && fields[0] == ")":
+			inBlock = false
+			continue
+		case fields[0] == "require" && len(fields) > 1 && fields[1] == "(":
+			inBlock = true
+			continue
+		case fields[0] == "require" && len(fields) > 1:
+			fields = fields[1:]
+		case !inBlock:
+			continue
+		}
+		if fields[0] != RootModulePath {
+			return true
+		}
+	}
+	return false
+}
+
+// VendorDir returns the path of the module's vendor directory, created
+// by `practice vendor`.
+func (m Module) VendorDir() string {
+	return filepath.Join(m.Dir, "vendor")
+}
+
+// Vendored reports whether the module's dependencies have been copied
+// into its vendor directory, so it builds without the network.
+func (m Module) Vendored() bool {
+	_, err := os.Stat(filepath.Join(m.VendorDir(), "modules.txt"))
+	return err == nil
+}
+
+var (
+	nonAlnum   = regexp.MustCompile(`[^a-z0-9]+`)
+	modulePref = regexp.MustCompile(`^(\d+)`)
+)
+
+// PackageName converts a topic key to a valid Go package name. It
+// matches topic_to_package_name in practice_utils.py so that both tools
+// agree on the name of the generated file.
+func PackageName(key string) string {
+	name := nonAlnum.ReplaceAllString(strings.ToLower(key), "_")
+	name = strings.Trim(name, "_")
+	if name != "" && name[0] >= '0' && name[0] <= '9' {
+		name = "go_" + name
+	}
+	return name
+}
+
+// FindRoot walks up from dir until it finds the repository root, which
+// is identified by setup_go_practice.py.
+func FindRoot(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "setup_go_practice.py")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("could not find setup_go_practice.py above the current directory")
+		}
+		dir = parent
+	}
+}
+
+// Discover returns every module under root that has a
+// .practice/metadata.json, sorted by number.
+func Discover(root string) ([]Module, error) {
+	paths, err := filepath.Glob(filepath.Join(root, "*", PracticeDirName, "metadata.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var modules []Module
+	for _, p := range paths {
+		m, err := Load(filepath.Dir(filepath.Dir(p)))
+		if err != nil {
+			return nil, err
+		}
+		modules = append(modules, m)
+	}
+
+	// Sort by number rather than name, so that 100 comes after 99.
+	sort.Slice(modules, func(i, j int) bool {
+		if modules[i].Number != modules[j].Number {
+			return modules[i].Number < modules[j].Number
+		}
+		return modules[i].Name < modules[j].Name
+	})
+	return modules, nil
+}
+
+// Load reads a single module directory.
+func Load(dir string) (Module, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return Module{}, err
+	}
+
+	m := Module{Name: filepath.Base(dir), Dir: dir}
+	if match := modulePref.FindString(m.Name); match != "" {
+		m.Number, _ = strconv.Atoi(match)
+	}
+
+	data, err := os.ReadFile(filepath.Join(m.PracticeDir(), "metadata.json"))
+	if err != nil {
+		return Module{}, err
+	}
+	if err := json.Unmarshal(data, &m.Metadata); err != nil {
+		return Module{}, fmt.Errorf("%s: %w", m.Name, err)
+	}
+	return m, nil
+}
+
+// Find looks up a module by directory name ("09Slices"), topic key
+// ("slices") or number ("9" or "09").
+func Find(modules []Module, name string) (Module, error) {
+	name = strings.TrimSuffix(strings.TrimSpace(name), "/")
+	n, numErr := strconv.Atoi(name)
+	for _, m := range modules {
+		switch {
+		case strings.EqualFold(m.Name, name),
+			strings.EqualFold(m.Metadata.Key, name),
+			numErr == nil && m.Number == n:
+			return m, nil
+		}
+	}
+	return Module{}, fmt.Errorf("unknown module %q", name)
+}
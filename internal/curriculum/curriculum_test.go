@@ -0,0 +1,149 @@
+package curriculum
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeModule(t *testing.T, root, name, metadata string) {
+	t.Helper()
+	dir := filepath.Join(root, name, PracticeDirName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "metadata.json"), []byte(metadata), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPackageName(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"hello-world", "hello_world"},
+		{"range-over-built-in-types", "range_over_built_in_types"},
+		{"sha256-hashes", "sha256_hashes"},
+		{"Non--Blocking  Channel", "non_blocking_channel"},
+		{"2d-slices", "go_2d_slices"},
+	}
+
+	for _, tt := range tests {
+		if got := PackageName(tt.key); got != tt.want {
+			t.Errorf("PackageName(%q) = %q, want %q", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestDiscoverAndFind(t *testing.T) {
+	root := t.TempDir()
+	writeModule(t, root, "10Maps", `{"key": "maps", "display_name": "Maps"}`)
+	writeModule(t, root, "09Slices", `{"key": "slices", "display_name": "Slices", "check": {"args": ["a"]}}`)
+	writeModule(t, root, "100Iterators", `{"key": "iterators", "display_name": "Iterators"}`)
+	// Directories without metadata are not modules.
+	os.Mkdir(filepath.Join(root, "cmd"), 0755)
+
+	modules, err := Discover(root)
+	if err != nil {
+		t.Fatalf("Discover failed: %v", err)
+	}
+	if len(modules) != 3 {
+		t.Fatalf("Expected 3 modules, got %d", len(modules))
+	}
+	if modules[0].Name != "09Slices" || modules[0].Number != 9 {
+		t.Errorf("Expected 09Slices first, got %s (%d)", modules[0].Name, modules[0].Number)
+	}
+	if got := modules[0].Metadata.Check.Args; len(got) != 1 || got[0] != "a" {
+		t.Errorf("Expected check args [a], got %v", got)
+	}
+	if modules[2].Name != "100Iterators" || modules[2].Number != 100 {
+		t.Errorf("Expected 100Iterators last, got %s (%d)", modules[2].Name, modules[2].Number)
+	}
+	if got := filepath.Base(modules[1].WorkspaceFile()); got != "maps.go" {
+		t.Errorf("Expected workspace file maps.go, got %s", got)
+	}
+
+	for _, name := range []string{"09Slices", "09slices", "slices", "9", "09", "09Slices/"} {
+		m, err := Find(modules, name)
+		if err != nil {
+			t.Errorf("Find(%q) failed: %v", name, err)
+			continue
+		}
+		if m.Name != "09Slices" {
+			t.Errorf("Find(%q) = %s, want 09Slices", name, m.Name)
+		}
+	}
+	if _, err := Find(modules, "11"); err == nil {
+		t.Error("Expected error for unknown module")
+	}
+}
+
+func TestFindRoot(t *testing.T) {
+	root := t.TempDir()
+	os.WriteFile(filepath.Join(root, "setup_go_practice.py"), nil, 0644)
+	nested := filepath.Join(root, "09Slices", PracticeDirName)
+	os.MkdirAll(nested, 0755)
+
+	got, err := FindRoot(nested)
+	if err != nil {
+		t.Fatalf("FindRoot failed: %v", err)
+	}
+	if got != root {
+		t.Errorf("Expected root %s, got %s", root, got)
+	}
+}
+
+func TestHasDependencies(t *testing.T) {
+	tests := []struct {
+		name  string
+		goMod string // "" for no go.mod
+		want  bool
+	}{
+		{"no go.mod", "", false},
+		{"root module only", "module m\n\ngo 1.25\n\nrequire github.com/orsenthil/practicego v0.0.0\n\nreplace github.com/orsenthil/practicego => ../\n", false},
+		{"single require", "module m\n\nrequire gopkg.in/yaml.v3 v3.0.1\n", true},
+		{"require block", "module m\n\nrequire (\n\t// the root module\n\tgithub.com/orsenthil/practicego v0.0.0\n\tgorm.io/gorm v1.25.12\n)\n", true},
+	}
+	for _, tt := range tests {
+		dir := t.TempDir()
+		m := Module{Dir: dir}
+		if tt.goMod != "" {
+			os.MkdirAll(m.PracticeDir(), 0755)
+			os.WriteFile(filepath.Join(m.PracticeDir(), "go.mod"), []byte(tt.goMod), 0644)
+		}
+		if got := m.HasDependencies(); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestTopic(t *testing.T) {
+	tests := []struct {
+		number int
+		want   string
+	}{
+		{1, "Basics"},
+		{27, "Basics"},
+		{28, "Concurrency"},
+		{45, "Concurrency"},
+		{46, "Standard library"},
+		{84, "Standard library"},
+		{85, "Fx"},
+		{89, "GORM"},
+		{93, "GORM"},
+		{94, "Configuration"},
+		{95, "Patterns"},
+		{105, "Patterns"},
+		// The last range is open, for modules added later.
+		{200, "Patterns"},
+		{0, OtherTopic},
+	}
+
+	for _, tt := range tests {
+		m := Module{Number: tt.number}
+		if got := m.Topic(); got != tt.want {
+			t.Errorf("Topic(%d) = %q, want %q", tt.number, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,339 @@
+// Package grader builds a module's generated workspace file, runs it
+// and compares what it prints against the golden expected_output.txt
+// stored next to the module's metadata.json.
+package grader
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/orsenthil/practicego/internal/curriculum"
+)
+
+// DefaultTimeout bounds a single run when the module doesn't set one.
+const DefaultTimeout = 30 * time.Second
+
+// Status is the outcome of checking a module.
+type Status int
+
+const (
+	Pass Status = iota
+	Fail
+	Skip
+)
+
+func (s Status) String() string {
+	switch s {
+	case Pass:
+		return "PASS"
+	case Fail:
+		return "FAIL"
+	case Skip:
+		return "SKIP"
+	}
+	return fmt.Sprintf("Status(%d)", int(s))
+}
+
+// Result describes the outcome of checking one module.
+type Result struct {
+	Module curriculum.Module
+	Status Status
+	Reason string // why the module failed or was skipped
+	Diff   string // expected vs. actual, after normalization
+	Output Output
+}
+
+// Output is what a run of the program produced.
+type Output struct {
+	Stdout   string
+	ExitCode int
+}
+
+// sourceLine matches the file:line messages of failing tests and
+// compiler errors, e.g. "    slices_test.go:12: Expected 9, got 0".
+var sourceLine = regexp.MustCompile(`^\s*(\S+\.go:\d+(:\d+)?: .*)$`)
+
+// FirstFailure returns a one line summary of why the module failed:
+// the first failing assertion or compile error of the tests, or the
+// first differing line of the output. It returns "" for results that
+// didn't fail.
+func (r Result) FirstFailure() string {
+	if r.Status != Fail {
+		return ""
+	}
+	for _, line := range strings.Split(r.Diff, "\n") {
+		if strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "+ ") {
+			return r.Reason + ": " + line
+		}
+	}
+	lines := strings.Split(r.Reason, "\n")
+	for _, line := range lines {
+		if m := sourceLine.FindStringSubmatch(line); m != nil {
+			return m[1]
+		}
+	}
+	for _, line := range lines {
+		if strings.HasPrefix(line, "panic: ") {
+			return line
+		}
+	}
+	// Otherwise the first line of the go command's output, past the
+	// package headers, says the most, e.g. a link error.
+	for _, line := range lines[1:] {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") && !strings.HasPrefix(line, "--- FAIL") && !strings.HasPrefix(line, "FAIL") {
+			return line
+		}
+	}
+	return lines[0]
+}
+
+// Check grades the module. It compares the program output with the
+// golden file and runs the companion tests, when the module has them.
+// A module with neither is skipped.
+func Check(ctx context.Context, m curriculum.Module) Result {
+	res := Result{Module: m}
+	c := m.Metadata.Check
+
+	skip := c.Skip
+	golden, err := os.ReadFile(m.GoldenFile())
+	switch {
+	case skip != "":
+	case errors.Is(err, os.ErrNotExist):
+		skip = "no expected_output.txt"
+	case err != nil:
+		res.Status, res.Reason = Fail, err.Error()
+		return res
+	}
+	if skip != "" && !m.HasTests() {
+		res.Status, res.Reason = Skip, skip
+		return res
+	}
+
+	if skip == "" {
+		if res = compareOutput(ctx, m, string(golden)); res.Status == Fail {
+			return res
+		}
+	}
+	if m.HasTests() {
+		if err := Test(ctx, m); err != nil {
+			res.Status, res.Reason = Fail, err.Error()
+			return res
+		}
+	}
+	res.Status = Pass
+	return res
+}
+
+// compareOutput runs the module and diffs its output against golden.
+func compareOutput(ctx context.Context, m curriculum.Module, golden string) Result {
+	res := Result{Module: m}
+	c := m.Metadata.Check
+
+	out, err := Run(ctx, m)
+	res.Output = out
+	if err != nil {
+		res.Status, res.Reason = Fail, err.Error()
+		return res
+	}
+	if out.ExitCode != c.ExitCode {
+		res.Status = Fail
+		res.Reason = fmt.Sprintf("exit status %d, want %d", out.ExitCode, c.ExitCode)
+		return res
+	}
+
+	want, err := Normalize(golden, c)
+	if err != nil {
+		res.Status, res.Reason = Fail, err.Error()
+		return res
+	}
+	got, err := Normalize(out.Stdout, c)
+	if err != nil {
+		res.Status, res.Reason = Fail, err.Error()
+		return res
+	}
+	if res.Diff = Diff(want, got); res.Diff != "" {
+		res.Status, res.Reason = Fail, "output differs from expected_output.txt"
+		return res
+	}
+	res.Status = Pass
+	return res
+}
+
+// Test runs the module's companion tests with `go test`, once with
+// the default build and once for each of the module's TestTags. The
+// returned error carries the test output.
+func Test(ctx context.Context, m curriculum.Module) error {
+	timeout, err := runTimeout(m.Metadata.Check)
+	if err != nil {
+		return err
+	}
+	if err := test(ctx, m, timeout, ""); err != nil {
+		return err
+	}
+	for _, tags := range m.Metadata.Check.TestTags {
+		if err := test(ctx, m, timeout, tags); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// test runs `go test` with the build tags, if any, within timeout.
+func test(ctx context.Context, m curriculum.Module, timeout time.Duration, tags string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	args := []string{"-count=1"}
+	suffix := ""
+	if tags != "" {
+		args = append(args, "-tags", tags)
+		suffix = " with -tags " + tags
+	}
+	cmd := goCommand(ctx, m, "test", append(args, ".")...)
+	out, err := cmd.CombinedOutput()
+	if ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("tests timed out after %s%s", timeout, suffix)
+	}
+	if err != nil {
+		return fmt.Errorf("tests failed%s\n%s", suffix, out)
+	}
+	return nil
+}
+
+// UpdateGolden runs the module and stores its raw output as the new
+// expected_output.txt. It is meant for maintainers running a known good
+// solution.
+func UpdateGolden(ctx context.Context, m curriculum.Module) error {
+	out, err := Run(ctx, m)
+	if err != nil {
+		return err
+	}
+	if out.ExitCode != m.Metadata.Check.ExitCode {
+		return fmt.Errorf("%s: exit status %d, want %d", m.Name, out.ExitCode, m.Metadata.Check.ExitCode)
+	}
+	return os.WriteFile(m.GoldenFile(), []byte(out.Stdout), 0644)
+}
+
+// Run builds the module's workspace into a temporary directory and
+// executes it there with the arguments, stdin, environment and files
+// configured in its metadata.
+func Run(ctx context.Context, m curriculum.Module) (Output, error) {
+	if !m.Generated() {
+		return Output{}, fmt.Errorf("%s is not generated; run python3 setup_go_practice.py", m.Name)
+	}
+	c := m.Metadata.Check
+
+	timeout, err := runTimeout(c)
+	if err != nil {
+		return Output{}, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	tmp, err := os.MkdirTemp("", "practice-"+m.PackageName())
+	if err != nil {
+		return Output{}, err
+	}
+	defer os.RemoveAll(tmp)
+
+	bin := filepath.Join(tmp, m.PackageName())
+	build := goCommand(ctx, m, "build", "-o", bin, ".")
+	if out, err := build.CombinedOutput(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			// A cold cgo build of a vendored dependency, such as
+			// go-sqlite3, can take minutes.
+			return Output{}, fmt.Errorf("build timed out after %s", timeout)
+		}
+		return Output{}, fmt.Errorf("build failed\n%s", out)
+	}
+
+	runDir := filepath.Join(tmp, "run")
+	if err := os.Mkdir(runDir, 0755); err != nil {
+		return Output{}, err
+	}
+	for name, content := range c.Files {
+		if !filepath.IsAbs(name) {
+			name = filepath.Join(runDir, name)
+		}
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			return Output{}, err
+		}
+		if err := os.WriteFile(name, []byte(content), 0644); err != nil {
+			return Output{}, err
+		}
+	}
+
+	var stdout bytes.Buffer
+	cmd := exec.CommandContext(ctx, bin, c.Args...)
+	// Run as ./<name> so that programs printing os.Args[0] produce
+	// the same output wherever the binary was built.
+	cmd.Args[0] = "./" + m.PackageName()
+	cmd.Dir = runDir
+	cmd.Stdout = &stdout
+	cmd.Stdin = bytes.NewBufferString(c.Stdin)
+	if c.Env != nil {
+		cmd.Env = environ(c.Env)
+	}
+
+	err = cmd.Run()
+	out := Output{Stdout: stdout.String()}
+	if ctx.Err() == context.DeadlineExceeded {
+		return out, fmt.Errorf("timed out after %s", timeout)
+	}
+	var exitErr *exec.ExitError
+	switch {
+	case errors.As(err, &exitErr):
+		out.ExitCode = exitErr.ExitCode()
+	case err != nil:
+		return out, err
+	}
+	return out, nil
+}
+
+// goCommand returns a go command run in the module directory. The
+// module is built on its own, outside the go.work workspace, and from
+// its vendor directory when it has one: -mod=vendor is passed
+// explicitly so a GOFLAGS=-mod=mod in the environment can't send the
+// build back to the network.
+func goCommand(ctx context.Context, m curriculum.Module, verb string, args ...string) *exec.Cmd {
+	goArgs := []string{verb}
+	if m.Vendored() {
+		goArgs = append(goArgs, "-mod=vendor")
+	}
+	cmd := exec.CommandContext(ctx, "go", append(goArgs, args...)...)
+	cmd.Dir = m.Dir
+	cmd.Env = append(os.Environ(), "GOWORK=off")
+	return cmd
+}
+
+// runTimeout returns the timeout configured for c.
+func runTimeout(c curriculum.Check) (time.Duration, error) {
+	if c.Timeout == "" {
+		return DefaultTimeout, nil
+	}
+	d, err := time.ParseDuration(c.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("timeout: %w", err)
+	}
+	return d, nil
+}
+
+// environ turns env into a deterministic KEY=value list.
+func environ(env map[string]string) []string {
+	list := make([]string, 0, len(env))
+	for k, v := range env {
+		list = append(list, k+"="+v)
+	}
+	sort.Strings(list)
+	return list
+}
@@ -0,0 +1,209 @@
+package grader
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/orsenthil/practicego/internal/curriculum"
+)
+
+// newModule lays out a generated module the way setup_go_practice.py
+// would, with src as the workspace file.
+func newModule(t *testing.T, src, golden string, check curriculum.Check) curriculum.Module {
+	t.Helper()
+	dir := filepath.Join(t.TempDir(), "01Echo")
+	if err := os.MkdirAll(filepath.Join(dir, curriculum.PracticeDirName), 0755); err != nil {
+		t.Fatal(err)
+	}
+	m := curriculum.Module{
+		Name:     "01Echo",
+		Dir:      dir,
+		Number:   1,
+		Metadata: curriculum.Metadata{Key: "echo", DisplayName: "Echo", Check: check},
+	}
+	files := map[string]string{
+		m.WorkspaceFile():            src,
+		filepath.Join(dir, "go.mod"): "module example.com/echo\n\ngo 1.25\n",
+		m.GoldenFile():               golden,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(name, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return m
+}
+
+const echoSrc = `package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+)
+
+func main() {
+	fmt.Println(os.Args)
+	s := bufio.NewScanner(os.Stdin)
+	for s.Scan() {
+		fmt.Println("in:", s.Text())
+	}
+	os.Exit(len(os.Args) - 1)
+}
+`
+
+func TestCheckPass(t *testing.T) {
+	m := newModule(t, echoSrc, "[./echo a]\nin: hello\n", curriculum.Check{
+		Args:     []string{"a"},
+		Stdin:    "hello\n",
+		ExitCode: 1,
+	})
+
+	res := Check(context.Background(), m)
+	if res.Status != Pass {
+		t.Fatalf("Expected PASS, got %s: %s\n%s", res.Status, res.Reason, res.Diff)
+	}
+}
+
+func TestCheckFail(t *testing.T) {
+	m := newModule(t, echoSrc, "[./echo]\nin: bye\n", curriculum.Check{Stdin: "hello\n"})
+
+	res := Check(context.Background(), m)
+	if res.Status != Fail {
+		t.Fatalf("Expected FAIL, got %s", res.Status)
+	}
+	if res.Diff == "" {
+		t.Error("Expected a diff for mismatched output")
+	}
+}
+
+func TestCheckExitCode(t *testing.T) {
+	m := newModule(t, echoSrc, "[./echo a]\n", curriculum.Check{Args: []string{"a"}})
+
+	res := Check(context.Background(), m)
+	if res.Status != Fail || res.Output.ExitCode != 1 {
+		t.Errorf("Expected FAIL with exit status 1, got %s (%d)", res.Status, res.Output.ExitCode)
+	}
+}
+
+func TestCheckBuildFailure(t *testing.T) {
+	m := newModule(t, "package main\n\nfunc main() { undefined() }\n", "", curriculum.Check{})
+
+	res := Check(context.Background(), m)
+	if res.Status != Fail {
+		t.Errorf("Expected FAIL for a build error, got %s", res.Status)
+	}
+}
+
+func TestCheckSkip(t *testing.T) {
+	m := newModule(t, echoSrc, "", curriculum.Check{Skip: "needs a server"})
+
+	res := Check(context.Background(), m)
+	if res.Status != Skip || res.Reason != "needs a server" {
+		t.Errorf("Expected SKIP with reason, got %s (%s)", res.Status, res.Reason)
+	}
+}
+
+func TestUpdateGolden(t *testing.T) {
+	m := newModule(t, echoSrc, "", curriculum.Check{})
+
+	if err := UpdateGolden(context.Background(), m); err != nil {
+		t.Fatalf("UpdateGolden failed: %v", err)
+	}
+	if res := Check(context.Background(), m); res.Status != Pass {
+		t.Errorf("Expected PASS after update, got %s: %s", res.Status, res.Reason)
+	}
+}
+
+func writeTests(t *testing.T, m curriculum.Module, src string) {
+	t.Helper()
+	if err := os.WriteFile(m.TestFile(), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckRunsTests(t *testing.T) {
+	m := newModule(t, echoSrc, "[./echo]\n", curriculum.Check{})
+	writeTests(t, m, "package main\n\nimport \"testing\"\n\nfunc TestFails(t *testing.T) { t.Fatal(\"boom\") }\n")
+
+	res := Check(context.Background(), m)
+	if res.Status != Fail || !strings.HasPrefix(res.Reason, "tests failed") {
+		t.Fatalf("Expected FAIL from tests, got %s: %s", res.Status, res.Reason)
+	}
+	if !strings.Contains(res.Reason, "boom") {
+		t.Errorf("Expected test output in reason, got %q", res.Reason)
+	}
+}
+
+func TestCheckSkippedOutputWithTests(t *testing.T) {
+	m := newModule(t, echoSrc, "", curriculum.Check{Skip: "needs a server"})
+	writeTests(t, m, "package main\n\nimport \"testing\"\n\nfunc TestPasses(t *testing.T) {}\n")
+
+	// The output can't be compared, but the tests still grade
+	// the module.
+	if res := Check(context.Background(), m); res.Status != Pass {
+		t.Errorf("Expected PASS from tests, got %s: %s", res.Status, res.Reason)
+	}
+}
+
+func TestCheckRunsTestsWithTags(t *testing.T) {
+	m := newModule(t, echoSrc, "", curriculum.Check{Skip: "needs a server", TestTags: []string{"alt"}})
+	writeTests(t, m, "package main\n\nimport \"testing\"\n\nfunc TestPasses(t *testing.T) {}\n")
+	alt := filepath.Join(m.Dir, "alt_test.go")
+	if err := os.WriteFile(alt, []byte("//go:build alt\n\npackage main\n\nimport \"testing\"\n\nfunc TestAlt(t *testing.T) { t.Fatal(\"alt build\") }\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	res := Check(context.Background(), m)
+	if res.Status != Fail || !strings.HasPrefix(res.Reason, "tests failed with -tags alt") {
+		t.Fatalf("Expected FAIL from the tagged tests, got %s: %s", res.Status, res.Reason)
+	}
+	if !strings.Contains(res.Reason, "alt build") {
+		t.Errorf("Expected test output in reason, got %q", res.Reason)
+	}
+}
+
+func TestFirstFailure(t *testing.T) {
+	tests := []struct {
+		name string
+		res  Result
+		want string
+	}{
+		{"pass", Result{Status: Pass}, ""},
+		{
+			"assertion",
+			Result{Status: Fail, Reason: "tests failed\n--- FAIL: TestSum (0.00s)\n    range_test.go:10: Expected 9, got 0\n    range_test.go:13: Expected 0, got 1\nFAIL\n"},
+			"range_test.go:10: Expected 9, got 0",
+		},
+		{
+			"compile error",
+			Result{Status: Fail, Reason: "build failed\n# example.com/echo\n./echo.go:5:2: undefined: x\n"},
+			"./echo.go:5:2: undefined: x",
+		},
+		{
+			"panic",
+			Result{Status: Fail, Reason: "tests failed\n--- FAIL: TestSum (0.00s)\npanic: runtime error: index out of range [2] with length 0\n"},
+			"panic: runtime error: index out of range [2] with length 0",
+		},
+		{
+			"output",
+			Result{Status: Fail, Reason: "output differs from expected_output.txt", Diff: "  sum: 9\n- index: 1\n+ index: -1\n"},
+			"output differs from expected_output.txt: - index: 1",
+		},
+		{
+			"link error",
+			Result{Status: Fail, Reason: "build failed\n# example.com/echo\nruntime.main_main·f: function main is undeclared in the main package\n"},
+			"runtime.main_main·f: function main is undeclared in the main package",
+		},
+		{"other", Result{Status: Fail, Reason: "exit status 1, want 0"}, "exit status 1, want 0"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.res.FirstFailure(); got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.want, got)
+		}
+	}
+}
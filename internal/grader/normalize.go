@@ -0,0 +1,117 @@
+package grader
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/orsenthil/practicego/internal/curriculum"
+)
+
+// rule is a named normalization that modules opt into from the
+// "normalize" list of their metadata.
+type rule struct {
+	name     string
+	patterns []*regexp.Regexp
+	with     string
+}
+
+// rules are applied in this order, regardless of the order in which a
+// module lists them, so that e.g. timestamps are collapsed before their
+// digits would be rewritten by "number".
+var rules = []rule{
+	{
+		name: "time",
+		with: "<time>",
+		patterns: []*regexp.Regexp{
+			// time.Time.String() and RFC 3339, with an optional
+			// zone abbreviation and monotonic clock reading.
+			regexp.MustCompile(`\d{4}-\d{2}-\d{2}[ T]\d{2}:\d{2}:\d{2}(\.\d+)?( ?(Z|[+-]\d{2}:?\d{2}))?( [A-Z]{2,5})?( m=[+-]\d+\.\d+)?`),
+			// ANSIC / UnixDate style, as printed by date(1).
+			regexp.MustCompile(`(Mon|Tue|Wed|Thu|Fri|Sat|Sun) (Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec) +\d{1,2} \d{2}:\d{2}:\d{2}( [A-Z]{2,5})?( \d{4})?`),
+			// Kitchen style, e.g. 3:04PM.
+			regexp.MustCompile(`\b\d{1,2}:\d{2}(AM|PM)`),
+		},
+	},
+	{
+		name: "duration",
+		with: "<duration>",
+		patterns: []*regexp.Regexp{
+			regexp.MustCompile(`\b(\d+h)?(\d+m)?\d+(\.\d+)?(s|ms|µs|us|ns)\b`),
+		},
+	},
+	{
+		name: "pointer",
+		with: "<ptr>",
+		patterns: []*regexp.Regexp{
+			regexp.MustCompile(`0x[0-9a-f]+`),
+		},
+	},
+	{
+		name: "pid",
+		with: "${1}<pid>",
+		patterns: []*regexp.Regexp{
+			regexp.MustCompile(`(?i)\b(pid[:= ]+)\d+`),
+		},
+	},
+	{
+		name: "number",
+		with: "<n>",
+		patterns: []*regexp.Regexp{
+			regexp.MustCompile(`-?\d+(\.\d+)?(e[+-]?\d+)?`),
+		},
+	},
+}
+
+// Normalize rewrites program output so that nondeterministic parts
+// compare equal. Trailing whitespace and trailing blank lines are always
+// ignored.
+func Normalize(out string, c curriculum.Check) (string, error) {
+	out = strings.ReplaceAll(out, "\r\n", "\n")
+
+	for _, r := range c.Replace {
+		re, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return "", fmt.Errorf("replace %q: %w", r.Pattern, err)
+		}
+		out = re.ReplaceAllString(out, r.With)
+	}
+
+	enabled := make(map[string]bool)
+	for _, name := range c.Normalize {
+		if !knownRule(name) {
+			return "", fmt.Errorf("unknown normalize rule %q", name)
+		}
+		enabled[name] = true
+	}
+	for _, r := range rules {
+		if !enabled[r.name] {
+			continue
+		}
+		for _, re := range r.patterns {
+			out = re.ReplaceAllString(out, r.with)
+		}
+	}
+
+	lines := strings.Split(out, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if c.Unordered {
+		sort.Strings(lines)
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func knownRule(name string) bool {
+	for _, r := range rules {
+		if r.name == name {
+			return true
+		}
+	}
+	return false
+}
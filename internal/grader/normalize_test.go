@@ -0,0 +1,127 @@
+package grader
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/orsenthil/practicego/internal/curriculum"
+)
+
+func TestNormalizeRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules []string
+		in    string
+		want  string
+	}{
+		{
+			name:  "time.Time",
+			rules: []string{"time"},
+			in:    "Tick at 2012-09-23 11:29:56.487625 -0700 PDT m=+0.500140584",
+			want:  "Tick at <time>",
+		},
+		{
+			name:  "RFC3339",
+			rules: []string{"time"},
+			in:    "2014-04-15T18:00:15-07:00",
+			want:  "<time>",
+		},
+		{
+			name:  "date(1)",
+			rules: []string{"time"},
+			in:    "Thu Oct 16 10:00:00 UTC 2026",
+			want:  "<time>",
+		},
+		{
+			name:  "kitchen",
+			rules: []string{"time"},
+			in:    "6:00PM",
+			want:  "<time>",
+		},
+		{
+			name:  "duration",
+			rules: []string{"duration"},
+			in:    "took 12h3m4.5s and 200ms",
+			want:  "took <duration> and <duration>",
+		},
+		{
+			name:  "pointer",
+			rules: []string{"pointer"},
+			in:    "pointer: 0xc000012345",
+			want:  "pointer: <ptr>",
+		},
+		{
+			name:  "pid",
+			rules: []string{"pid"},
+			in:    "child pid 4242 exited",
+			want:  "child pid <pid> exited",
+		},
+		{
+			name:  "number",
+			rules: []string{"number"},
+			in:    "81,87\n0.6645600532184904\n5.336998830475256e+08",
+			want:  "<n>,<n>\n<n>\n<n>",
+		},
+		{
+			name:  "time before number",
+			rules: []string{"number", "time"},
+			in:    "2009-11-17 20:34:58.651387237 +0000 UTC 42",
+			want:  "<time> <n>",
+		},
+		{
+			name: "trailing whitespace",
+			in:   "BAR: \n\n",
+			want: "BAR:",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.in, curriculum.Check{Normalize: tt.rules})
+			if err != nil {
+				t.Fatalf("Normalize failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeReplaceAndUnordered(t *testing.T) {
+	c := curriculum.Check{
+		Replace:   []curriculum.Replacement{{Pattern: "It's (a weekday|the weekend)", With: "It's <day>"}},
+		Unordered: true,
+	}
+	a, err := Normalize("worker 2 done\nIt's the weekend\nworker 1 done\n", c)
+	if err != nil {
+		t.Fatalf("Normalize failed: %v", err)
+	}
+	b, _ := Normalize("worker 1 done\nworker 2 done\nIt's a weekday\n", c)
+	if a != b {
+		t.Errorf("Expected equal output, got %q and %q", a, b)
+	}
+}
+
+func TestNormalizeUnknownRule(t *testing.T) {
+	if _, err := Normalize("x", curriculum.Check{Normalize: []string{"bogus"}}); err == nil {
+		t.Error("Expected error for unknown rule")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	if d := Diff("a\nb", "a\nb"); d != "" {
+		t.Errorf("Expected no diff, got %q", d)
+	}
+
+	d := Diff("a\nb\nc", "a\nx\nc")
+	want := "  a\n- b\n+ x\n  c\n"
+	if d != want {
+		t.Errorf("got diff:\n%s\nwant:\n%s", d, want)
+	}
+
+	d = Diff("", "hello world")
+	if !strings.Contains(d, "+ hello world") {
+		t.Errorf("Expected added line, got %q", d)
+	}
+}
@@ -0,0 +1,130 @@
+package grader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/orsenthil/practicego/internal/curriculum"
+)
+
+// importsFile is written next to the workspace file while vendoring.
+// Its build tag keeps it out of every build, but `go mod vendor`
+// still follows its imports.
+const importsFile = "practice_vendor.go"
+
+// Vendor copies the module's dependencies into its vendor directory
+// with `go mod vendor`, so that Run and Test no longer need the
+// network or the module cache. It needs them once, to fetch the
+// dependencies.
+//
+// The workspace file is usually still the template, which imports
+// little or nothing, so the packages imported by the reference
+// solution and its tests are vendored too.
+func Vendor(ctx context.Context, m curriculum.Module) error {
+	if !m.Generated() {
+		return fmt.Errorf("%s is not generated; run python3 setup_go_practice.py", m.Name)
+	}
+	remove, err := writeImports(m)
+	if err != nil {
+		return err
+	}
+	defer remove()
+
+	cmd := exec.CommandContext(ctx, "go", "mod", "vendor")
+	cmd.Dir = m.Dir
+	cmd.Env = append(os.Environ(), "GOWORK=off")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s: go mod vendor failed\n%s", m.Name, out)
+	}
+	return nil
+}
+
+// VendorWorkspace runs `go work vendor` in root, which holds go.work.
+// Commands run inside the workspace, such as `go run .` from a module
+// directory, ignore the per-module vendor directories and read the
+// workspace one instead. As with Vendor, the solution imports of
+// modules are included.
+func VendorWorkspace(ctx context.Context, root string, modules []curriculum.Module) error {
+	for _, m := range modules {
+		if !m.Generated() {
+			continue
+		}
+		remove, err := writeImports(m)
+		if err != nil {
+			return err
+		}
+		defer remove()
+	}
+
+	cmd := exec.CommandContext(ctx, "go", "work", "vendor")
+	cmd.Dir = root
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("go work vendor failed\n%s", out)
+	}
+	return nil
+}
+
+// writeImports writes importsFile with a blank import of every
+// non-standard package the module's solution and tests use. The
+// returned function removes it again.
+func writeImports(m curriculum.Module) (func(), error) {
+	paths, err := solutionImports(m)
+	if err != nil || len(paths) == 0 {
+		return func() {}, err
+	}
+
+	var b strings.Builder
+	b.WriteString("//go:build practice_vendor\n\npackage main\n\nimport (\n")
+	for _, p := range paths {
+		fmt.Fprintf(&b, "\t_ %q\n", p)
+	}
+	b.WriteString(")\n")
+
+	name := filepath.Join(m.Dir, importsFile)
+	if err := os.WriteFile(name, []byte(b.String()), 0644); err != nil {
+		return nil, err
+	}
+	return func() { os.Remove(name) }, nil
+}
+
+// solutionImports returns the sorted non-standard import paths of the
+// module's .practice/solution.go and solution_test.go.
+func solutionImports(m curriculum.Module) ([]string, error) {
+	seen := make(map[string]bool)
+	fset := token.NewFileSet()
+	for _, name := range []string{"solution.go", "solution_test.go"} {
+		f, err := parser.ParseFile(fset, filepath.Join(m.PracticeDir(), name), nil, parser.ImportsOnly)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, spec := range f.Imports {
+			p, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				return nil, err
+			}
+			// Standard library paths have no dot in their first
+			// element.
+			if first, _, _ := strings.Cut(p, "/"); strings.Contains(first, ".") {
+				seen[p] = true
+			}
+		}
+	}
+	paths := make([]string, 0, len(seen))
+	for p := range seen {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
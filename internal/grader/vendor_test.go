@@ -0,0 +1,111 @@
+package grader
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/orsenthil/practicego/internal/curriculum"
+)
+
+const greetSrc = `package main
+
+import (
+	"fmt"
+
+	"example.com/greet"
+)
+
+func main() {
+	fmt.Println(greet.Hello())
+}
+`
+
+func TestVendor(t *testing.T) {
+	// The workspace is still the template and doesn't import the
+	// dependency yet; the solution does.
+	m := newModule(t, echoSrc, "hello\n", curriculum.Check{})
+	if err := os.WriteFile(filepath.Join(m.PracticeDir(), "solution.go"), []byte(greetSrc), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The dependency lives next to the module and is pulled in with
+	// a replace directive, so vendoring it needs no network.
+	dep := filepath.Join(filepath.Dir(m.Dir), "greet")
+	files := map[string]string{
+		filepath.Join(dep, "go.mod"):   "module example.com/greet\n\ngo 1.25\n",
+		filepath.Join(dep, "greet.go"): "package greet\n\nfunc Hello() string { return \"hello\" }\n",
+		filepath.Join(m.Dir, "go.mod"): "module example.com/echo\n\ngo 1.25\n\nrequire example.com/greet v0.0.0\n\nreplace example.com/greet => ../greet\n",
+	}
+	for name, content := range files {
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if m.Vendored() {
+		t.Fatal("Expected a fresh module not to be vendored")
+	}
+	if err := Vendor(context.Background(), m); err != nil {
+		t.Fatalf("Vendor failed: %v", err)
+	}
+	if !m.Vendored() {
+		t.Fatal("Expected the module to be vendored")
+	}
+
+	if _, err := os.Stat(filepath.Join(m.Dir, importsFile)); !os.IsNotExist(err) {
+		t.Errorf("Expected %s to be removed after vendoring, got %v", importsFile, err)
+	}
+
+	// Once the learner writes the solution, the build can only
+	// succeed from the vendor directory: the replaced directory is
+	// gone and GOFLAGS asks for the module cache.
+	if err := os.WriteFile(m.WorkspaceFile(), []byte(greetSrc), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.RemoveAll(dep); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("GOFLAGS", "-mod=mod")
+	t.Setenv("GOPROXY", "off")
+	if res := Check(context.Background(), m); res.Status != Pass {
+		t.Errorf("Expected PASS from the vendored build, got %s: %s\n%s", res.Status, res.Reason, res.Diff)
+	}
+}
+
+func TestVendorNotGenerated(t *testing.T) {
+	m := newModule(t, echoSrc, "", curriculum.Check{})
+	if err := os.Remove(m.WorkspaceFile()); err != nil {
+		t.Fatal(err)
+	}
+	if err := Vendor(context.Background(), m); err == nil {
+		t.Error("Expected an error for a module that is not generated")
+	}
+}
+
+func TestSolutionImports(t *testing.T) {
+	m := newModule(t, echoSrc, "", curriculum.Check{})
+	files := map[string]string{
+		"solution.go":      "package main\n\nimport (\n\t\"fmt\"\n\n\t\"gorm.io/gorm\"\n\tsqlite \"gorm.io/driver/sqlite\"\n)\n",
+		"solution_test.go": "package main\n\nimport (\n\t\"testing\"\n\n\t\"gorm.io/gorm\"\n\t\"gopkg.in/yaml.v3\"\n)\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(m.PracticeDir(), name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := solutionImports(m)
+	if err != nil {
+		t.Fatalf("solutionImports failed: %v", err)
+	}
+	want := []string{"gopkg.in/yaml.v3", "gorm.io/driver/sqlite", "gorm.io/gorm"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,89 @@
+// Package hints loads the progressive hints stored next to a module's
+// template in .practice/hints.yaml.
+//
+// The file maps a TODO id, the name of the template function holding
+// the TODO or Type.Method for a method, to three levels of help that
+// are revealed one at a time:
+//
+//	makeSlice:
+//	  concept: What a slice with a length is.
+//	  api: The builtin make and its arguments.
+//	  solution: Nearly the code, e.g. make([]string, n).
+package hints
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Levels is the number of levels of every hint.
+const Levels = 3
+
+// levelNames names the levels, from the most general to the most
+// specific.
+var levelNames = [Levels]string{"concept", "API", "solution"}
+
+// Hint is the help for one TODO.
+type Hint struct {
+	ID       string `yaml:"-"`
+	Concept  string `yaml:"concept"`
+	API      string `yaml:"api"`
+	Solution string `yaml:"solution"`
+}
+
+// Level returns the name and text of level n, counted from 1.
+func (h Hint) Level(n int) (name, text string) {
+	texts := [Levels]string{h.Concept, h.API, h.Solution}
+	return levelNames[n-1], strings.TrimSpace(texts[n-1])
+}
+
+// Load reads the hints file at path and returns its hints in file
+// order. A missing file is reported as an error wrapping
+// os.ErrNotExist.
+func Load(path string) ([]Hint, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%s: expected a mapping of TODO ids to hints", path)
+	}
+
+	// Decode pair by pair, rather than into a map, to keep the order
+	// in which the TODOs appear.
+	var hints []Hint
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		h := Hint{ID: root.Content[i].Value}
+		if err := root.Content[i+1].Decode(&h); err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", path, h.ID, err)
+		}
+		for n := 1; n <= Levels; n++ {
+			if name, text := h.Level(n); text == "" {
+				return nil, fmt.Errorf("%s: %s has no %s hint", path, h.ID, name)
+			}
+		}
+		hints = append(hints, h)
+	}
+	return hints, nil
+}
+
+// Find looks up the hint for a TODO id, ignoring case.
+func Find(hints []Hint, id string) (Hint, error) {
+	for _, h := range hints {
+		if strings.EqualFold(h.ID, id) {
+			return h, nil
+		}
+	}
+	return Hint{}, fmt.Errorf("no hint for %q", id)
+}
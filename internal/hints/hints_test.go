@@ -0,0 +1,170 @@
+package hints
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/orsenthil/practicego/internal/curriculum"
+)
+
+func writeHints(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "hints.yaml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	path := writeHints(t, `
+zeroval:
+  concept: Arguments are copied.
+  api: Assign to the parameter.
+  solution: |
+    ival = 0
+zeroptr:
+  concept: A pointer refers to the caller's variable.
+  api: Dereference with *.
+  solution: "*iptr = 0"
+`)
+	list, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(list) != 2 || list[0].ID != "zeroval" || list[1].ID != "zeroptr" {
+		t.Fatalf("Expected zeroval and zeroptr in file order, got %+v", list)
+	}
+
+	tests := []struct {
+		level int
+		name  string
+		text  string
+	}{
+		{1, "concept", "Arguments are copied."},
+		{2, "API", "Assign to the parameter."},
+		{3, "solution", "ival = 0"},
+	}
+	for _, tt := range tests {
+		name, text := list[0].Level(tt.level)
+		if name != tt.name || text != tt.text {
+			t.Errorf("Level(%d): expected %q %q, got %q %q", tt.level, tt.name, tt.text, name, text)
+		}
+	}
+}
+
+func TestLoadMissingLevel(t *testing.T) {
+	path := writeHints(t, "zeroval:\n  concept: Arguments are copied.\n  solution: ival = 0\n")
+	_, err := Load(path)
+	if err == nil || !strings.Contains(err.Error(), "zeroval has no API hint") {
+		t.Errorf("Expected a missing API hint error, got %v", err)
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	_, err := Load(filepath.Join(t.TempDir(), "hints.yaml"))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected os.ErrNotExist, got %v", err)
+	}
+}
+
+func TestFind(t *testing.T) {
+	list := []Hint{{ID: "makeSlice"}, {ID: "sliceUp"}}
+	h, err := Find(list, "sliceup")
+	if err != nil || h.ID != "sliceUp" {
+		t.Errorf("Expected sliceUp, got %q, %v", h.ID, err)
+	}
+	if _, err := Find(list, "triangle"); err == nil {
+		t.Error("Expected an error for an unknown TODO id")
+	}
+}
+
+// TestRepositoryHints checks the hints shipped with the modules: a
+// module whose template has TODOs must ship a file that loads, with an
+// entry for each function holding one, and each TODO id must name a
+// function of the module's template, or a method as Type.Method.
+func TestRepositoryHints(t *testing.T) {
+	root, err := curriculum.FindRoot(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	modules, err := curriculum.Discover(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range modules {
+		f, err := parser.ParseFile(token.NewFileSet(), m.TemplateFile(), nil, parser.ParseComments)
+		if err != nil {
+			t.Fatalf("%s: %v", m.Name, err)
+		}
+		funcs := make(map[string]bool)
+		var todos []string
+		for _, decl := range f.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok {
+				funcs[funcID(fn)] = true
+				if holdsTODO(f, fn) {
+					todos = append(todos, funcID(fn))
+				}
+			}
+		}
+
+		list, err := Load(m.HintsFile())
+		if errors.Is(err, os.ErrNotExist) && len(todos) == 0 {
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", m.Name, err)
+			continue
+		}
+		ids := make(map[string]bool)
+		for _, h := range list {
+			if !funcs[h.ID] {
+				t.Errorf("%s: hint %q doesn't match a function in template.go", m.Name, h.ID)
+			}
+			ids[h.ID] = true
+		}
+		for _, id := range todos {
+			if !ids[id] {
+				t.Errorf("%s: no hint for the TODOs in %s", m.Name, id)
+			}
+		}
+	}
+}
+
+// holdsTODO reports whether a comment inside fn mentions a TODO.
+func holdsTODO(f *ast.File, fn *ast.FuncDecl) bool {
+	for _, g := range f.Comments {
+		if g.Pos() > fn.Pos() && g.End() < fn.End() && strings.Contains(g.Text(), "TODO") {
+			return true
+		}
+	}
+	return false
+}
+
+// funcID returns the TODO id of fn: its name, or Type.Method for a
+// method.
+func funcID(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+	typ := fn.Recv.List[0].Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	switch t := typ.(type) {
+	case *ast.IndexExpr:
+		typ = t.X
+	case *ast.IndexListExpr:
+		typ = t.X
+	}
+	if id, ok := typ.(*ast.Ident); ok {
+		return id.Name + "." + fn.Name.Name
+	}
+	return fn.Name.Name
+}
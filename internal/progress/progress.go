@@ -0,0 +1,240 @@
+// Package progress records how far a learner has got through the
+// practice modules. The record is a JSON file kept at the repository
+// root, outside the generated files, so `setup_go_practice.py --clean`
+// leaves it alone.
+package progress
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"time"
+
+	"github.com/orsenthil/practicego/internal/curriculum"
+)
+
+// FileName is the name of the state file in the repository root.
+const FileName = ".practice-progress.json"
+
+// Path returns the default state file for the repository at root.
+func Path(root string) string {
+	return filepath.Join(root, FileName)
+}
+
+// State is where a learner stands on a module. States are ordered:
+// each one implies the ones before it.
+type State string
+
+const (
+	NotStarted State = "not started"
+	InProgress State = "in progress"
+	Passing    State = "passing"
+	Reviewed   State = "reviewed"
+)
+
+var states = []State{NotStarted, InProgress, Passing, Reviewed}
+
+// rank returns the position of s in the progression, or -1 for an
+// unknown state.
+func (s State) rank() int {
+	for i, st := range states {
+		if s == st {
+			return i
+		}
+	}
+	return -1
+}
+
+// AtLeast reports whether s is o or a later state.
+func (s State) AtLeast(o State) bool {
+	return s.rank() >= o.rank()
+}
+
+// UnmarshalText rejects states this version doesn't know about, so a
+// typo in a hand-edited file is reported instead of ignored.
+func (s *State) UnmarshalText(text []byte) error {
+	st := State(text)
+	if st.rank() < 0 {
+		return fmt.Errorf("unknown state %q", text)
+	}
+	*s = st
+	return nil
+}
+
+// Entry is the recorded state of one module.
+type Entry struct {
+	State   State     `json:"state"`
+	Updated time.Time `json:"updated"`
+
+	// Hints counts the hint levels revealed with `practice hint`,
+	// keyed by TODO id.
+	Hints map[string]int `json:"hints,omitempty"`
+}
+
+// HintsUsed returns the total number of hint levels revealed for the
+// module.
+func (e Entry) HintsUsed() int {
+	n := 0
+	for _, levels := range e.Hints {
+		n += levels
+	}
+	return n
+}
+
+// Progress is the content of the state file.
+type Progress struct {
+	Learner string           `json:"learner"`
+	Modules map[string]Entry `json:"modules"` // keyed by module directory name
+}
+
+// Load reads the state file at path. A missing file is not an error:
+// it yields an empty record for the current user.
+func Load(path string) (*Progress, error) {
+	p := &Progress{}
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, err
+	default:
+		if err := json.Unmarshal(data, p); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	if p.Modules == nil {
+		p.Modules = make(map[string]Entry)
+	}
+	if p.Learner == "" {
+		p.Learner = currentUser()
+	}
+	return p, nil
+}
+
+// Save writes the record to path. The file is replaced atomically so
+// an interrupted run can't leave it half written.
+func (p *Progress) Save(path string) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".progress-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Get returns the recorded entry for the module, NotStarted when there
+// is none.
+func (p *Progress) Get(name string) Entry {
+	e, ok := p.Modules[name]
+	if !ok {
+		e.State = NotStarted
+	}
+	return e
+}
+
+// Set records state s for the module. Updated only changes when the
+// state does.
+func (p *Progress) Set(name string, s State, now time.Time) {
+	e, ok := p.Modules[name]
+	if ok && e.State == s {
+		return
+	}
+	e.State, e.Updated = s, now
+	p.Modules[name] = e
+}
+
+// Record stores the outcome of running the module's tests. Passing
+// tests keep a Reviewed module reviewed; failing tests send any module
+// back to InProgress.
+func (p *Progress) Record(name string, passed bool, now time.Time) {
+	switch {
+	case !passed:
+		p.Set(name, InProgress, now)
+	case !p.Get(name).State.AtLeast(Passing):
+		p.Set(name, Passing, now)
+	}
+}
+
+// Review marks a module as reviewed. Only modules whose tests last
+// passed can be reviewed.
+func (p *Progress) Review(name string, now time.Time) error {
+	if s := p.Get(name).State; !s.AtLeast(Passing) {
+		return fmt.Errorf("%s is %s; only passing modules can be reviewed", name, s)
+	}
+	p.Set(name, Reviewed, now)
+	return nil
+}
+
+// Hint records that level of the hint for todo was revealed in the
+// module. Levels only go up: showing an earlier level again changes
+// nothing. The module's state is left alone.
+func (p *Progress) Hint(name, todo string, level int) {
+	e := p.Get(name)
+	if e.Hints[todo] >= level {
+		return
+	}
+	if e.Hints == nil {
+		e.Hints = make(map[string]int)
+	}
+	e.Hints[todo] = level
+	p.Modules[name] = e
+}
+
+// Observe inspects the module's workspace without building or testing
+// it. A module whose workspace file is missing or still identical to
+// its template is NotStarted; any other is InProgress.
+func Observe(m curriculum.Module) (State, error) {
+	work, err := os.ReadFile(m.WorkspaceFile())
+	if errors.Is(err, os.ErrNotExist) {
+		return NotStarted, nil
+	}
+	if err != nil {
+		return NotStarted, err
+	}
+	tmpl, err := os.ReadFile(m.TemplateFile())
+	if err != nil {
+		return NotStarted, err
+	}
+	if bytes.Equal(work, tmpl) {
+		return NotStarted, nil
+	}
+	return InProgress, nil
+}
+
+// Current combines the recorded entry with what Observe sees: a module
+// that was never recorded past NotStarted moves to InProgress as soon
+// as its workspace is edited. Recorded test results are kept, even when
+// the workspace has since been cleaned.
+func (p *Progress) Current(m curriculum.Module) (Entry, error) {
+	e := p.Get(m.Name)
+	if e.State.AtLeast(InProgress) {
+		return e, nil
+	}
+	observed, err := Observe(m)
+	if err != nil {
+		return e, err
+	}
+	e.State = observed
+	return e, nil
+}
+
+func currentUser() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
@@ -0,0 +1,190 @@
+package progress
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/internal/curriculum"
+)
+
+var (
+	t0 = time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	t1 = t0.Add(time.Hour)
+)
+
+func TestLoadMissing(t *testing.T) {
+	p, err := Load(filepath.Join(t.TempDir(), FileName))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if p.Modules == nil {
+		t.Fatal("Expected an empty module map, got nil")
+	}
+	if got := p.Get("09Slices").State; got != NotStarted {
+		t.Errorf("Expected %q, got %q", NotStarted, got)
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	p := &Progress{Learner: "sam", Modules: map[string]Entry{}}
+	p.Set("09Slices", Passing, t0)
+
+	if err := p.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	got, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if got.Learner != "sam" {
+		t.Errorf("Expected learner sam, got %q", got.Learner)
+	}
+	if e := got.Get("09Slices"); e.State != Passing || !e.Updated.Equal(t0) {
+		t.Errorf("Expected passing at %v, got %+v", t0, e)
+	}
+}
+
+func TestLoadUnknownState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	os.WriteFile(path, []byte(`{"modules": {"09Slices": {"state": "done"}}}`), 0644)
+
+	if _, err := Load(path); err == nil {
+		t.Error("Expected an error for an unknown state")
+	}
+}
+
+func TestRecord(t *testing.T) {
+	tests := []struct {
+		from   State
+		passed bool
+		want   State
+	}{
+		{NotStarted, true, Passing},
+		{NotStarted, false, InProgress},
+		{InProgress, true, Passing},
+		{Passing, false, InProgress},
+		{Reviewed, true, Reviewed},
+		{Reviewed, false, InProgress},
+	}
+
+	for _, tt := range tests {
+		p := &Progress{Modules: map[string]Entry{}}
+		p.Set("09Slices", tt.from, t0)
+		p.Record("09Slices", tt.passed, t1)
+		if got := p.Get("09Slices").State; got != tt.want {
+			t.Errorf("Record(%v) from %q: expected %q, got %q", tt.passed, tt.from, tt.want, got)
+		}
+	}
+}
+
+func TestSetKeepsTimestamp(t *testing.T) {
+	p := &Progress{Modules: map[string]Entry{}}
+	p.Set("09Slices", Passing, t0)
+	p.Set("09Slices", Passing, t1)
+
+	if got := p.Get("09Slices").Updated; !got.Equal(t0) {
+		t.Errorf("Expected the time of the first change %v, got %v", t0, got)
+	}
+}
+
+func TestHint(t *testing.T) {
+	p := &Progress{Modules: map[string]Entry{}}
+	p.Hint("09Slices", "makeSlice", 1)
+	p.Hint("09Slices", "makeSlice", 2)
+	p.Hint("09Slices", "makeSlice", 1) // shown again, not a new level
+	p.Hint("09Slices", "sliceUp", 1)
+
+	e := p.Get("09Slices")
+	if e.Hints["makeSlice"] != 2 || e.Hints["sliceUp"] != 1 {
+		t.Errorf("Expected makeSlice at 2 and sliceUp at 1, got %v", e.Hints)
+	}
+	if got := e.HintsUsed(); got != 3 {
+		t.Errorf("Expected 3 hint levels used, got %d", got)
+	}
+	if e.State != NotStarted {
+		t.Errorf("Expected hints to leave the state alone, got %q", e.State)
+	}
+
+	// Recording a test result keeps the hints.
+	p.Record("09Slices", true, t1)
+	if got := p.Get("09Slices").HintsUsed(); got != 3 {
+		t.Errorf("Expected Record to keep the hints, got %d levels", got)
+	}
+}
+
+func TestReview(t *testing.T) {
+	p := &Progress{Modules: map[string]Entry{}}
+	p.Set("09Slices", InProgress, t0)
+	if err := p.Review("09Slices", t1); err == nil {
+		t.Error("Expected an error reviewing a module that isn't passing")
+	}
+
+	p.Set("09Slices", Passing, t0)
+	if err := p.Review("09Slices", t1); err != nil {
+		t.Fatalf("Review failed: %v", err)
+	}
+	if got := p.Get("09Slices").State; got != Reviewed {
+		t.Errorf("Expected %q, got %q", Reviewed, got)
+	}
+}
+
+// newModule lays out a module with the given template and, when work
+// is not empty, a workspace file.
+func newModule(t *testing.T, template, work string) curriculum.Module {
+	t.Helper()
+	dir := filepath.Join(t.TempDir(), "09Slices")
+	if err := os.MkdirAll(filepath.Join(dir, curriculum.PracticeDirName), 0755); err != nil {
+		t.Fatal(err)
+	}
+	m := curriculum.Module{
+		Name:     "09Slices",
+		Dir:      dir,
+		Number:   9,
+		Metadata: curriculum.Metadata{Key: "slices"},
+	}
+	if err := os.WriteFile(m.TemplateFile(), []byte(template), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if work != "" {
+		if err := os.WriteFile(m.WorkspaceFile(), []byte(work), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return m
+}
+
+func TestCurrent(t *testing.T) {
+	const tmpl = "package main\n\n// TODO\nfunc main() {}\n"
+	const edited = "package main\n\nfunc main() { println(1) }\n"
+
+	tests := []struct {
+		name     string
+		work     string
+		recorded State
+		want     State
+	}{
+		{"not generated", "", NotStarted, NotStarted},
+		{"untouched", tmpl, NotStarted, NotStarted},
+		{"edited", edited, NotStarted, InProgress},
+		{"cleaned after passing", "", Passing, Passing},
+		{"reviewed", tmpl, Reviewed, Reviewed},
+	}
+
+	for _, tt := range tests {
+		m := newModule(t, tmpl, tt.work)
+		p := &Progress{Modules: map[string]Entry{}}
+		if tt.recorded != NotStarted {
+			p.Set(m.Name, tt.recorded, t0)
+		}
+		e, err := p.Current(m)
+		if err != nil {
+			t.Fatalf("%s: Current failed: %v", tt.name, err)
+		}
+		if e.State != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.want, e.State)
+		}
+	}
+}
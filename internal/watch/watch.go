@@ -0,0 +1,109 @@
+// Package watch polls a module directory for changes to its Go files.
+// Polling needs no platform specific support and is cheap for the few
+// files of a practice module.
+package watch
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"os"
+	"strings"
+	"time"
+)
+
+// DefaultInterval is how often the files are polled when no interval
+// is given.
+const DefaultInterval = 500 * time.Millisecond
+
+// fileInfo is what a snapshot remembers of a file.
+type fileInfo struct {
+	size    int64
+	modTime time.Time
+}
+
+// Snapshot records the size and modification time of the Go files
+// directly inside a directory, keyed by name.
+type Snapshot map[string]fileInfo
+
+// Take snapshots the Go files in dir. Subdirectories, such as
+// .practice and vendor, are not included.
+func Take(dir string) (Snapshot, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	s := make(Snapshot)
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") {
+			continue
+		}
+		info, err := e.Info()
+		if errors.Is(err, os.ErrNotExist) {
+			continue // removed since ReadDir
+		}
+		if err != nil {
+			return nil, err
+		}
+		s[e.Name()] = fileInfo{size: info.Size(), modTime: info.ModTime()}
+	}
+	return s, nil
+}
+
+// Equal reports whether both snapshots saw the same files, unchanged.
+func (s Snapshot) Equal(o Snapshot) bool {
+	return maps.EqualFunc(s, o, func(a, b fileInfo) bool {
+		return a.size == b.size && a.modTime.Equal(b.modTime)
+	})
+}
+
+// Changes polls the Go files in dir every interval and sends on the
+// returned channel after they change. A change is only reported once
+// the files have stayed the same for a whole interval, so an editor
+// writing a file in several steps triggers a single run. The channel
+// is closed when ctx is done.
+func Changes(ctx context.Context, dir string, interval time.Duration) (<-chan struct{}, error) {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	last, err := Take(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan struct{})
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		pending := false
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			cur, err := Take(dir)
+			if err != nil {
+				// The directory may be briefly missing while
+				// setup_go_practice.py regenerates it.
+				continue
+			}
+			if !cur.Equal(last) {
+				last, pending = cur, true
+				continue
+			}
+			if !pending {
+				continue
+			}
+			pending = false
+			select {
+			case ch <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
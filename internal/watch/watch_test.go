@@ -0,0 +1,80 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func write(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	write(t, filepath.Join(dir, "slices.go"), "package main\n")
+	write(t, filepath.Join(dir, "go.mod"), "module example.com/slices\n")
+	if err := os.Mkdir(filepath.Join(dir, ".practice"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	write(t, filepath.Join(dir, ".practice", "template.go"), "package main\n")
+
+	s, err := Take(dir)
+	if err != nil {
+		t.Fatalf("Take failed: %v", err)
+	}
+	if len(s) != 1 {
+		t.Errorf("Expected only slices.go in the snapshot, got %v", s)
+	}
+
+	same, _ := Take(dir)
+	if !s.Equal(same) {
+		t.Error("Expected snapshots of unchanged files to be equal")
+	}
+
+	write(t, filepath.Join(dir, "slices.go"), "package main\n\nfunc main() {}\n")
+	changed, _ := Take(dir)
+	if s.Equal(changed) {
+		t.Error("Expected a snapshot after an edit to differ")
+	}
+}
+
+func TestChanges(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "slices.go")
+	write(t, path, "package main\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes, err := Changes(ctx, dir, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("Changes failed: %v", err)
+	}
+
+	select {
+	case <-changes:
+		t.Fatal("Expected no change before the file is edited")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	write(t, path, "package main\n\nfunc main() {}\n")
+	select {
+	case <-changes:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected a change after the file was edited")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-changes:
+		if ok {
+			t.Error("Expected the channel to be closed, got a change")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the channel to be closed after cancel")
+	}
+}
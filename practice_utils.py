@@ -67,7 +67,10 @@ def topic_to_package_name(topic):
 def generate_files(practice_dir, target_dir):
     """
     Standard file generation logic used by all templates.
-    Creates .go and go.mod files in the target directory.
+    Creates .go and go.mod files in the target directory, plus a
+    _test.go file when the template ships a solution_test.go, a
+    go.sum when it ships one for its dependencies and a copy of
+    everything in .practice/support.
     
     Args:
         practice_dir: Path to the .practice directory
@@ -87,15 +90,53 @@ def generate_files(practice_dir, target_dir):
         with open(go_filepath, 'w') as f:
             f.write(template)
         
+        # Copy the companion tests, if any, next to the practice file
+        test_path = os.path.join(practice_dir, "solution_test.go")
+        if os.path.exists(test_path):
+            with open(test_path, 'r') as f:
+                tests = f.read()
+            with open(os.path.join(target_dir, f"{package_name}_test.go"), 'w') as f:
+                f.write(tests)
+        
+        # Copy the support files, such as build-tagged variants that
+        # the learner uses but doesn't write, as they are. Directories
+        # are copied with their contents, e.g. files for go:embed.
+        support_dir = os.path.join(practice_dir, "support")
+        if os.path.isdir(support_dir):
+            for root, dirs, files in os.walk(support_dir):
+                dirs.sort()
+                rel = os.path.relpath(root, support_dir)
+                os.makedirs(os.path.join(target_dir, rel), exist_ok=True)
+                for filename in sorted(files):
+                    with open(os.path.join(root, filename), 'r') as f:
+                        content = f.read()
+                    with open(os.path.join(target_dir, rel, filename), 'w') as f:
+                        f.write(content)
+        
         # Create go.mod file
         go_mod_path = os.path.join(target_dir, "go.mod")
-        go_mod_content = f'''module github.com/orsenthil/gobyexample/{package_name}
+        module_line = f"module github.com/orsenthil/gobyexample/{package_name}"
+        go_mod_content = f'''{module_line}
 
 go 1.25
 '''
+        
+        # Modules with third-party dependencies ship a go.mod (and go.sum)
+        # in .practice; keep their pinned requirements under our module path
+        practice_go_mod = os.path.join(practice_dir, "go.mod")
+        if os.path.exists(practice_go_mod):
+            with open(practice_go_mod, 'r') as f:
+                go_mod_content = re.sub(r'^module .*$', module_line, f.read(), count=1, flags=re.M)
         with open(go_mod_path, 'w') as f:
             f.write(go_mod_content)
         
+        practice_go_sum = os.path.join(practice_dir, "go.sum")
+        if os.path.exists(practice_go_sum):
+            with open(practice_go_sum, 'r') as f:
+                go_sum = f.read()
+            with open(os.path.join(target_dir, "go.sum"), 'w') as f:
+                f.write(go_sum)
+        
         return True
     except Exception as e:
         import sys
@@ -0,0 +1,173 @@
+package practicekit
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the part of the time package that the practice modules use.
+// Its methods behave like the functions of the same name.
+type Clock interface {
+	Now() time.Time
+	Since(t time.Time) time.Duration
+	Sleep(d time.Duration)
+	NewTimer(d time.Duration) *Timer
+	NewTicker(d time.Duration) *Ticker
+	Tick(d time.Duration) <-chan time.Time
+}
+
+// Timer is a single event, like time.Timer.
+type Timer struct {
+	C    <-chan time.Time
+	stop func() bool
+}
+
+// Stop prevents the timer from firing. It returns false if the timer
+// already fired or was stopped.
+func (t *Timer) Stop() bool { return t.stop() }
+
+// Ticker delivers ticks at intervals, like time.Ticker.
+type Ticker struct {
+	C    <-chan time.Time
+	stop func()
+}
+
+// Stop turns off the ticker. No more ticks are sent after Stop
+// returns.
+func (t *Ticker) Stop() { t.stop() }
+
+// System returns the real clock of the time package.
+func System() Clock { return systemClock{} }
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                  { return time.Now() }
+func (systemClock) Since(t time.Time) time.Duration { return time.Since(t) }
+func (systemClock) Sleep(d time.Duration)           { time.Sleep(d) }
+
+func (systemClock) NewTimer(d time.Duration) *Timer {
+	t := time.NewTimer(d)
+	return &Timer{C: t.C, stop: t.Stop}
+}
+
+func (systemClock) NewTicker(d time.Duration) *Ticker {
+	t := time.NewTicker(d)
+	return &Ticker{C: t.C, stop: t.Stop}
+}
+
+func (systemClock) Tick(d time.Duration) <-chan time.Time { return time.Tick(d) }
+
+// Simulated is a clock whose time only moves by the events scheduled
+// on it: Now returns the time of the latest timer, tick or sleep that
+// completed, and timers and tickers deliver the exact time they were
+// due. Events still happen in real time, divided by the speedup, so
+// goroutines interleave as they would with the system clock, but the
+// times a program prints no longer depend on scheduling delays.
+type Simulated struct {
+	start     time.Time
+	realStart time.Time
+	speedup   time.Duration
+
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewSimulated returns a clock starting at start whose events happen
+// speedup times faster than real time.
+func NewSimulated(start time.Time, speedup int) *Simulated {
+	return &Simulated{
+		start:     start,
+		realStart: time.Now(),
+		speedup:   time.Duration(max(speedup, 1)),
+		now:       start,
+	}
+}
+
+// Now returns the time of the latest event.
+func (s *Simulated) Now() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.now
+}
+
+// Since returns the simulated time elapsed since t.
+func (s *Simulated) Since(t time.Time) time.Duration {
+	return s.Now().Sub(t)
+}
+
+// advance moves the clock to t, unless a later event happened first.
+func (s *Simulated) advance(t time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t.After(s.now) {
+		s.now = t
+	}
+}
+
+// until returns the real time left before the simulated time t.
+func (s *Simulated) until(t time.Time) time.Duration {
+	return time.Until(s.realStart.Add(t.Sub(s.start) / s.speedup))
+}
+
+// Sleep blocks until d after the latest event.
+func (s *Simulated) Sleep(d time.Duration) {
+	at := s.Now().Add(d)
+	time.Sleep(s.until(at))
+	s.advance(at)
+}
+
+// NewTimer returns a timer that fires d after the latest event.
+func (s *Simulated) NewTimer(d time.Duration) *Timer {
+	c := make(chan time.Time, 1)
+	at := s.Now().Add(d)
+	t := time.AfterFunc(s.until(at), func() {
+		s.advance(at)
+		c <- at
+	})
+	return &Timer{C: c, stop: t.Stop}
+}
+
+// NewTicker returns a ticker whose first tick is d after the latest
+// event. Like time.Ticker, it drops ticks for slow receivers.
+func (s *Simulated) NewTicker(d time.Duration) *Ticker {
+	if d <= 0 {
+		panic("practicekit: non-positive interval for NewTicker")
+	}
+	c := make(chan time.Time, 1)
+	var (
+		mu      sync.Mutex
+		timer   *time.Timer
+		stopped bool
+		fire    func()
+	)
+	next := s.Now().Add(d)
+	fire = func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if stopped {
+			return
+		}
+		s.advance(next)
+		select {
+		case c <- next:
+		default:
+		}
+		next = next.Add(d)
+		timer = time.AfterFunc(s.until(next), fire)
+	}
+
+	mu.Lock()
+	timer = time.AfterFunc(s.until(next), fire)
+	mu.Unlock()
+	return &Ticker{C: c, stop: func() {
+		mu.Lock()
+		defer mu.Unlock()
+		stopped = true
+		timer.Stop()
+	}}
+}
+
+// Tick is like NewTicker but only returns the channel.
+func (s *Simulated) Tick(d time.Duration) <-chan time.Time {
+	return s.NewTicker(d).C
+}
@@ -0,0 +1,79 @@
+package practicekit
+
+import (
+	"testing"
+	"time"
+)
+
+var start = time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+
+func TestSimulatedSleep(t *testing.T) {
+	c := NewSimulated(start, 100)
+	c.Sleep(2 * time.Second)
+	if got, want := c.Now(), start.Add(2*time.Second); !got.Equal(want) {
+		t.Errorf("Expected %v after Sleep, got %v", want, got)
+	}
+	if got := c.Since(start); got != 2*time.Second {
+		t.Errorf("Expected 2s since start, got %v", got)
+	}
+}
+
+func TestSimulatedTimer(t *testing.T) {
+	c := NewSimulated(start, 100)
+	timer := c.NewTimer(time.Second)
+	if got, want := <-timer.C, start.Add(time.Second); !got.Equal(want) {
+		t.Errorf("Expected the timer to deliver %v, got %v", want, got)
+	}
+	if got := c.Now(); !got.Equal(start.Add(time.Second)) {
+		t.Errorf("Expected the clock to move to the timer, got %v", got)
+	}
+	if timer.Stop() {
+		t.Error("Expected Stop to report a timer that already fired")
+	}
+
+	stopped := c.NewTimer(time.Second)
+	if !stopped.Stop() {
+		t.Error("Expected Stop to stop a pending timer")
+	}
+	select {
+	case v := <-stopped.C:
+		t.Errorf("Expected a stopped timer not to fire, got %v", v)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestSimulatedTicker(t *testing.T) {
+	c := NewSimulated(start, 100)
+	ticker := c.NewTicker(500 * time.Millisecond)
+	for i := 1; i <= 3; i++ {
+		want := start.Add(time.Duration(i) * 500 * time.Millisecond)
+		if got := <-ticker.C; !got.Equal(want) {
+			t.Errorf("Expected tick %d at %v, got %v", i, want, got)
+		}
+	}
+	ticker.Stop()
+
+	// A tick may have been buffered before Stop; none comes after.
+	select {
+	case <-ticker.C:
+	default:
+	}
+	select {
+	case v := <-ticker.C:
+		t.Errorf("Expected no tick after Stop, got %v", v)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestSystemClock(t *testing.T) {
+	c := System()
+	before := time.Now()
+	if now := c.Now(); now.Before(before) {
+		t.Errorf("Expected the system time, got %v before %v", now, before)
+	}
+	timer := c.NewTimer(time.Millisecond)
+	<-timer.C
+	ticker := c.NewTicker(time.Millisecond)
+	<-ticker.C
+	ticker.Stop()
+}
@@ -0,0 +1,104 @@
+// Package practicekit makes the output of time and randomness based
+// practice modules reproducible, so that `practice check` can compare
+// it with a golden file and their tests can assert it exactly.
+//
+// Modules get their clock and random number generator from the
+// environment at startup:
+//
+//	var (
+//		clock = practicekit.ClockFromEnv()
+//		rng   = practicekit.RandFromEnv()
+//	)
+//
+// Run normally, clock is the system clock and rng is randomly seeded,
+// so the programs behave like their Go by Example originals. When
+// PRACTICE_CLOCK and PRACTICE_SEED are set, as the `env` of the
+// module's metadata.json does for `practice check`, they are a
+// simulated clock and a seeded generator instead.
+//
+// The package lives in the root module so that every generated module
+// can import it; their go.mod replaces the root module with the
+// repository directory.
+package practicekit
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Environment variables read by ClockFromEnv and RandFromEnv.
+const (
+	// ClockEnv holds the RFC 3339 start time of the simulated clock.
+	ClockEnv = "PRACTICE_CLOCK"
+	// SpeedupEnv optionally makes the simulated clock run faster than
+	// real time, e.g. 10 for ten times faster.
+	SpeedupEnv = "PRACTICE_SPEEDUP"
+	// SeedEnv holds the seed of the random number generator.
+	SeedEnv = "PRACTICE_SEED"
+)
+
+// ClockFromEnv returns a Simulated clock when ClockEnv is set, and the
+// System clock otherwise. It panics on malformed values: they are a
+// mistake in the module's metadata, not something a program can
+// recover from.
+func ClockFromEnv() Clock {
+	start := os.Getenv(ClockEnv)
+	if start == "" {
+		return System()
+	}
+	t, err := time.Parse(time.RFC3339Nano, start)
+	if err != nil {
+		panic(fmt.Sprintf("practicekit: %s: %v", ClockEnv, err))
+	}
+	speedup := 1
+	if s := os.Getenv(SpeedupEnv); s != "" {
+		if speedup, err = strconv.Atoi(s); err != nil || speedup < 1 {
+			panic(fmt.Sprintf("practicekit: %s: want a positive integer, got %q", SpeedupEnv, s))
+		}
+	}
+	return NewSimulated(t, speedup)
+}
+
+// RandFromEnv returns a generator seeded with SeedEnv when it is set,
+// and a randomly seeded one otherwise.
+func RandFromEnv() *rand.Rand {
+	s := os.Getenv(SeedEnv)
+	if s == "" {
+		return rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+	seed, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		panic(fmt.Sprintf("practicekit: %s: %v", SeedEnv, err))
+	}
+	return NewRand(seed)
+}
+
+// NewRand returns a generator whose sequence is fixed by seed.
+func NewRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+// Capture runs f and returns what it wrote to os.Stdout.
+func Capture(f func()) (string, error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return "", err
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	out := make(chan string)
+	go func() {
+		b, _ := io.ReadAll(r)
+		r.Close()
+		out <- string(b)
+	}()
+	f()
+	w.Close()
+	return <-out, nil
+}
@@ -0,0 +1,73 @@
+package practicekit
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestClockFromEnv(t *testing.T) {
+	t.Setenv(ClockEnv, "")
+	if _, ok := ClockFromEnv().(systemClock); !ok {
+		t.Errorf("Expected the system clock without %s", ClockEnv)
+	}
+
+	t.Setenv(ClockEnv, "2026-01-02T15:04:05Z")
+	t.Setenv(SpeedupEnv, "10")
+	c, ok := ClockFromEnv().(*Simulated)
+	if !ok {
+		t.Fatalf("Expected a simulated clock with %s set", ClockEnv)
+	}
+	if want := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC); !c.Now().Equal(want) {
+		t.Errorf("Expected the clock to start at %v, got %v", want, c.Now())
+	}
+	if c.speedup != 10 {
+		t.Errorf("Expected speedup 10, got %d", c.speedup)
+	}
+}
+
+func TestClockFromEnvMalformed(t *testing.T) {
+	for _, env := range [][2]string{
+		{ClockEnv, "yesterday"},
+		{SpeedupEnv, "0"},
+	} {
+		t.Run(env[0], func(t *testing.T) {
+			t.Setenv(ClockEnv, "2026-01-02T15:04:05Z")
+			t.Setenv(env[0], env[1])
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected a panic for %s=%q", env[0], env[1])
+				}
+			}()
+			ClockFromEnv()
+		})
+	}
+}
+
+func TestRandFromEnv(t *testing.T) {
+	t.Setenv(SeedEnv, "42")
+	a, b := RandFromEnv(), RandFromEnv()
+	for range 10 {
+		if x, y := a.IntN(100), b.IntN(100); x != y {
+			t.Fatalf("Expected generators with the same seed to agree, got %d and %d", x, y)
+		}
+	}
+
+	r := NewRand(42)
+	if x, y := r.IntN(1000), NewRand(43).IntN(1000); x == y {
+		t.Errorf("Expected different seeds to give different numbers, got %d twice", x)
+	}
+}
+
+func TestCapture(t *testing.T) {
+	got, err := Capture(func() {
+		fmt.Println("hello")
+		fmt.Print("world")
+	})
+	if err != nil {
+		t.Fatalf("Capture failed: %v", err)
+	}
+	if want := "hello\nworld"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,99 @@
+// Package practicetest holds the helpers the tests of the practice
+// modules share, so that each solution_test.go doesn't carry its own
+// copy of them.
+//
+// The tests also run against a module's template, whose functions are
+// not written yet. A goroutine that never sends, or a WaitGroup that
+// is never done, must then fail the test with a message rather than
+// block until `go test` times out, so tests wait with Wait and Receive
+// instead of receiving or calling directly.
+package practicetest
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit"
+)
+
+// Timeout is how long Wait and Receive wait before failing the test.
+var Timeout = 5 * time.Second
+
+// Capture runs fn and returns what it wrote to os.Stdout.
+func Capture(t testing.TB, fn func()) string {
+	t.Helper()
+	out, err := practicekit.Capture(fn)
+	if err != nil {
+		t.Fatalf("Capture failed: %v", err)
+	}
+	return out
+}
+
+// Wait runs fn in a goroutine and waits for it to return, failing the
+// test if it takes longer than Timeout. fn may report errors with
+// t.Error, but must not call t.Fatal, which only works in the test's
+// own goroutine.
+func Wait(t testing.TB, what string, fn func()) {
+	t.Helper()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+	select {
+	case <-done:
+	case <-time.After(Timeout):
+		t.Fatalf("Timed out after %v waiting for %s", Timeout, what)
+	}
+}
+
+// Receive returns the next value on c, failing the test if none
+// arrives within Timeout. A closed channel returns its zero value, as
+// a receive does.
+func Receive[T any](t testing.TB, c <-chan T, what string) T {
+	t.Helper()
+	select {
+	case v := <-c:
+		return v
+	case <-time.After(Timeout):
+		t.Fatalf("Timed out after %v waiting for %s", Timeout, what)
+		panic("unreachable")
+	}
+}
+
+// Start is the time the simulated clocks of the tests start at. It is
+// the PRACTICE_CLOCK of the modules' metadata, so that the tests see
+// the same times as `practice check`.
+var Start = time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+
+// Simulate sets *clock, usually a module's clock variable, to a
+// simulated clock starting at Start and running speedup times faster
+// than real time. It restores *clock when the test ends, and returns
+// the simulated clock.
+func Simulate(t testing.TB, clock *practicekit.Clock, speedup int) *practicekit.Simulated {
+	t.Helper()
+	c := practicekit.NewSimulated(Start, speedup)
+	old := *clock
+	*clock = c
+	t.Cleanup(func() { *clock = old })
+	return c
+}
+
+// CheckLeaks fails the test if it ends with more goroutines than it
+// started with. Goroutines take a moment to exit after they're done,
+// so it retries for a while before reporting.
+func CheckLeaks(t testing.TB) {
+	t.Helper()
+	before := runtime.NumGoroutine()
+	t.Cleanup(func() {
+		deadline := time.Now().Add(time.Second)
+		for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		if n := runtime.NumGoroutine(); n > before {
+			buf := make([]byte, 1<<16)
+			t.Errorf("Expected %d goroutines, got %d:\n%s", before, n, buf[:runtime.Stack(buf, true)])
+		}
+	})
+}
@@ -0,0 +1,168 @@
+package practicetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/orsenthil/practicego/practicekit"
+)
+
+// fakeT records the failures of a test. Like testing.T's, its Fatalf
+// stops the test; run recovers from it.
+type fakeT struct {
+	testing.TB
+	mu       sync.Mutex
+	failures []string
+	cleanups []func()
+}
+
+// fatal is what Fatalf panics with.
+type fatal struct{}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Errorf(format string, args ...any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures = append(f.failures, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeT) Fatalf(format string, args ...any) {
+	f.Errorf(format, args...)
+	panic(fatal{})
+}
+
+func (f *fakeT) Cleanup(fn func()) { f.cleanups = append(f.cleanups, fn) }
+
+// run runs test as the body of a test on f, and then its cleanups.
+func (f *fakeT) run(test func(t testing.TB)) {
+	func() {
+		defer func() {
+			if v := recover(); v != nil && v != (fatal{}) {
+				panic(v)
+			}
+		}()
+		test(f)
+	}()
+	for _, fn := range f.cleanups {
+		fn()
+	}
+}
+
+// shortTimeout lowers Timeout for the rest of the test.
+func shortTimeout(t *testing.T) {
+	timeout := Timeout
+	Timeout = 20 * time.Millisecond
+	t.Cleanup(func() { Timeout = timeout })
+}
+
+func TestCapture(t *testing.T) {
+	out := Capture(t, func() { fmt.Println("hello") })
+	if out != "hello\n" {
+		t.Errorf("Expected %q, got %q", "hello\n", out)
+	}
+}
+
+func TestWait(t *testing.T) {
+	shortTimeout(t)
+	ran := false
+	Wait(t, "fn", func() { ran = true })
+	if !ran {
+		t.Error("Expected fn to run")
+	}
+
+	f := &fakeT{}
+	block := make(chan struct{})
+	defer close(block)
+	f.run(func(t testing.TB) { Wait(t, "the worker", func() { <-block }) })
+	if len(f.failures) != 1 || !strings.Contains(f.failures[0], "waiting for the worker") {
+		t.Errorf("Expected a timeout, got %q", f.failures)
+	}
+}
+
+func TestReceive(t *testing.T) {
+	shortTimeout(t)
+	c := make(chan int, 1)
+	c <- 7
+	if v := Receive(t, c, "a value"); v != 7 {
+		t.Errorf("Expected 7, got %d", v)
+	}
+
+	f := &fakeT{}
+	f.run(func(t testing.TB) { Receive(t, c, "done") })
+	if len(f.failures) != 1 || !strings.Contains(f.failures[0], "waiting for done") {
+		t.Errorf("Expected a timeout, got %q", f.failures)
+	}
+}
+
+func TestCheckLeaks(t *testing.T) {
+	f := &fakeT{}
+	f.run(func(t testing.TB) { CheckLeaks(t) })
+	if len(f.failures) != 0 {
+		t.Errorf("Expected no leaks, got %q", f.failures)
+	}
+
+	f = &fakeT{}
+	stop := make(chan struct{})
+	defer close(stop)
+	f.run(func(t testing.TB) {
+		CheckLeaks(t)
+		go func() { <-stop }()
+	})
+	if len(f.failures) != 1 || !strings.Contains(f.failures[0], "goroutines") {
+		t.Errorf("Expected a leak, got %q", f.failures)
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	clock := practicekit.System()
+	f := &fakeT{}
+	f.run(func(t testing.TB) {
+		c := Simulate(t, &clock, 1000)
+		if clock != practicekit.Clock(c) || !c.Now().Equal(Start) {
+			t.Errorf("Expected a simulated clock at %v, got %v", Start, clock.Now())
+		}
+	})
+	if len(f.failures) != 0 {
+		t.Error(f.failures)
+	}
+	if clock != practicekit.System() {
+		t.Error("Expected the system clock to be restored")
+	}
+}
+
+// TestStartMatchesMetadata checks that the modules' PRACTICE_CLOCK
+// is Start, so that tests and `practice check` agree.
+func TestStartMatchesMetadata(t *testing.T) {
+	files, err := filepath.Glob("../../*/.practice/metadata.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range files {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var meta struct {
+			Check struct {
+				Env map[string]string `json:"env"`
+			} `json:"check"`
+		}
+		if err := json.Unmarshal(data, &meta); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		v, ok := meta.Check.Env[practicekit.ClockEnv]
+		if !ok {
+			continue
+		}
+		if start, err := time.Parse(time.RFC3339, v); err != nil || !start.Equal(Start) {
+			t.Errorf("%s: expected %s %s, got %q", name, practicekit.ClockEnv, Start.Format(time.RFC3339), v)
+		}
+	}
+}
@@ -10,6 +10,15 @@ import sys
 import argparse
 import importlib.util
 import glob
+import shutil
+
+def module_number(module_name):
+    """
+    Return the numeric prefix of a module name, e.g. 9 for 09Slices.
+    Names without one sort last.
+    """
+    digits = len(module_name) - len(module_name.lstrip("0123456789"))
+    return int(module_name[:digits]) if digits else float("inf")
 
 def discover_templates(base_dir):
     """
@@ -19,7 +28,7 @@ def discover_templates(base_dir):
         base_dir: Base directory to search for templates
         
     Returns:
-        List of tuples: (module_dir, template_script_path) sorted by directory name
+        List of tuples: (module_dir, template_script_path) sorted by module number
     """
     pattern = os.path.join(base_dir, "*", ".practice", "generate.py")
     template_scripts = glob.glob(pattern)
@@ -32,8 +41,8 @@ def discover_templates(base_dir):
         module_name = os.path.basename(module_dir)
         templates.append((module_name, module_dir, script_path))
     
-    # Sort by module name (which includes numeric prefix)
-    templates.sort(key=lambda x: x[0])
+    # Sort by the numeric prefix, so that 100 comes after 99
+    templates.sort(key=lambda x: (module_number(x[0]), x[0]))
     
     return templates
 
@@ -109,7 +118,9 @@ def create_go_workspace(base_dir, templates):
     
     print("\nCreating Go workspace file...")
     
-    go_work_content = "go 1.25\n\nuse (\n"
+    # The repository root is itself a module holding the practice
+    # command (cmd/practice), so it must be part of the workspace too.
+    go_work_content = "go 1.25\n\nuse (\n    .\n"
     for module_name, _, _ in templates:
         go_work_content += f"    ./{module_name}\n"
     go_work_content += ")\n"
@@ -137,21 +148,26 @@ def clean_modules(base_dir):
     removed_count = 0
     
     for module_name, module_dir, _ in templates:
-        # Remove .go files and go.mod files in the module directory
-        # but preserve the .practice directory
+        # Remove .go, go.mod and go.sum files in the module directory,
+        # and the directories copied from .practice/support, but
+        # preserve the .practice directory
         removed_files = []
+        support_dir = os.path.join(module_dir, ".practice", "support")
         
         for filename in os.listdir(module_dir):
             if filename == ".practice":
                 continue  # Skip the template directory
             
             filepath = os.path.join(module_dir, filename)
-            if os.path.isfile(filepath) and (filename.endswith('.go') or filename == 'go.mod'):
-                try:
+            try:
+                if os.path.isfile(filepath) and (filename.endswith('.go') or filename in ('go.mod', 'go.sum')):
                     os.remove(filepath)
                     removed_files.append(filename)
-                except Exception as e:
-                    print(f"  ❌ Failed to remove {filepath}: {e}")
+                elif os.path.isdir(filepath) and os.path.isdir(os.path.join(support_dir, filename)):
+                    shutil.rmtree(filepath)
+                    removed_files.append(filename + "/")
+            except Exception as e:
+                print(f"  ❌ Failed to remove {filepath}: {e}")
         
         if removed_files:
             print(f"  Cleaned {module_name}/ (removed {', '.join(removed_files)})")
@@ -168,6 +184,14 @@ def clean_modules(base_dir):
     
     print(f"\n✅ Cleanup complete! Cleaned {removed_count} modules.")
     print("💡 Template directories (.practice) were preserved.")
+    if os.path.exists(os.path.join(base_dir, ".practice-progress.json")):
+        print("📈 Your progress in .practice-progress.json was kept.")
+    vendored = [name for name, module_dir, _ in templates
+                if os.path.isdir(os.path.join(module_dir, "vendor"))]
+    if vendored or os.path.isdir(os.path.join(base_dir, "vendor")):
+        # Vendored dependencies are not the learner's work, and
+        # recreating them needs the network, so they survive a clean.
+        print("📦 Vendored dependencies were kept for offline builds.")
 
 def main():
     """Main function to handle command line arguments and operations."""